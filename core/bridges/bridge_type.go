@@ -10,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -21,6 +23,10 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// CacheTTL is the maximum age of a cached response that may be returned
+	// when the external adapter fails. Zero disables caching, and nil keeps
+	// the current value when updating a bridge.
+	CacheTTL *models.Interval `json:"cacheTTL"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -48,6 +54,7 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	CacheTTL               models.Interval
 }
 
 // BridgeType is used for external adapters and has fields for
@@ -60,10 +67,19 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link `gorm:"type:varchar(255)"`
+	CacheTTL               models.Interval
+	CacheHits              int64
+	CacheLastHitAt         null.Time
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
 
+// CacheEnabled returns true if the last successful response of this bridge
+// should be kept and used as a fallback when the external adapter fails.
+func (bt BridgeType) CacheEnabled() bool {
+	return bt.CacheTTL.Duration() > 0
+}
+
 // NewBridgeType returns a bridge bridge type authentication (with plaintext
 // password) and a bridge type (with hashed password, for persisting)
 func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
@@ -77,22 +93,29 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 		return nil, nil, err
 	}
 
+	var cacheTTL models.Interval
+	if btr.CacheTTL != nil {
+		cacheTTL = *btr.CacheTTL
+	}
+
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, nil
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingToken:          incomingToken,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		CacheTTL:               cacheTTL,
+	}, &BridgeType{
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingTokenHash:      hash,
+		Salt:                   salt,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		CacheTTL:               cacheTTL,
+	}, nil
 }
 
 // AuthenticateBridgeType returns true if the passed token matches its
@@ -75,8 +75,8 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	sql := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	sql := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, cache_ttl, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :cache_ttl, now(), now())
 	RETURNING *;`
 	stmt, err := o.db.PrepareNamed(sql)
 	if err != nil {
@@ -85,10 +85,15 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 	return stmt.Get(bt, bt)
 }
 
-// UpdateBridgeType updates the bridge type.
+// UpdateBridgeType updates the bridge type. The cache TTL is only updated if
+// it is set in the request.
 func (o *orm) UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error {
-	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.db.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	var cacheTTL interface{}
+	if btr.CacheTTL != nil {
+		cacheTTL = *btr.CacheTTL
+	}
+	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, cache_ttl = COALESCE($4, cache_ttl) WHERE name = $5 RETURNING *"
+	return o.db.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, cacheTTL, bt.Name)
 }
 
 // --- External Initiator
@@ -2,6 +2,7 @@ package bridges_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func setupORM(t *testing.T) (*sqlx.DB, bridges.ORM) {
@@ -76,6 +78,16 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	foundbridge, err := orm.FindBridge("UniqueName")
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
+
+	cacheTTL := models.Interval(time.Minute)
+	updateBridge.CacheTTL = &cacheTTL
+	require.NoError(t, orm.UpdateBridgeType(firstBridge, updateBridge))
+	require.Equal(t, cacheTTL, firstBridge.CacheTTL)
+
+	// The cache TTL is kept when it is not in the request
+	updateBridge.CacheTTL = nil
+	require.NoError(t, orm.UpdateBridgeType(firstBridge, updateBridge))
+	require.Equal(t, cacheTTL, firstBridge.CacheTTL)
 }
 
 func TestORM_CreateExternalInitiator(t *testing.T) {
@@ -81,7 +81,7 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		client = eth.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
 		var err2 error
-		client, err2 = newEthClientFromChain(l, dbchain)
+		client, err2 = newEthClientFromChain(l, cfg, dbchain)
 		if err2 != nil {
 			return nil, errors.Wrapf(err2, "failed to instantiate eth client for chain with ID %s", dbchain.ID.String())
 		}
@@ -285,7 +285,7 @@ func (c *chain) Logger() logger.Logger                     { return c.logger }
 
 var ErrNoPrimaryNode = errors.New("no primary node found")
 
-func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (eth.Client, error) {
+func newEthClientFromChain(lggr logger.Logger, cfg evmconfig.ChainScopedConfig, chain types.Chain) (eth.Client, error) {
 	nodes := chain.Nodes
 	chainID := big.Int(chain.ID)
 	var primaries []eth.Node
@@ -308,19 +308,23 @@ func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (eth.Client, e
 	if len(primaries) == 0 {
 		return nil, ErrNoPrimaryNode
 	}
-	return eth.NewClientWithNodes(lggr, primaries, sendonlys, &chainID)
+	return eth.NewClientWithNodes(lggr, cfg, primaries, sendonlys, &chainID)
 }
 
 func newPrimary(lggr logger.Logger, n types.Node) (eth.Node, error) {
 	if n.SendOnly {
 		return nil, errors.New("cannot cast send-only node to primary")
 	}
-	if !n.WSURL.Valid {
-		return nil, errors.New("primary node was missing WS url")
+	if !n.WSURL.Valid && !n.HTTPURL.Valid {
+		return nil, errors.New("primary node was missing both WS and HTTP urls")
 	}
-	wsuri, err := url.Parse(n.WSURL.String)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid websocket uri")
+	var wsuri *url.URL
+	if n.WSURL.Valid {
+		u, err := url.Parse(n.WSURL.String)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid websocket uri")
+		}
+		wsuri = u
 	}
 	var httpuri *url.URL
 	if n.HTTPURL.Valid {
@@ -331,7 +335,7 @@ func newPrimary(lggr logger.Logger, n types.Node) (eth.Node, error) {
 		httpuri = u
 	}
 
-	return eth.NewNode(lggr, *wsuri, httpuri, n.Name), nil
+	return eth.NewNode(lggr, wsuri, httpuri, n.Name), nil
 }
 
 func newSendOnly(lggr logger.Logger, n types.Node) (eth.SendOnlyNode, error) {
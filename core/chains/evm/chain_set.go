@@ -5,11 +5,13 @@ import (
 	"math"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -22,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -349,3 +352,35 @@ func UpdateKeySpecificMaxGasPrice(addr common.Address, maxGasPriceWei *big.Int)
 		return nil
 	}
 }
+
+// KeyBudgetUpdate holds the parts of the spending budget of a key to update,
+// nil fields are left unchanged. Zero values remove the limit.
+type KeyBudgetUpdate struct {
+	Window      *time.Duration
+	MaxSpendWei *big.Int
+	MaxTxs      *uint32
+}
+
+func UpdateKeySpecificBudget(addr common.Address, update KeyBudgetUpdate) ChainConfigUpdater {
+	return func(config *types.ChainCfg) error {
+		keyChainConfig, ok := config.KeySpecific[addr.Hex()]
+		if !ok {
+			keyChainConfig = types.ChainCfg{}
+		}
+		if update.Window != nil {
+			d := models.MustMakeDuration(*update.Window)
+			keyChainConfig.EvmKeyBudgetWindow = &d
+		}
+		if update.MaxSpendWei != nil {
+			keyChainConfig.EvmKeyBudgetMaxSpendWei = (*utils.Big)(update.MaxSpendWei)
+		}
+		if update.MaxTxs != nil {
+			keyChainConfig.EvmKeyBudgetMaxTxs = null.IntFrom(int64(*update.MaxTxs))
+		}
+		if config.KeySpecific == nil {
+			config.KeySpecific = map[string]types.ChainCfg{}
+		}
+		config.KeySpecific[addr.Hex()] = keyChainConfig
+		return nil
+	}
+}
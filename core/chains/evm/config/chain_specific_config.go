@@ -28,10 +28,17 @@ type (
 		blockHistoryEstimatorTransactionPercentile uint16
 		chainType                                  chains.ChainType
 		eip1559DynamicFees                         bool
+		ethTxBatchContractAddress                  string
+		ethTxBatchMaxSize                          uint32
+		ethTxBatchWindow                           time.Duration
 		ethTxReaperInterval                        time.Duration
 		ethTxReaperThreshold                       time.Duration
 		ethTxResendAfterThreshold                  time.Duration
+		feeHistoryEstimatorBaseFeeBufferBlocks     uint16
+		feeHistoryEstimatorBlockCount              uint16
+		feeHistoryEstimatorRewardPercentile        uint16
 		finalityDepth                              uint32
+		finalityTagsEnabled                        bool
 		flagsContractAddress                       string
 		gasBumpPercent                             uint16
 		gasBumpThreshold                           uint64
@@ -58,6 +65,7 @@ type (
 		minimumContractPayment                     *assets.Link
 		nonceAutoSync                              bool
 		ocrContractConfirmations                   uint16
+		pollInterval                               time.Duration
 		rpcDefaultBatchSize                        uint32
 		// set true if fully configured
 		complete bool
@@ -92,39 +100,47 @@ func setChainSpecificConfigDefaultSets() {
 		blockHistoryEstimatorBlockDelay:            1,
 		blockHistoryEstimatorBlockHistorySize:      16,
 		blockHistoryEstimatorTransactionPercentile: 60,
-		chainType:                        "",
-		eip1559DynamicFees:               false,
-		ethTxReaperInterval:              1 * time.Hour,
-		ethTxReaperThreshold:             168 * time.Hour,
-		ethTxResendAfterThreshold:        1 * time.Minute,
-		finalityDepth:                    50,
-		gasBumpPercent:                   20,
-		gasBumpThreshold:                 3,
-		gasBumpTxDepth:                   10,
-		gasBumpWei:                       *assets.GWei(5),
-		gasEstimatorMode:                 "BlockHistory",
-		gasLimitDefault:                  DefaultGasLimit,
-		gasLimitMultiplier:               1.0,
-		gasLimitTransfer:                 21000,
-		gasPriceDefault:                  *DefaultGasPrice,
-		gasTipCapDefault:                 *DefaultGasTip,
-		gasTipCapMinimum:                 *big.NewInt(0),
-		headTrackerHistoryDepth:          100,
-		headTrackerMaxBufferSize:         3,
-		headTrackerSamplingInterval:      1 * time.Second,
-		linkContractAddress:              "",
-		logBackfillBatchSize:             100,
-		maxGasPriceWei:                   *assets.GWei(5000),
-		maxInFlightTransactions:          16,
-		maxQueuedTransactions:            250,
-		minGasPriceWei:                   *assets.GWei(1),
-		minIncomingConfirmations:         3,
-		minRequiredOutgoingConfirmations: 12,
-		minimumContractPayment:           DefaultMinimumContractPayment,
-		nonceAutoSync:                    true,
-		ocrContractConfirmations:         4,
-		rpcDefaultBatchSize:              100,
-		complete:                         true,
+		chainType:                              "",
+		eip1559DynamicFees:                     false,
+		ethTxBatchContractAddress:              "",
+		ethTxBatchMaxSize:                      10,
+		ethTxBatchWindow:                       5 * time.Second,
+		ethTxReaperInterval:                    1 * time.Hour,
+		ethTxReaperThreshold:                   168 * time.Hour,
+		ethTxResendAfterThreshold:              1 * time.Minute,
+		feeHistoryEstimatorBaseFeeBufferBlocks: 3,
+		feeHistoryEstimatorBlockCount:          20,
+		feeHistoryEstimatorRewardPercentile:    60,
+		finalityDepth:                          50,
+		finalityTagsEnabled:                    false,
+		gasBumpPercent:                         20,
+		gasBumpThreshold:                       3,
+		gasBumpTxDepth:                         10,
+		gasBumpWei:                             *assets.GWei(5),
+		gasEstimatorMode:                       "BlockHistory",
+		gasLimitDefault:                        DefaultGasLimit,
+		gasLimitMultiplier:                     1.0,
+		gasLimitTransfer:                       21000,
+		gasPriceDefault:                        *DefaultGasPrice,
+		gasTipCapDefault:                       *DefaultGasTip,
+		gasTipCapMinimum:                       *big.NewInt(0),
+		headTrackerHistoryDepth:                100,
+		headTrackerMaxBufferSize:               3,
+		headTrackerSamplingInterval:            1 * time.Second,
+		linkContractAddress:                    "",
+		logBackfillBatchSize:                   100,
+		maxGasPriceWei:                         *assets.GWei(5000),
+		maxInFlightTransactions:                16,
+		maxQueuedTransactions:                  250,
+		minGasPriceWei:                         *assets.GWei(1),
+		minIncomingConfirmations:               3,
+		minRequiredOutgoingConfirmations:       12,
+		minimumContractPayment:                 DefaultMinimumContractPayment,
+		nonceAutoSync:                          true,
+		ocrContractConfirmations:               4,
+		pollInterval:                           5 * time.Second,
+		rpcDefaultBatchSize:                    100,
+		complete:                               true,
 	}
 
 	mainnet := fallbackDefaultSet
@@ -177,6 +193,7 @@ func setChainSpecificConfigDefaultSets() {
 	bscMainnet.minGasPriceWei = *assets.GWei(1)
 	bscMainnet.minIncomingConfirmations = 3
 	bscMainnet.minRequiredOutgoingConfirmations = 12
+	bscMainnet.pollInterval = 1 * time.Second
 
 	hecoMainnet := bscMainnet
 
@@ -199,6 +216,7 @@ func setChainSpecificConfigDefaultSets() {
 	polygonMainnet.linkContractAddress = "0xb0897686c545045afc77cf20ec7a532e3120e0f1"
 	polygonMainnet.minIncomingConfirmations = 5
 	polygonMainnet.minRequiredOutgoingConfirmations = 12
+	polygonMainnet.pollInterval = 1 * time.Second
 	polygonMumbai := polygonMainnet
 	polygonMumbai.linkContractAddress = "0x326C977E6efc84E512bB9C30f76E30c160eD06FB"
 
@@ -211,7 +229,8 @@ func setChainSpecificConfigDefaultSets() {
 	arbitrumMainnet.gasPriceDefault = *assets.GWei(1000) // Arbitrum uses something like a Vickrey auction model where gas price represents a "max bid". In practice we usually pay much less
 	arbitrumMainnet.maxGasPriceWei = *assets.GWei(1000)  // Fix the gas price
 	arbitrumMainnet.minGasPriceWei = *assets.GWei(1000)  // Fix the gas price
-	arbitrumMainnet.gasEstimatorMode = "FixedPrice"
+	// Prices L1 calldata with the ArbGasInfo precompile; the gas price settings above only apply with FixedPrice, except for the max
+	arbitrumMainnet.gasEstimatorMode = "Arbitrum"
 	arbitrumMainnet.blockHistoryEstimatorBlockHistorySize = 0 // Force an error if someone set GAS_UPDATER_ENABLED=true by accident; we never want to run the block history estimator on arbitrum
 	arbitrumMainnet.linkContractAddress = "0xf97f4df75117a78c1A5a0DBb814Af92458539FB4"
 	arbitrumMainnet.ocrContractConfirmations = 1
@@ -233,6 +252,7 @@ func setChainSpecificConfigDefaultSets() {
 	optimismMainnet.minIncomingConfirmations = 1
 	optimismMainnet.minRequiredOutgoingConfirmations = 0
 	optimismMainnet.ocrContractConfirmations = 1
+	optimismMainnet.pollInterval = 1 * time.Second
 	optimismKovan := optimismMainnet
 	optimismKovan.blockEmissionIdleWarningThreshold = 30 * time.Minute
 	optimismKovan.linkContractAddress = "0x4911b761993b9c8c0d14Ba2d86902AF6B0074F5B"
@@ -31,11 +31,15 @@ type ChainScopedOnlyConfig interface {
 	BlockHistoryEstimatorTransactionPercentile() uint16
 	ChainID() *big.Int
 	EvmEIP1559DynamicFees() bool
+	EthTxBatchContractAddress() string
+	EthTxBatchMaxSize() uint32
+	EthTxBatchWindow() time.Duration
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmDefaultBatchSize() uint32
 	EvmFinalityDepth() uint32
+	EvmFinalityTagsEnabled() bool
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
@@ -56,10 +60,17 @@ type ChainScopedOnlyConfig interface {
 	EvmMaxQueuedTransactions() uint64
 	EvmMinGasPriceWei() *big.Int
 	EvmNonceAutoSync() bool
+	EvmPollInterval() time.Duration
 	EvmRPCDefaultBatchSize() uint32
+	FeeHistoryEstimatorBaseFeeBufferBlocks() uint16
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	FlagsContractAddress() string
 	GasEstimatorMode() string
 	ChainType() chains.ChainType
+	KeySpecificBudgetMaxSpendWei(addr gethcommon.Address) *big.Int
+	KeySpecificBudgetMaxTxs(addr gethcommon.Address) uint32
+	KeySpecificBudgetWindow(addr gethcommon.Address) time.Duration
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *big.Int
 	LinkContractAddress() string
 	MinIncomingConfirmations() uint32
@@ -161,12 +172,28 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if c.GasEstimatorMode() == "FeeHistory" {
+		if c.FeeHistoryEstimatorBlockCount() < 1 {
+			err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_BLOCK_COUNT must be greater than or equal to 1 if fee history estimator is enabled"))
+		}
+		if c.FeeHistoryEstimatorRewardPercentile() > 100 {
+			err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE must be less than or equal to 100"))
+		}
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
 	if c.MinIncomingConfirmations() < 1 {
 		err = multierr.Combine(err, errors.New("MIN_INCOMING_CONFIRMATIONS must be greater than or equal to 1"))
 	}
+	if addr := c.EthTxBatchContractAddress(); addr != "" {
+		if !gethcommon.IsHexAddress(addr) {
+			err = multierr.Combine(err, errors.Errorf("ETH_TX_BATCH_CONTRACT_ADDRESS %q is not a valid address", addr))
+		}
+		if c.EthTxBatchMaxSize() < 2 {
+			err = multierr.Combine(err, errors.New("ETH_TX_BATCH_MAX_SIZE must be greater than or equal to 2"))
+		}
+	}
 	lc := ocrtypes.LocalConfig{
 		BlockchainTimeout:                      c.OCRBlockchainTimeout(),
 		ContractConfigConfirmations:            c.OCRContractConfirmations(),
@@ -189,9 +216,12 @@ func (c *chainScopedConfig) validate() (err error) {
 	} else {
 		switch chainType {
 		case chains.Arbitrum:
-			if gasEst := c.GasEstimatorMode(); gasEst != "FixedPrice" {
+			gasEst := c.GasEstimatorMode()
+			switch gasEst {
+			case "Arbitrum", "FixedPrice":
+			default:
 				err = multierr.Combine(err, errors.Errorf("GAS_ESTIMATOR_MODE %q is not allowed with chain type %q - "+
-					"must be %q", gasEst, chains.Arbitrum, "FixedPrice"))
+					"must be %q or %q", gasEst, chains.Arbitrum, "Arbitrum", "FixedPrice"))
 			}
 		case chains.ExChain:
 
@@ -463,6 +493,28 @@ func (c *chainScopedConfig) EvmFinalityDepth() uint32 {
 	return c.defaultSet.finalityDepth
 }
 
+// EvmFinalityTagsEnabled makes the head tracker track the latest blocks with
+// the `finalized` and `safe` tags. Log listeners which ask for a tag instead
+// of a number of confirmations receive logs once their block is at or below
+// the tagged block. If disabled, these listeners wait for EvmFinalityDepth
+// confirmations instead. Only enable it for chains whose nodes support the
+// tags.
+func (c *chainScopedConfig) EvmFinalityTagsEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmFinalityTagsEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmFinalityTagsEnabled", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmFinalityTagsEnabled
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmFinalityTagsEnabled", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.finalityTagsEnabled
+}
+
 // EvmHeadTrackerHistoryDepth tracks the top N block numbers to keep in the `heads` database table.
 // Note that this can easily result in MORE than N records since in the case of re-orgs we keep multiple heads for a particular block height.
 // This number should be at least as large as `EvmFinalityDepth`.
@@ -630,6 +682,40 @@ func (c *chainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return c.defaultSet.blockHistoryEstimatorTransactionPercentile
 }
 
+// FeeHistoryEstimatorBaseFeeBufferBlocks is the number of blocks of maximum base fee
+// growth that the fee cap allows for, so that a transaction stays includable
+// while the base fee rises
+func (c *chainScopedConfig) FeeHistoryEstimatorBaseFeeBufferBlocks() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBaseFeeBufferBlocks()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBaseFeeBufferBlocks", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorBaseFeeBufferBlocks
+}
+
+// FeeHistoryEstimatorBlockCount is the number of past blocks to request from
+// eth_feeHistory
+func (c *chainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBlockCount()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBlockCount", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorBlockCount
+}
+
+// FeeHistoryEstimatorRewardPercentile is the percentile of priority fees paid
+// in each block to base the tip cap on
+func (c *chainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorRewardPercentile()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorRewardPercentile", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorRewardPercentile
+}
+
 // GasEstimatorMode controls what type of gas estimator is used
 func (c *chainScopedConfig) GasEstimatorMode() string {
 	val, ok := c.GeneralConfig.GlobalGasEstimatorMode()
@@ -673,6 +759,45 @@ func (c *chainScopedConfig) KeySpecificMaxGasPriceWei(addr gethcommon.Address) *
 	return c.EvmMaxGasPriceWei()
 }
 
+// KeySpecificBudgetMaxSpendWei is the most wei the key may spend on
+// transactions, including gas, over each budget window. Nil means no limit.
+func (c *chainScopedConfig) KeySpecificBudgetMaxSpendWei(addr gethcommon.Address) *big.Int {
+	c.persistMu.RLock()
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].EvmKeyBudgetMaxSpendWei
+	c.persistMu.RUnlock()
+	if keySpecific != nil && !keySpecific.Equal(utils.NewBigI(0)) {
+		c.logKeySpecificOverrideOnce("EvmKeyBudgetMaxSpendWei", addr, keySpecific)
+		return keySpecific.ToInt()
+	}
+	return nil
+}
+
+// KeySpecificBudgetMaxTxs is the most transactions the key may send over each
+// budget window. Zero means no limit.
+func (c *chainScopedConfig) KeySpecificBudgetMaxTxs(addr gethcommon.Address) uint32 {
+	c.persistMu.RLock()
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].EvmKeyBudgetMaxTxs
+	c.persistMu.RUnlock()
+	if keySpecific.Valid && keySpecific.Int64 > 0 {
+		c.logKeySpecificOverrideOnce("EvmKeyBudgetMaxTxs", addr, keySpecific.Int64)
+		return uint32(keySpecific.Int64)
+	}
+	return 0
+}
+
+// KeySpecificBudgetWindow is the rolling window over which the spending
+// budget of the key applies
+func (c *chainScopedConfig) KeySpecificBudgetWindow(addr gethcommon.Address) time.Duration {
+	c.persistMu.RLock()
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].EvmKeyBudgetWindow
+	c.persistMu.RUnlock()
+	if keySpecific != nil && keySpecific.Duration() > 0 {
+		c.logKeySpecificOverrideOnce("EvmKeyBudgetWindow", addr, keySpecific.Duration())
+		return keySpecific.Duration()
+	}
+	return time.Hour
+}
+
 func (c *chainScopedConfig) ChainType() chains.ChainType {
 	val, ok := c.GeneralConfig.GlobalChainType()
 	if ok {
@@ -833,6 +958,25 @@ func (c *chainScopedConfig) EvmNonceAutoSync() bool {
 	return c.defaultSet.nonceAutoSync
 }
 
+// EvmPollInterval is how often new heads and logs are polled for when none of
+// the chain's primary nodes support subscriptions, i.e. they only have HTTP
+// urls
+func (c *chainScopedConfig) EvmPollInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmPollInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmPollInterval", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmPollInterval
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmPollInterval", p.Duration())
+		return p.Duration()
+	}
+	return c.defaultSet.pollInterval
+}
+
 // EvmGasLimitMultiplier is a factor by which a transaction's GasLimit is
 // multiplied before transmission. So if the value is 1.1, and the GasLimit for
 // a transaction is 10, 10% will be added before transmission.
@@ -875,6 +1019,47 @@ func (c *chainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
 	return c.defaultSet.headTrackerMaxBufferSize
 }
 
+// EthTxBatchContractAddress is the address of the contract which transactions
+// sent with the batch strategy are batched into, see
+// bulletprooftxmanager.BatchStrategy. Batching is disabled if it is empty.
+func (c *chainScopedConfig) EthTxBatchContractAddress() string {
+	val, ok := c.GeneralConfig.GlobalEthTxBatchContractAddress()
+	if ok {
+		c.logEnvOverrideOnce("EthTxBatchContractAddress", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EthTxBatchContractAddress
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EthTxBatchContractAddress", p.String)
+		return p.String
+	}
+	return c.defaultSet.ethTxBatchContractAddress
+}
+
+// EthTxBatchMaxSize is the maximum number of transactions sent in one batch
+func (c *chainScopedConfig) EthTxBatchMaxSize() uint32 {
+	val, ok := c.GeneralConfig.GlobalEthTxBatchMaxSize()
+	if ok {
+		c.logEnvOverrideOnce("EthTxBatchMaxSize", val)
+		return val
+	}
+	return c.defaultSet.ethTxBatchMaxSize
+}
+
+// EthTxBatchWindow is how long a transaction sent with the batch strategy is
+// held for other transactions to batch it with, unless the batch fills up
+// earlier
+func (c *chainScopedConfig) EthTxBatchWindow() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEthTxBatchWindow()
+	if ok {
+		c.logEnvOverrideOnce("EthTxBatchWindow", val)
+		return val
+	}
+	return c.defaultSet.ethTxBatchWindow
+}
+
 // EthTxReaperInterval controls how often the eth tx reaper should run
 func (c *chainScopedConfig) EthTxReaperInterval() time.Duration {
 	val, ok := c.GeneralConfig.GlobalEthTxReaperInterval()
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/guregu/null.v4"
@@ -18,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -97,6 +99,41 @@ func TestChainScopedConfig(t *testing.T) {
 			assert.Equal(t, val.String(), cfg.KeySpecificMaxGasPriceWei(addr).String())
 		})
 	})
+
+	t.Run("KeySpecificBudget", func(t *testing.T) {
+		addr := cltest.NewAddress()
+
+		t.Run("is unlimited with an hour window when nothing is set", func(t *testing.T) {
+			assert.Nil(t, cfg.KeySpecificBudgetMaxSpendWei(addr))
+			assert.Equal(t, uint32(0), cfg.KeySpecificBudgetMaxTxs(addr))
+			assert.Equal(t, time.Hour, cfg.KeySpecificBudgetWindow(addr))
+		})
+		t.Run("uses key-specific values when they are set", func(t *testing.T) {
+			window := models.MustMakeDuration(24 * time.Hour)
+			evmconfig.UpdatePersistedCfg(cfg, func(cfg *types.ChainCfg) {
+				cfg.KeySpecific[addr.Hex()] = evmtypes.ChainCfg{
+					EvmKeyBudgetMaxSpendWei: utils.NewBigI(1000),
+					EvmKeyBudgetMaxTxs:      null.IntFrom(10),
+					EvmKeyBudgetWindow:      &window,
+				}
+			})
+
+			assert.Equal(t, big.NewInt(1000), cfg.KeySpecificBudgetMaxSpendWei(addr))
+			assert.Equal(t, uint32(10), cfg.KeySpecificBudgetMaxTxs(addr))
+			assert.Equal(t, 24*time.Hour, cfg.KeySpecificBudgetWindow(addr))
+		})
+		t.Run("treats zero limits as unlimited", func(t *testing.T) {
+			evmconfig.UpdatePersistedCfg(cfg, func(cfg *types.ChainCfg) {
+				cfg.KeySpecific[addr.Hex()] = evmtypes.ChainCfg{
+					EvmKeyBudgetMaxSpendWei: utils.NewBigI(0),
+					EvmKeyBudgetMaxTxs:      null.IntFrom(0),
+				}
+			})
+
+			assert.Nil(t, cfg.KeySpecificBudgetMaxSpendWei(addr))
+			assert.Equal(t, uint32(0), cfg.KeySpecificBudgetMaxTxs(addr))
+		})
+	})
 }
 
 func TestChainScopedConfig_Profiles(t *testing.T) {
@@ -205,4 +242,23 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			assert.Error(t, cfg.Validate())
 		})
 	})
+
+	t.Run("eth-tx-batch-contract-address", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+			EthTxBatchContractAddress: null.StringFrom("0xnotanaddress"),
+		}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("eth-tx-batch-max-size", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEthTxBatchMaxSize = null.IntFrom(1)
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+			EthTxBatchContractAddress: null.StringFrom("0xcA11bde05977b3631167028862bE2a173976CA11"),
+		}, nil, lggr, gcfg)
+		assert.Error(t, cfg.Validate())
+	})
 }
@@ -38,14 +38,16 @@ func ClobberDBFromEnv(db *gorm.DB, config LegacyEthNodeConfig, lggr logger.Logge
 	}
 
 	stmt := `INSERT INTO nodes (name, evm_chain_id, ws_url, http_url, send_only, created_at, updated_at) VALUES (?,?,?,?,?,NOW(),NOW())`
-	primaryWS := config.EthereumURL()
-	if primaryWS == "" {
-		return errors.New("ETH_URL must be specified (or set USE_LEGACY_ETH_ENV_VARS=false)")
+	var primaryWS, primaryHTTP null.String
+	if config.EthereumURL() != "" {
+		primaryWS = null.StringFrom(config.EthereumURL())
 	}
-	var primaryHTTP null.String
 	if config.EthereumHTTPURL() != nil {
 		primaryHTTP = null.StringFrom(config.EthereumHTTPURL().String())
 	}
+	if !primaryWS.Valid && !primaryHTTP.Valid {
+		return errors.New("ETH_URL or ETH_HTTP_URL must be specified (or set USE_LEGACY_ETH_ENV_VARS=false)")
+	}
 	if err := db.Exec(stmt, fmt.Sprintf("primary-0-%s", ethChainID), ethChainID, primaryWS, primaryHTTP, false, ethChainID, primaryWS, primaryHTTP).Error; err != nil {
 		return errors.Wrap(err, "failed to upsert primary-0")
 	}
@@ -46,10 +46,12 @@ type ORM interface {
 type ChainCfg struct {
 	BlockHistoryEstimatorBlockDelay       null.Int
 	BlockHistoryEstimatorBlockHistorySize null.Int
+	EthTxBatchContractAddress             null.String
 	EthTxReaperThreshold                  *models.Duration
 	EthTxResendAfterThreshold             *models.Duration
 	EvmEIP1559DynamicFees                 null.Bool
 	EvmFinalityDepth                      null.Int
+	EvmFinalityTagsEnabled                null.Bool
 	EvmGasBumpPercent                     null.Int
 	EvmGasBumpTxDepth                     null.Int
 	EvmGasBumpWei                         *utils.Big
@@ -61,9 +63,13 @@ type ChainCfg struct {
 	EvmHeadTrackerHistoryDepth            null.Int
 	EvmHeadTrackerMaxBufferSize           null.Int
 	EvmHeadTrackerSamplingInterval        *models.Duration
+	EvmKeyBudgetMaxSpendWei               *utils.Big
+	EvmKeyBudgetMaxTxs                    null.Int
+	EvmKeyBudgetWindow                    *models.Duration
 	EvmLogBackfillBatchSize               null.Int
 	EvmMaxGasPriceWei                     *utils.Big
 	EvmNonceAutoSync                      null.Bool
+	EvmPollInterval                       *models.Duration
 	EvmRPCDefaultBatchSize                null.Int
 	FlagsContractAddress                  null.String
 	GasEstimatorMode                      null.String
@@ -96,6 +96,29 @@ func NewApp(client *Client) *cli.App {
 							Name:  "block-number",
 							Usage: "Block number to replay from",
 						},
+						cli.IntFlag{
+							Name:  "job-id",
+							Usage: "Replay the logs to the listeners of this job only",
+						},
+						cli.StringFlag{
+							Name:  "contract",
+							Usage: "With --job-id, replay the logs of this contract only",
+						},
+						cli.BoolFlag{
+							Name:  "ignore-consumed",
+							Usage: "With --job-id, have the job process the logs again even if it consumed them already",
+						},
+					},
+				},
+				{
+					Name:   "replays",
+					Usage:  "List the latest job replays and their progress",
+					Action: client.ListJobReplays,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "job-id",
+							Usage: "List the replays of this job only",
+						},
 					},
 				},
 			},
@@ -227,6 +250,17 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Create a job",
 					Action: client.CreateJob,
 				},
+				{
+					Name:   "simulate",
+					Usage:  "Run the pipeline of a job spec once without saving it, stubbing out tasks with side effects",
+					Action: client.SimulateJob,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "vars",
+							Usage: "JSON object or filepath of the variables to run the pipeline with, e.g. {\"jobRun\": {\"requestBody\": \"...\"}}",
+						},
+					},
+				},
 				{
 					Name:   "delete",
 					Usage:  "Delete a job",
@@ -237,6 +271,45 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:  "runs",
+					Usage: "Commands for managing job runs",
+					Subcommands: []cli.Command{
+						{
+							Name:   "cancel",
+							Usage:  "Cancel a running or suspended job run",
+							Action: client.CancelPipelineRuns,
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "suspended",
+									Usage: "cancel all suspended runs of the job instead of a single run",
+								},
+							},
+						},
+						{
+							Name:   "retry",
+							Usage:  "Retry an errored job run in a new run, keeping the results of the tasks which are not retried",
+							Action: client.RetryPipelineRun,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "task",
+									Usage: "dot ID of the task to retry from, defaults to the tasks which errored",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:   "upkeep-eligibility",
+					Usage:  "Explain which keeper is responsible for an upkeep of a keeper job, and whether this node would perform it, e.g. `jobs upkeep-eligibility JOB_ID UPKEEP_ID`",
+					Action: client.ShowUpkeepEligibility,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:  "block-number",
+							Usage: "block to explain the eligibility at, defaults to the latest block",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -271,6 +344,18 @@ func NewApp(client *Client) *cli.App {
 									Name:  "maxGasPriceGWei",
 									Usage: "Maximum gas price (GWei) for the specified key.",
 								},
+								cli.DurationFlag{
+									Name:  "budgetWindow",
+									Usage: "Rolling window of the spending budget of the specified key, e.g. 24h.",
+								},
+								cli.StringFlag{
+									Name:  "budgetMaxSpendWei",
+									Usage: "Maximum amount (Wei) the specified key may spend within the budget window. Zero removes the limit.",
+								},
+								cli.Uint64Flag{
+									Name:  "budgetMaxTxs",
+									Usage: "Maximum number of transactions the specified key may send within the budget window. Zero removes the limit.",
+								},
 							},
 						},
 						{
@@ -755,6 +840,11 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "cancel",
+					Usage:  "Cancel an unstarted or unconfirmed Ethereum Transaction, given the hash of one of its attempts or its ID",
+					Action: client.CancelTransaction,
+				},
 			},
 		},
 		{
@@ -816,7 +906,7 @@ func NewApp(client *Client) *cli.App {
 						},
 						cli.StringFlag{
 							Name:  "ws-url",
-							Usage: "Websocket URL",
+							Usage: "Websocket URL, optional for a primary node if an HTTP URL is given",
 						},
 						cli.StringFlag{
 							Name:  "http-url",
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type JobReplayPresenter struct {
+	presenters.JobReplayResource
+}
+
+var jobReplayHeaders = []string{"ID", "Job ID", "Contract", "From", "To", "Replayed up to", "Logs delivered", "Ignore consumed", "State", "Error"}
+
+// RenderTable implements TableRenderer
+func (p *JobReplayPresenter) RenderTable(rt RendererTable) error {
+	renderList(jobReplayHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+func (p *JobReplayPresenter) ToRow() []string {
+	contract := "all"
+	if p.Contract != nil {
+		contract = p.Contract.Hex()
+	}
+	replayedUpTo := ""
+	if p.BlockNumber.Valid {
+		replayedUpTo = strconv.FormatInt(p.BlockNumber.Int64, 10)
+	}
+	return []string{
+		p.GetID(),
+		strconv.FormatInt(int64(p.JobID), 10),
+		contract,
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		replayedUpTo,
+		strconv.FormatInt(p.LogsDelivered, 10),
+		strconv.FormatBool(p.IgnoreConsumed),
+		string(p.State),
+		p.Error,
+	}
+}
+
+type JobReplayPresenters []JobReplayPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobReplayPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(jobReplayHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListJobReplays lists the latest job replays with their progress, only those
+// of a job with --job-id
+func (cli *Client) ListJobReplays(c *cli.Context) (err error) {
+	path := "/v2/replays"
+	if c.IsSet("job-id") {
+		path += "?jobID=" + strconv.FormatInt(c.Int64("job-id"), 10)
+	}
+	resp, err := cli.HTTP.Get(path, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobReplayPresenters{}, "Job Replays")
+}
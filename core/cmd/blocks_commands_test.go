@@ -0,0 +1,57 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestJobReplayPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		contract = cltest.NewAddress()
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.JobReplayPresenter{
+		JobReplayResource: presenters.JobReplayResource{
+			JAID:          presenters.NewJAIDInt64(7),
+			JobID:         42,
+			Contract:      &contract,
+			FromBlock:     100,
+			ToBlock:       200,
+			BlockNumber:   clnull.Int64From(150),
+			LogsDelivered: 3,
+			State:         log.JobReplayStateInProgress,
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, contract.Hex())
+	assert.Contains(t, output, "150")
+	assert.Contains(t, output, string(log.JobReplayStateInProgress))
+
+	// Render many resources
+	buffer.Reset()
+	p2 := p
+	p2.Contract = nil
+	ps := cmd.JobReplayPresenters{p, p2}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, contract.Hex())
+	assert.Contains(t, output, "all")
+}
@@ -18,14 +18,24 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyCacheTTL converts the cache TTL to a string
+func (p *BridgePresenter) FriendlyCacheTTL() string {
+	if p.CacheTTL.IsZero() {
+		return "disabled"
+	}
+	return p.CacheTTL.Duration().String()
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Cache TTL", "Cache Hits"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.FriendlyCacheTTL(),
+		strconv.FormatInt(p.CacheHits, 10),
 	})
 	render("Bridge", table)
 	return nil
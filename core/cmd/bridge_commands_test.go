@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,6 +35,8 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			URL:           url,
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
+			CacheTTL:      models.Interval(30 * time.Second),
+			CacheHits:     42,
 			CreatedAt:     createdAt,
 		},
 	}
@@ -46,6 +49,8 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "30s")
+	assert.Contains(t, output, "42")
 
 	// Render many resources
 	buffer.Reset()
@@ -30,10 +30,31 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		p.MaxGasPriceWei.String(),
+		p.budgetString(),
 	}
 }
 
-var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Created", "Updated", "Max Gas Price Wei"}
+// budgetString summarises the spending budget of the key and its usage
+func (p *EthKeyPresenter) budgetString() string {
+	b := p.Budget
+	if b == nil {
+		return ""
+	}
+	var parts []string
+	if b.MaxSpendWei != nil {
+		parts = append(parts, fmt.Sprintf("%s/%s wei", b.SpentWei.String(), b.MaxSpendWei.String()))
+	}
+	if b.MaxTxs > 0 {
+		parts = append(parts, fmt.Sprintf("%d/%d txs", b.Txs, b.MaxTxs))
+	}
+	s := fmt.Sprintf("%s per %s", strings.Join(parts, ", "), b.Window.String())
+	if b.TrippedAt != nil {
+		s += fmt.Sprintf(" (exceeded since %s)", b.TrippedAt.String())
+	}
+	return s
+}
+
+var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Created", "Updated", "Max Gas Price Wei", "Budget"}
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
@@ -115,9 +136,12 @@ func (cli *Client) UpdateETHKey(c *cli.Context) (err error) {
 	}
 
 	query := updateUrl.Query()
-	if c.IsSet("maxGasPriceGWei") {
-		query.Set("maxGasPriceGWei", c.String("maxGasPriceGWei"))
-	} else {
+	for _, param := range []string{"maxGasPriceGWei", "budgetWindow", "budgetMaxSpendWei", "budgetMaxTxs"} {
+		if c.IsSet(param) {
+			query.Set(param, c.String(param))
+		}
+	}
+	if len(query) == 0 {
 		return cli.errorOut(errors.New("Must pass at least one parameter to update"))
 	}
 
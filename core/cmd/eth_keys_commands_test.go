@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -51,6 +52,13 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 			CreatedAt:      createdAt,
 			UpdatedAt:      updatedAt,
 			MaxGasPriceWei: *maxGasPriceWei,
+			Budget: &presenters.ETHKeyBudget{
+				Window:      models.MustMakeDuration(time.Hour),
+				MaxSpendWei: utils.NewBigI(1000),
+				MaxTxs:      20,
+				SpentWei:    *utils.NewBigI(500),
+				Txs:         10,
+			},
 		},
 	}
 
@@ -65,6 +73,7 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.String())
 	assert.Contains(t, output, updatedAt.String())
 	assert.Contains(t, output, maxGasPriceWei.String())
+	assert.Contains(t, output, "500/1000 wei, 10/20 txs per 1h0m0s")
 
 	// Render many resources
 	buffer.Reset()
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"time"
 
 	"github.com/pkg/errors"
@@ -250,3 +251,113 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// RetryPipelineRun retries an errored job run from the task given with --task,
+// or from its errored tasks
+func (cli *Client) RetryPipelineRun(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the run id"))
+	}
+
+	request, err := json.Marshal(web.RetryPipelineRunRequest{
+		DotID: c.String("task"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/runs/"+c.Args().Get(1)+"/retry", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	return cli.renderAPIResponse(resp, &run, "Pipeline run retried")
+}
+
+// SimulateJob validates a job spec and executes its pipeline once against live
+// data, without saving the job or the run. Tasks with side effects are stubbed.
+func (cli *Client) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	var vars map[string]interface{}
+	if c.IsSet("vars") {
+		buf, verr := getBufferFromJSON(c.String("vars"))
+		if verr != nil {
+			return cli.errorOut(verr)
+		}
+		if verr = json.Unmarshal(buf.Bytes(), &vars); verr != nil {
+			return cli.errorOut(errors.Wrap(verr, "vars must be a JSON object"))
+		}
+	}
+
+	request, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlString,
+		Vars: vars,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/simulate", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var sim presenters.JobSimulationResource
+	return cli.renderAPIResponse(resp, &sim, "Job simulated")
+}
+
+// CancelPipelineRuns cancels a running or suspended job run, or with
+// --suspended, all suspended runs of the job
+func (cli *Client) CancelPipelineRuns(c *cli.Context) (err error) {
+	jobID := c.Args().First()
+	if jobID == "" {
+		return cli.errorOut(errors.New("must pass the job id"))
+	}
+
+	var resp *http.Response
+	if c.Bool("suspended") {
+		if c.NArg() > 1 {
+			return cli.errorOut(errors.New("cannot pass a run id together with --suspended"))
+		}
+		resp, err = cli.HTTP.Delete("/v2/jobs/" + jobID + "/runs?state=" + string(pipeline.RunStatusSuspended))
+	} else {
+		if c.NArg() != 2 {
+			return cli.errorOut(errors.New("must pass the job id and the run id, or --suspended to cancel all suspended runs"))
+		}
+		resp, err = cli.HTTP.Delete("/v2/jobs/" + jobID + "/runs/" + c.Args().Get(1))
+	}
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if c.Bool("suspended") {
+		var runs []presenters.PipelineRunResource
+		return cli.renderAPIResponse(resp, &runs, "Suspended pipeline runs cancelled")
+	}
+	var run presenters.PipelineRunResource
+	return cli.renderAPIResponse(resp, &run, "Pipeline run cancelled")
+}
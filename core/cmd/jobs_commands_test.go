@@ -3,6 +3,7 @@ package cmd_test
 import (
 	"bytes"
 	"flag"
+	"strconv"
 	"testing"
 	"time"
 
@@ -391,3 +392,88 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestClient_CancelPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	jobID := strconv.Itoa(int(jb.ID))
+
+	cancel := func(args ...string) error {
+		set := flag.NewFlagSet("test", 0)
+		set.Bool("suspended", false, "")
+		require.NoError(t, set.Parse(args))
+		return client.CancelPipelineRuns(cli.NewContext(nil, set, nil))
+	}
+
+	assert.EqualError(t, cancel(), "must pass the job id")
+	assert.EqualError(t, cancel(jobID), "must pass the job id and the run id, or --suspended to cancel all suspended runs")
+	assert.EqualError(t, cancel("--suspended", jobID, "1"), "cannot pass a run id together with --suspended")
+
+	require.NoError(t, cancel("--suspended", jobID))
+	require.Len(t, r.Renders, 1)
+	assert.Empty(t, *r.Renders[0].(*[]presenters.PipelineRunResource))
+
+	err := cancel(jobID, "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pipeline run not found")
+}
+
+func TestClient_RetryPipelineRun(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	jobID := strconv.Itoa(int(jb.ID))
+
+	retry := func(args ...string) error {
+		set := flag.NewFlagSet("test", 0)
+		set.String("task", "", "")
+		require.NoError(t, set.Parse(args))
+		return client.RetryPipelineRun(cli.NewContext(nil, set, nil))
+	}
+
+	assert.EqualError(t, retry(), "must pass the job id and the run id")
+	assert.EqualError(t, retry(jobID), "must pass the job id and the run id")
+
+	err := retry("--task", "ds1", jobID, "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pipeline run not found")
+}
+
+func TestClient_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	tomlStr := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    parse    [type=jsonparse path="price" data="$(jobRun.requestBody)"];
+    multiply [type=multiply input="$(parse)" times="10"];
+    parse -> multiply;
+"""
+`
+	set := flag.NewFlagSet("test", 0)
+	set.String("vars", "", "")
+	require.NoError(t, set.Parse([]string{"--vars", `{"jobRun": {"requestBody": "{\"price\": 3}"}}`, tomlStr}))
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	sim := *r.Renders[0].(*presenters.JobSimulationResource)
+	require.Len(t, sim.Outputs, 1)
+	assert.Equal(t, "30", *sim.Outputs[0])
+	requireJobsCount(t, app.JobORM(), 0)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("vars", "", "")
+	require.NoError(t, set.Parse([]string{"--vars", `[1, 2]`, tomlStr}))
+	assert.Error(t, client.SimulateJob(cli.NewContext(nil, set, nil)))
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type UpkeepEligibilityPresenter struct {
+	presenters.UpkeepEligibilityResource
+}
+
+var upkeepEligibilityHeaders = []string{
+	"Job ID", "Upkeep ID", "Registry", "Block", "Turn start block", "Responsible keeper", "Our keeper index",
+	"Our turn", "Last run block", "Grace period", "Eligible", "Reason", "Check simulated", "Check error",
+	"Action", "Last check block", "Last check outcome", "Last check error", "Last check revert data", "Last check at", "Last perform tx ID",
+}
+
+// RenderTable implements TableRenderer
+func (p *UpkeepEligibilityPresenter) RenderTable(rt RendererTable) error {
+	renderList(upkeepEligibilityHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+func (p *UpkeepEligibilityPresenter) ToRow() []string {
+	checkError := p.CheckError
+	if p.CheckSimulated && p.CheckSucceeded {
+		checkError = "none, checkUpkeep succeeded"
+	}
+	lastCheckBlock, lastPerformTxID, lastCheckAt := "", "", ""
+	if p.LastCheckBlockNumber.Valid {
+		lastCheckBlock = strconv.FormatInt(p.LastCheckBlockNumber.Int64, 10)
+	}
+	if p.LastPerformEthTxID.Valid {
+		lastPerformTxID = strconv.FormatInt(p.LastPerformEthTxID.Int64, 10)
+	}
+	if p.LastCheckAt.Valid {
+		lastCheckAt = p.LastCheckAt.Time.String()
+	}
+	lastCheckRevertData := ""
+	if len(p.LastCheckRevertData) > 0 {
+		lastCheckRevertData = p.LastCheckRevertData.String()
+	}
+	return []string{
+		strconv.FormatInt(int64(p.JobID), 10),
+		strconv.FormatInt(p.UpkeepID, 10),
+		p.RegistryAddress.Hex(),
+		strconv.FormatInt(p.BlockNumber, 10),
+		strconv.FormatInt(p.TurnStartBlock, 10),
+		fmt.Sprintf("%d of %d", p.ResponsibleKeeperIndex, p.NumKeepers),
+		strconv.FormatInt(int64(p.KeeperIndex), 10),
+		strconv.FormatBool(p.IsOurTurn),
+		strconv.FormatInt(p.LastRunBlockHeight, 10),
+		strconv.FormatInt(p.GracePeriod, 10),
+		strconv.FormatBool(p.Eligible),
+		p.Reason,
+		strconv.FormatBool(p.CheckSimulated),
+		checkError,
+		string(p.Action),
+		lastCheckBlock,
+		p.LastCheckOutcome.String,
+		p.LastCheckError.String,
+		lastCheckRevertData,
+		lastCheckAt,
+		lastPerformTxID,
+	}
+}
+
+// ShowUpkeepEligibility explains which keeper is responsible for an upkeep of
+// a keeper job, and whether the node would perform it
+func (cli *Client) ShowUpkeepEligibility(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must provide the id of the job and of the upkeep"))
+	}
+	jobID, upkeepID := c.Args().Get(0), c.Args().Get(1)
+	params := url.Values{}
+	if c.IsSet("block-number") {
+		params.Set("blockNumber", strconv.FormatInt(c.Int64("block-number"), 10))
+	}
+	path := fmt.Sprintf("/v2/jobs/%s/upkeeps/%s/eligibility", url.PathEscape(jobID), url.PathEscape(upkeepID))
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	resp, err := cli.HTTP.Get(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UpkeepEligibilityPresenter{}, "Upkeep Eligibility")
+}
@@ -0,0 +1,60 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestUpkeepEligibilityPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		registry = cltest.NewEIP55Address()
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.UpkeepEligibilityPresenter{
+		UpkeepEligibilityResource: presenters.UpkeepEligibilityResource{
+			JAID:                   presenters.NewJAIDInt64(3),
+			JobID:                  42,
+			UpkeepID:               3,
+			RegistryAddress:        registry,
+			BlockNumber:            125,
+			NumKeepers:             4,
+			TurnStartBlock:         120,
+			ResponsibleKeeperIndex: 2,
+			KeeperIndex:            2,
+			IsOurTurn:              true,
+			Eligible:               true,
+			Reason:                 "it is our turn to perform the upkeep",
+			CheckSimulated:         true,
+			CheckError:             "execution reverted: upkeep not needed",
+			Action:                 keeper.UpkeepActionSkip,
+			LastCheckBlockNumber:   null.IntFrom(100),
+			LastCheckOutcome:       null.StringFrom(string(keeper.UpkeepCheckPerformed)),
+			LastCheckRevertData:    []byte{0xde, 0xad},
+			LastPerformEthTxID:     null.IntFrom(77),
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, registry.Hex())
+	assert.Contains(t, output, "2 of 4")
+	assert.Contains(t, output, "upkeep not needed")
+	assert.Contains(t, output, string(keeper.UpkeepActionSkip))
+	assert.Contains(t, output, string(keeper.UpkeepCheckPerformed))
+	assert.Contains(t, output, "0xdead")
+	assert.Contains(t, output, "77")
+}
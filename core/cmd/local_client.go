@@ -32,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/health"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/static"
@@ -107,6 +108,21 @@ func (cli *Client) RunNode(c *clipkg.Context) error {
 		}
 	}
 
+	if signerURL := cli.Config.EthRemoteSignerURL(); signerURL != nil {
+		addresses, err2 := cli.Config.EthRemoteSignerAddresses()
+		if err2 != nil {
+			return cli.errorOut(err2)
+		}
+		signer, err2 := keystore.NewJSONRPCSigner(signerURL, addresses)
+		if err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "failed to create remote signer"))
+		}
+		if err2 = keyStore.Eth().AddRemoteSigner(signer, dflt.ID()); err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "failed to add remote signer"))
+		}
+		lggr.Infow("Transactions of remote signer addresses will be signed remotely", "url", signerURL.Redacted(), "addresses", addresses, "evmChainID", dflt.ID())
+	}
+
 	ocrKey, didExist, err := app.GetKeyStore().OCR().EnsureKey()
 	if err != nil {
 		return cli.errorOut(errors.Wrap(err, "failed to ensure ocr key"))
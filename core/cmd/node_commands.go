@@ -25,6 +25,7 @@ func (p *NodePresenter) ToRow() []string {
 		p.EVMChainID.ToInt().String(),
 		p.WSURL.ValueOrZero(),
 		p.HTTPURL.ValueOrZero(),
+		p.State,
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 	}
@@ -33,7 +34,7 @@ func (p *NodePresenter) ToRow() []string {
 
 // RenderTable implements TableRenderer
 func (p NodePresenter) RenderTable(rt RendererTable) error {
-	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "Created", "Updated"}
+	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "HTTP URL", "State", "Created", "Updated"}
 	rows := [][]string{}
 	rows = append(rows, p.ToRow())
 	renderList(headers, rows, rt.Writer)
@@ -45,7 +46,7 @@ type NodePresenters []NodePresenter
 
 // RenderTable implements TableRenderer
 func (ps NodePresenters) RenderTable(rt RendererTable) error {
-	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "Created", "Updated"}
+	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "HTTP URL", "State", "Created", "Updated"}
 	rows := [][]string{}
 
 	for _, p := range ps {
@@ -79,8 +80,8 @@ func (cli *Client) CreateNode(c *cli.Context) (err error) {
 	if t != "primary" && t != "sendonly" {
 		return cli.errorOut(errors.New("invalid or unspecified --type, must be either primary or sendonly"))
 	}
-	if t == "primary" && ws == "" {
-		return cli.errorOut(errors.New("missing --ws-url"))
+	if t == "primary" && ws == "" && httpURLStr == "" {
+		return cli.errorOut(errors.New("missing --ws-url or --http-url"))
 	}
 	var httpURL = null.NewString(httpURLStr, true)
 	if httpURLStr == "" {
@@ -119,7 +119,8 @@ func (cli *Client) getPage(requestURI string, page int, model interface{}) (err
 	return err
 }
 
-// ReplayFromBlock replays chain data from the given block number until the most recent
+// ReplayFromBlock replays chain data from the given block number until the most recent.
+// With --job-id, the logs are replayed to the listeners of that job only.
 func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 
 	blockNumber := c.Int64("block-number")
@@ -127,9 +128,26 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 		return cli.errorOut(errors.New("Must pass a positive value in '--block-number' parameter"))
 	}
 
+	params := url.Values{}
+	if c.IsSet("job-id") {
+		params.Set("jobID", strconv.FormatInt(c.Int64("job-id"), 10))
+		if c.IsSet("contract") {
+			params.Set("contract", c.String("contract"))
+		}
+		if c.Bool("ignore-consumed") {
+			params.Set("ignoreConsumed", "true")
+		}
+	} else if c.IsSet("contract") || c.IsSet("ignore-consumed") {
+		return cli.errorOut(errors.New("'--contract' and '--ignore-consumed' require '--job-id'"))
+	}
+
 	buf := bytes.NewBufferString("{}")
 
-	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_from_block/%v", blockNumber), buf)
+	path := fmt.Sprintf("/v2/replay_from_block/%v", blockNumber)
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	resp, err := cli.HTTP.Post(path, buf)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -148,6 +166,9 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 		return cli.errorOut(errors.New(string(bytes)))
 	}
 
+	if c.IsSet("job-id") {
+		return cli.renderAPIResponse(resp, &JobReplayPresenter{}, "Job replay started")
+	}
 	err = cli.printResponseBody(resp)
 	return err
 }
@@ -136,6 +136,37 @@ func TestClient_ReplayBlocks(t *testing.T) {
 	assert.NoError(t, client.ReplayFromBlock(c))
 }
 
+func TestClient_ReplayBlocks_Job(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t,
+		withConfigSet(func(c *configtest.TestGeneralConfig) {
+			c.Overrides.EVMDisabled = null.BoolFrom(false)
+			c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+			c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+			c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+		}))
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.String("contract", "", "")
+	require.NoError(t, set.Set("contract", cltest.NewAddress().Hex()))
+	c := cli.NewContext(nil, set, nil)
+	assert.EqualError(t, client.ReplayFromBlock(c), "'--contract' and '--ignore-consumed' require '--job-id'")
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.Int64("job-id", 0, "")
+	require.NoError(t, set.Set("job-id", "1"))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ReplayFromBlock(c), "expected an error for a job without log listeners")
+
+	require.NoError(t, client.ListJobReplays(cli.NewContext(nil, flag.NewFlagSet("flagset", 0), nil)))
+	require.Len(t, r.Renders, 1)
+	assert.Empty(t, *r.Renders[0].(*cmd.JobReplayPresenters))
+}
+
 func TestClient_CreateExternalInitiator(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/olekukonko/tablewriter"
@@ -62,7 +63,11 @@ func (rt RendererTable) Render(v interface{}, headers ...string) error {
 	case *config.ConfigPrinter:
 		return rt.renderConfiguration(*typed)
 	case *webpresenters.PipelineRunResource:
-		return rt.renderPipelineRun(*typed)
+		return rt.renderPipelineRuns([]webpresenters.PipelineRunResource{*typed})
+	case *[]webpresenters.PipelineRunResource:
+		return rt.renderPipelineRuns(*typed)
+	case *webpresenters.JobSimulationResource:
+		return rt.renderJobSimulation(*typed)
 	case *webpresenters.ServiceLogConfigResource:
 		return rt.renderLogPkgConfig(*typed)
 	case *[]VRFKeyPresenter:
@@ -215,21 +220,69 @@ func (rt RendererTable) renderConfigPatchResponse(config *web.ConfigPatchRespons
 	return nil
 }
 
-func (rt RendererTable) renderPipelineRun(run webpresenters.PipelineRunResource) error {
-	table := rt.newTable([]string{"ID", "Created At", "Finished At"})
+func (rt RendererTable) renderPipelineRuns(runs []webpresenters.PipelineRunResource) error {
+	table := rt.newTable([]string{"ID", "State", "Created At", "Finished At", "Retry Of"})
 
-	var finishedAt string
-	if !run.FinishedAt.IsZero() {
-		finishedAt = run.FinishedAt.String()
+	for _, run := range runs {
+		var finishedAt string
+		if !run.FinishedAt.IsZero() {
+			finishedAt = run.FinishedAt.String()
+		}
+		var retryOf string
+		if run.RetryOfRunID.Valid {
+			retryOf = strconv.FormatInt(run.RetryOfRunID.Int64, 10)
+		}
+
+		row := []string{
+			run.GetID(),
+			string(run.State),
+			run.CreatedAt.String(),
+			finishedAt,
+			retryOf,
+		}
+		table.Append(row)
 	}
 
-	row := []string{
-		run.GetID(),
-		run.CreatedAt.String(),
-		finishedAt,
+	title := "Pipeline Run"
+	if len(runs) != 1 {
+		title = "Pipeline Runs"
 	}
-	table.Append(row)
+	render(title, table)
+	return nil
+}
 
-	render("Pipeline Run", table)
+func (rt RendererTable) renderJobSimulation(sim webpresenters.JobSimulationResource) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error", "Duration", "Stubbed"})
+	for _, tr := range sim.TaskRuns {
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			stringOrEmpty(tr.Output),
+			stringOrEmpty(tr.Error),
+			tr.Duration.Duration().String(),
+			strconv.FormatBool(tr.Stubbed),
+		})
+	}
+	render("Simulated Task Runs", table)
+
+	table = rt.newTable([]string{"Output", "Error"})
+	for i := range sim.FatalErrors {
+		var output *string
+		if i < len(sim.Outputs) {
+			output = sim.Outputs[i]
+		}
+		table.Append([]string{
+			stringOrEmpty(output),
+			stringOrEmpty(sim.FatalErrors[i]),
+		})
+	}
+	render("Simulation Results", table)
 	return nil
 }
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -5,10 +5,13 @@ import (
 	"io/ioutil"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web"
 	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
@@ -112,6 +115,56 @@ func TestRendererTable_PatchResponse(t *testing.T) {
 	assert.Regexp(t, regexp.MustCompile("53276"), output)
 }
 
+func TestRendererTable_RenderPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	runs := []webpresenters.PipelineRunResource{
+		{JAID: webpresenters.NewJAIDInt64(1), State: pipeline.RunStatusCancelled},
+		{JAID: webpresenters.NewJAIDInt64(2), State: pipeline.RunStatusSuspended},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	assert.NoError(t, r.Render(&runs[0]))
+	assert.Regexp(t, regexp.MustCompile(`1\s+║\s+cancelled`), buffer.String())
+
+	buffer.Reset()
+	assert.NoError(t, r.Render(&runs))
+	output := buffer.String()
+	assert.Regexp(t, regexp.MustCompile(`1\s+║\s+cancelled`), output)
+	assert.Regexp(t, regexp.MustCompile(`2\s+║\s+suspended`), output)
+}
+
+func TestRendererTable_RenderJobSimulation(t *testing.T) {
+	t.Parallel()
+
+	output := `"30"`
+	result := "30"
+	sim := webpresenters.JobSimulationResource{
+		JAID:    webpresenters.NewJAID("simulation"),
+		Outputs: []*string{&result},
+		TaskRuns: []webpresenters.JobSimulationTaskRunResource{
+			{
+				PipelineTaskRunResource: webpresenters.PipelineTaskRunResource{DotID: "multiply", Type: pipeline.TaskTypeMultiply, Output: &output},
+				Duration:                models.Interval(2 * time.Millisecond),
+			},
+			{
+				PipelineTaskRunResource: webpresenters.PipelineTaskRunResource{DotID: "submit", Type: pipeline.TaskTypeETHTx},
+				Stubbed:                 true,
+			},
+		},
+		FatalErrors: []*string{nil},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	assert.NoError(t, r.Render(&sim))
+	rendered := buffer.String()
+	assert.Regexp(t, regexp.MustCompile(`multiply\s+║\s+multiply\s+║\s+"30"\s+║\s+║\s+2ms\s+║\s+false`), rendered)
+	assert.Regexp(t, regexp.MustCompile(`submit\s+║\s+ethtx\s+║\s+║\s+║\s+0s\s+║\s+true`), rendered)
+	assert.Regexp(t, regexp.MustCompile(`30\s+║`), rendered)
+}
+
 func TestRendererTable_RenderUnknown(t *testing.T) {
 	t.Parallel()
 	r := cmd.RendererTable{Writer: ioutil.Discard}
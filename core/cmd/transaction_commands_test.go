@@ -2,6 +2,7 @@ package cmd_test
 
 import (
 	"flag"
+	"strconv"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
@@ -141,3 +142,27 @@ func TestClient_SendEther_From_BPTXM(t *testing.T) {
 	assert.Equal(t, &etx.ToAddress, output.To)
 	assert.Equal(t, etx.Value.String(), output.Value)
 }
+
+func TestClient_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	db := app.GetDB()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	tx := cltest.MustInsertUnstartedEthTx(t, db, from)
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	set.Parse([]string{strconv.FormatInt(tx.ID, 10)})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CancelTransaction(c))
+
+	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, string(bulletprooftxmanager.EthTxFatalError), renderedTx.State)
+
+	set = flag.NewFlagSet("test cancel tx", 0)
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.CancelTransaction(c))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,26 @@ func TestGeneralConfig_sessionSecret(t *testing.T) {
 	require.Equal(t, initial, second)
 }
 
+func TestGeneralConfig_EthRemoteSignerAddresses(t *testing.T) {
+	t.Parallel()
+
+	config := NewGeneralConfig().(*generalConfig)
+	config.viper.Set(EnvVarName("EthRemoteSignerAddresses"), "")
+	addresses, err := config.EthRemoteSignerAddresses()
+	require.NoError(t, err)
+	assert.Empty(t, addresses)
+
+	config.viper.Set(EnvVarName("EthRemoteSignerAddresses"), "0x0000000000000000000000000000000000000001, 0x0000000000000000000000000000000000000002")
+	addresses, err = config.EthRemoteSignerAddresses()
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}, addresses)
+
+	config.viper.Set(EnvVarName("EthRemoteSignerAddresses"), "0x0000000000000000000000000000000000000001,0x2")
+	_, err = config.EthRemoteSignerAddresses()
+	require.Error(t, err)
+	assert.Equal(t, ErrInvalid, errors.Cause(err))
+}
+
 func TestConfig_readFromFile(t *testing.T) {
 	v := viper.New()
 	v.Set("ROOT", "../../tools/clroot/")
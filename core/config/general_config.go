@@ -11,9 +11,11 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -73,6 +75,8 @@ type GeneralOnlyConfig interface {
 	DefaultMaxHTTPAttempts() uint
 	Dev() bool
 	EVMDisabled() bool
+	EthRemoteSignerAddresses() ([]common.Address, error)
+	EthRemoteSignerURL() *url.URL
 	EthereumDisabled() bool
 	EthereumHTTPURL() *url.URL
 	EthereumSecondaryURLs() []url.URL
@@ -97,6 +101,7 @@ type GeneralOnlyConfig interface {
 	JobPipelineReaperInterval() time.Duration
 	JobPipelineReaperThreshold() time.Duration
 	JobPipelineResultWriteQueueDepth() uint64
+	JobPipelineSecretsFile() string
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperGasPriceBufferPercent() uint32
 	KeeperGasTipCapBufferPercent() uint32
@@ -114,6 +119,9 @@ type GeneralOnlyConfig interface {
 	LogToDisk() bool
 	LogUnixTimestamps() bool
 	MigrateDatabase() bool
+	NodePollInterval() time.Duration
+	NodeSelectionMode() string
+	NodeSyncThreshold() uint32
 	OCRBlockchainTimeout() time.Duration
 	OCRBootstrapCheckInterval() time.Duration
 	OCRContractPollInterval() time.Duration
@@ -193,12 +201,16 @@ type GlobalConfig interface {
 	GlobalBlockHistoryEstimatorBlockDelay() (uint16, bool)
 	GlobalBlockHistoryEstimatorBlockHistorySize() (uint16, bool)
 	GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool)
+	GlobalEthTxBatchContractAddress() (string, bool)
+	GlobalEthTxBatchMaxSize() (uint32, bool)
+	GlobalEthTxBatchWindow() (time.Duration, bool)
 	GlobalEthTxReaperInterval() (time.Duration, bool)
 	GlobalEthTxReaperThreshold() (time.Duration, bool)
 	GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	GlobalEvmDefaultBatchSize() (uint32, bool)
 	GlobalEvmEIP1559DynamicFees() (bool, bool)
 	GlobalEvmFinalityDepth() (uint32, bool)
+	GlobalEvmFinalityTagsEnabled() (bool, bool)
 	GlobalEvmGasBumpPercent() (uint16, bool)
 	GlobalEvmGasBumpThreshold() (uint64, bool)
 	GlobalEvmGasBumpTxDepth() (uint16, bool)
@@ -218,7 +230,11 @@ type GlobalConfig interface {
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
 	GlobalEvmMinGasPriceWei() (*big.Int, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
+	GlobalEvmPollInterval() (time.Duration, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
+	GlobalFeeHistoryEstimatorBaseFeeBufferBlocks() (uint16, bool)
+	GlobalFeeHistoryEstimatorBlockCount() (uint16, bool)
+	GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
 	GlobalChainType() (string, bool)
@@ -324,6 +340,11 @@ func (c *generalConfig) Validate() error {
 	if _, err := c.OCRTransmitterAddress(); errors.Cause(err) == ErrInvalid {
 		return err
 	}
+	if addresses, err := c.EthRemoteSignerAddresses(); err != nil {
+		return err
+	} else if len(addresses) > 0 && c.EthRemoteSignerURL() == nil {
+		return errors.Errorf("ETH_REMOTE_SIGNER_ADDRESSES requires ETH_REMOTE_SIGNER_URL to be set")
+	}
 	if peers, err := c.P2PBootstrapPeers(); err == nil {
 		for i := range peers {
 			if _, err := multiaddr.NewMultiaddr(peers[i]); err != nil {
@@ -352,6 +373,12 @@ func (c *generalConfig) Validate() error {
 		}
 	}
 
+	switch c.NodeSelectionMode() {
+	case "RoundRobin", "HighestHead", "LowestLatency", "PriorityOrder":
+	default:
+		return errors.Errorf("unrecognised value for NODE_SELECTION_MODE: %s (valid options are 'RoundRobin', 'HighestHead', 'LowestLatency' or 'PriorityOrder')", c.NodeSelectionMode())
+	}
+
 	switch c.DatabaseLockingMode() {
 	case "dual", "lease", "advisorylock", "none":
 	default:
@@ -503,6 +530,26 @@ func (c *generalConfig) MigrateDatabase() bool {
 	return c.viper.GetBool(EnvVarName("MigrateDatabase"))
 }
 
+// NodePollInterval is how often the health of each primary eth node is
+// checked
+func (c *generalConfig) NodePollInterval() time.Duration {
+	return c.getWithFallback("NodePollInterval", ParseDuration).(time.Duration)
+}
+
+// NodeSelectionMode controls which of the healthy primary eth nodes is used
+// for each request. It can be one of 'RoundRobin', 'HighestHead',
+// 'LowestLatency' or 'PriorityOrder'
+func (c *generalConfig) NodeSelectionMode() string {
+	return c.getWithFallback("NodeSelectionMode", ParseString).(string)
+}
+
+// NodeSyncThreshold is the number of blocks a primary eth node may lag behind
+// the highest node in the pool before it is taken out of rotation. Set to 0 to
+// disable the check
+func (c *generalConfig) NodeSyncThreshold() uint32 {
+	return c.getWithFallback("NodeSyncThreshold", ParseUint32).(uint32)
+}
+
 // DefaultMaxHTTPAttempts defines the limit for HTTP requests.
 func (c *generalConfig) DefaultMaxHTTPAttempts() uint {
 	return uint(c.getWithFallback("DefaultMaxHTTPAttempts", ParseUint64).(uint64))
@@ -570,6 +617,36 @@ func (c *generalConfig) EthereumHTTPURL() (uri *url.URL) {
 	return
 }
 
+// EthRemoteSignerURL is the URL of a JSON-RPC server which signs the
+// transactions of the ETH_REMOTE_SIGNER_ADDRESSES with eth_signTransaction
+func (c *generalConfig) EthRemoteSignerURL() *url.URL {
+	rval := c.getWithFallback("EthRemoteSignerURL", ParseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		panic(fmt.Sprintf("invariant: EthRemoteSignerURL returned as type %T", rval))
+	}
+}
+
+// EthRemoteSignerAddresses is the allow-list of the addresses whose
+// transactions are signed by the remote signer, separated by commas. Their
+// private keys are not held by the node.
+func (c *generalConfig) EthRemoteSignerAddresses() (addresses []common.Address, err error) {
+	for _, s := range regexp.MustCompile(`\s*[;,]\s*`).Split(strings.TrimSpace(c.viper.GetString(EnvVarName("EthRemoteSignerAddresses"))), -1) {
+		if s == "" {
+			continue
+		}
+		if !common.IsHexAddress(s) {
+			return nil, errors.Wrapf(ErrInvalid, "ETH_REMOTE_SIGNER_ADDRESSES contains an invalid address: %s", s)
+		}
+		addresses = append(addresses, common.HexToAddress(s))
+	}
+	return addresses, nil
+}
+
 // EthereumSecondaryURLs is an optional backup RPC URL
 // Must be http(s) format
 // If specified, transactions will also be broadcast to this ethereum node
@@ -646,6 +723,12 @@ func (c *generalConfig) JobPipelineReaperThreshold() time.Duration {
 	return c.getWithFallback("JobPipelineReaperThreshold", ParseDuration).(time.Duration)
 }
 
+// JobPipelineSecretsFile points to a JSON file of named secrets which http
+// tasks can reference in their headers as $(secrets.<name>)
+func (c *generalConfig) JobPipelineSecretsFile() string {
+	return c.viper.GetString(EnvVarName("JobPipelineSecretsFile"))
+}
+
 // KeeperRegistryCheckGasOverhead is the amount of extra gas to provide checkUpkeep() calls
 // to account for the gas consumed by the keeper registry
 func (c *generalConfig) KeeperRegistryCheckGasOverhead() uint64 {
@@ -1320,6 +1403,27 @@ func (*generalConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint16
 	}
 	return val.(uint16), ok
 }
+func (*generalConfig) GlobalEthTxBatchContractAddress() (string, bool) {
+	val, ok := lookupEnv(EnvVarName("EthTxBatchContractAddress"), ParseString)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
+func (*generalConfig) GlobalEthTxBatchMaxSize() (uint32, bool) {
+	val, ok := lookupEnv(EnvVarName("EthTxBatchMaxSize"), ParseUint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
+func (*generalConfig) GlobalEthTxBatchWindow() (time.Duration, bool) {
+	val, ok := lookupEnv(EnvVarName("EthTxBatchWindow"), ParseDuration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
 func (*generalConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	val, ok := lookupEnv(EnvVarName("EthTxReaperInterval"), ParseDuration)
 	if val == nil {
@@ -1355,6 +1459,13 @@ func (*generalConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalEvmFinalityTagsEnabled() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmFinalityTagsEnabled"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (*generalConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmGasBumpPercent"), ParseUint16)
 	if val == nil {
@@ -1474,6 +1585,13 @@ func (*generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return val.(bool), ok
 }
+func (*generalConfig) GlobalEvmPollInterval() (time.Duration, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmPollInterval"), ParseDuration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
 func (*generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmRPCDefaultBatchSize"), ParseUint32)
 	if val == nil {
@@ -1481,6 +1599,27 @@ func (*generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalFeeHistoryEstimatorBaseFeeBufferBlocks() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorBaseFeeBufferBlocks"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (*generalConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorBlockCount"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (*generalConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorRewardPercentile"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
 func (*generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	val, ok := lookupEnv(EnvVarName("FlagsContractAddress"), ParseString)
 	if val == nil {
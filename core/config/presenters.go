@@ -42,6 +42,8 @@ type EnvPrinter struct {
 	DefaultHTTPLimit                           int64           `json:"DEFAULT_HTTP_LIMIT"`
 	DefaultHTTPTimeout                         models.Duration `json:"DEFAULT_HTTP_TIMEOUT"`
 	Dev                                        bool            `json:"CHAINLINK_DEV"`
+	EthRemoteSignerAddresses                   []string        `json:"ETH_REMOTE_SIGNER_ADDRESSES"`
+	EthRemoteSignerURL                         string          `json:"ETH_REMOTE_SIGNER_URL"`
 	EthereumDisabled                           bool            `json:"ETH_DISABLED"`
 	EthereumHTTPURL                            string          `json:"ETH_HTTP_URL"`
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
@@ -55,6 +57,7 @@ type EnvPrinter struct {
 	JSONConsole                                bool            `json:"JSON_CONSOLE"`
 	JobPipelineReaperInterval                  time.Duration   `json:"JOB_PIPELINE_REAPER_INTERVAL"`
 	JobPipelineReaperThreshold                 time.Duration   `json:"JOB_PIPELINE_REAPER_THRESHOLD"`
+	JobPipelineSecretsFile                     string          `json:"JOB_PIPELINE_SECRETS_FILE"`
 	KeeperDefaultTransactionQueueDepth         uint32          `json:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
 	KeeperGasPriceBufferPercent                uint32          `json:"KEEPER_GAS_PRICE_BUFFER_PERCENT"`
 	KeeperGasTipCapBufferPercent               uint32          `json:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT"`
@@ -70,6 +73,9 @@ type EnvPrinter struct {
 	LogSQLMigrations                           bool            `json:"LOG_SQL_MIGRATIONS"`
 	LogSQLStatements                           bool            `json:"LOG_SQL"`
 	LogToDisk                                  bool            `json:"LOG_TO_DISK"`
+	NodePollInterval                           time.Duration   `json:"NODE_POLL_INTERVAL"`
+	NodeSelectionMode                          string          `json:"NODE_SELECTION_MODE"`
+	NodeSyncThreshold                          uint32          `json:"NODE_SYNC_THRESHOLD"`
 	OCRBootstrapCheckInterval                  time.Duration   `json:"OCR_BOOTSTRAP_CHECK_INTERVAL"`
 	TriggerFallbackDBPollInterval              time.Duration   `json:"JOB_PIPELINE_DB_POLL_INTERVAL"`
 	OCRContractTransmitterTransmitTimeout      time.Duration   `json:"OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT"`
@@ -115,6 +121,15 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 	if cfg.EthereumHTTPURL() != nil {
 		ethereumHTTPURL = cfg.EthereumHTTPURL().String()
 	}
+	ethRemoteSignerURL := ""
+	if cfg.EthRemoteSignerURL() != nil {
+		ethRemoteSignerURL = cfg.EthRemoteSignerURL().String()
+	}
+	var ethRemoteSignerAddresses []string
+	addresses, _ := cfg.EthRemoteSignerAddresses()
+	for _, address := range addresses {
+		ethRemoteSignerAddresses = append(ethRemoteSignerAddresses, address.Hex())
+	}
 	telemetryIngressURL := ""
 	if cfg.TelemetryIngressURL() != nil {
 		telemetryIngressURL = cfg.TelemetryIngressURL().String()
@@ -134,6 +149,8 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			DefaultHTTPLimit:                      cfg.DefaultHTTPLimit(),
 			DefaultHTTPTimeout:                    cfg.DefaultHTTPTimeout(),
 			Dev:                                   cfg.Dev(),
+			EthRemoteSignerAddresses:              ethRemoteSignerAddresses,
+			EthRemoteSignerURL:                    ethRemoteSignerURL,
 			EthereumDisabled:                      cfg.EthereumDisabled(),
 			EthereumHTTPURL:                       ethereumHTTPURL,
 			EthereumSecondaryURLs:                 mapToStringA(cfg.EthereumSecondaryURLs()),
@@ -146,6 +163,7 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			JSONConsole:                           cfg.JSONConsole(),
 			JobPipelineReaperInterval:             cfg.JobPipelineReaperInterval(),
 			JobPipelineReaperThreshold:            cfg.JobPipelineReaperThreshold(),
+			JobPipelineSecretsFile:                cfg.JobPipelineSecretsFile(),
 			KeeperDefaultTransactionQueueDepth:    cfg.KeeperDefaultTransactionQueueDepth(),
 			KeeperGasPriceBufferPercent:           cfg.KeeperGasPriceBufferPercent(),
 			KeeperGasTipCapBufferPercent:          cfg.KeeperGasTipCapBufferPercent(),
@@ -153,6 +171,9 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			LogSQLMigrations:                      cfg.LogSQLMigrations(),
 			LogSQLStatements:                      cfg.LogSQLStatements(),
 			LogToDisk:                             cfg.LogToDisk(),
+			NodePollInterval:                      cfg.NodePollInterval(),
+			NodeSelectionMode:                     cfg.NodeSelectionMode(),
+			NodeSyncThreshold:                     cfg.NodeSyncThreshold(),
 			OCRBootstrapCheckInterval:             cfg.OCRBootstrapCheckInterval(),
 			OCRContractTransmitterTransmitTimeout: cfg.OCRContractTransmitterTransmitTimeout(),
 			OCRDHTLookupInterval:                  cfg.OCRDHTLookupInterval(),
@@ -49,6 +49,11 @@ type ConfigSchema struct {
 	DefaultMaxHTTPAttempts                     uint                          `env:"MAX_HTTP_ATTEMPTS" default:"5"`
 	Dev                                        bool                          `env:"CHAINLINK_DEV" default:"false"`
 	EVMDisabled                                bool                          `env:"EVM_DISABLED" default:"false"`
+	EthRemoteSignerAddresses                   string                        `env:"ETH_REMOTE_SIGNER_ADDRESSES"`
+	EthRemoteSignerURL                         *url.URL                      `env:"ETH_REMOTE_SIGNER_URL"`
+	EthTxBatchContractAddress                  string                        `env:"ETH_TX_BATCH_CONTRACT_ADDRESS"`
+	EthTxBatchMaxSize                          uint32                        `env:"ETH_TX_BATCH_MAX_SIZE"`
+	EthTxBatchWindow                           time.Duration                 `env:"ETH_TX_BATCH_WINDOW"`
 	EthTxReaperInterval                        time.Duration                 `env:"ETH_TX_REAPER_INTERVAL"`
 	EthTxReaperThreshold                       time.Duration                 `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold                  time.Duration                 `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
@@ -60,6 +65,7 @@ type ConfigSchema struct {
 	EvmDefaultBatchSize                        uint32                        `env:"ETH_DEFAULT_BATCH_SIZE"`
 	EvmEIP1559DynamicFees                      bool                          `env:"EVM_EIP1559_DYNAMIC_FEES"`
 	EvmFinalityDepth                           uint32                        `env:"ETH_FINALITY_DEPTH"`
+	EvmFinalityTagsEnabled                     bool                          `env:"EVM_FINALITY_TAGS_ENABLED"`
 	EvmGasBumpPercent                          uint16                        `env:"ETH_GAS_BUMP_PERCENT"`
 	EvmGasBumpThreshold                        uint64                        `env:"ETH_GAS_BUMP_THRESHOLD"`
 	EvmGasBumpTxDepth                          uint16                        `env:"ETH_GAS_BUMP_TX_DEPTH"`
@@ -79,6 +85,7 @@ type ConfigSchema struct {
 	EvmMaxQueuedTransactions                   uint64                        `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
 	EvmMinGasPriceWei                          *big.Int                      `env:"ETH_MIN_GAS_PRICE_WEI"`
 	EvmNonceAutoSync                           bool                          `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmPollInterval                            time.Duration                 `env:"ETH_POLL_INTERVAL"`
 	EvmRPCDefaultBatchSize                     uint32                        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	ExplorerAccessKey                          string                        `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret                             string                        `env:"EXPLORER_SECRET"`
@@ -89,6 +96,9 @@ type ConfigSchema struct {
 	FeatureOffchainReporting                   bool                          `env:"FEATURE_OFFCHAIN_REPORTING" default:"false"`
 	FeatureUICSAKeys                           bool                          `env:"FEATURE_UI_CSA_KEYS" default:"false"`
 	FeatureUIFeedsManager                      bool                          `env:"FEATURE_UI_FEEDS_MANAGER" default:"false"`
+	FeeHistoryEstimatorBaseFeeBufferBlocks     uint16                        `env:"FEE_HISTORY_ESTIMATOR_BASE_FEE_BUFFER_BLOCKS"`
+	FeeHistoryEstimatorBlockCount              uint16                        `env:"FEE_HISTORY_ESTIMATOR_BLOCK_COUNT"`
+	FeeHistoryEstimatorRewardPercentile        uint16                        `env:"FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE"`
 	FlagsContractAddress                       string                        `env:"FLAGS_CONTRACT_ADDRESS"`
 	GasEstimatorMode                           string                        `env:"GAS_ESTIMATOR_MODE"`
 	GlobalLockRetryInterval                    models.Duration               `env:"GLOBAL_LOCK_RETRY_INTERVAL" default:"1s"`
@@ -100,6 +110,7 @@ type ConfigSchema struct {
 	JobPipelineReaperInterval                  time.Duration                 `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                 time.Duration                 `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
 	JobPipelineResultWriteQueueDepth           uint64                        `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
+	JobPipelineSecretsFile                     string                        `env:"JOB_PIPELINE_SECRETS_FILE"`
 	KeeperDefaultTransactionQueueDepth         uint32                        `env:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
 	KeeperGasPriceBufferPercent                uint32                        `env:"KEEPER_GAS_PRICE_BUFFER_PERCENT" default:"20"`
 	KeeperGasTipCapBufferPercent               uint32                        `env:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT" default:"20"`
@@ -119,6 +130,9 @@ type ConfigSchema struct {
 	MinIncomingConfirmations                   uint32                        `env:"MIN_INCOMING_CONFIRMATIONS"`
 	MinRequiredOutgoingConfirmations           uint64                        `env:"MIN_OUTGOING_CONFIRMATIONS"`
 	MinimumContractPayment                     assets.Link                   `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
+	NodePollInterval                           time.Duration                 `env:"NODE_POLL_INTERVAL" default:"10s"`
+	NodeSelectionMode                          string                        `env:"NODE_SELECTION_MODE" default:"RoundRobin"`
+	NodeSyncThreshold                          uint32                        `env:"NODE_SYNC_THRESHOLD" default:"5"`
 	OCRBlockchainTimeout                       time.Duration                 `env:"OCR_BLOCKCHAIN_TIMEOUT" default:"20s"`
 	OCRBootstrapCheckInterval                  time.Duration                 `env:"OCR_BOOTSTRAP_CHECK_INTERVAL" default:"20s"`
 	OCRContractConfirmations                   uint                          `env:"OCR_CONTRACT_CONFIRMATIONS"`
@@ -42,6 +42,11 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultMaxHTTPAttempts":                     "MAX_HTTP_ATTEMPTS",
 		"Dev":                                        "CHAINLINK_DEV",
 		"EVMDisabled":                                "EVM_DISABLED",
+		"EthRemoteSignerAddresses":                   "ETH_REMOTE_SIGNER_ADDRESSES",
+		"EthRemoteSignerURL":                         "ETH_REMOTE_SIGNER_URL",
+		"EthTxBatchContractAddress":                  "ETH_TX_BATCH_CONTRACT_ADDRESS",
+		"EthTxBatchMaxSize":                          "ETH_TX_BATCH_MAX_SIZE",
+		"EthTxBatchWindow":                           "ETH_TX_BATCH_WINDOW",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
@@ -54,6 +59,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmDefaultBatchSize":                        "ETH_DEFAULT_BATCH_SIZE",
 		"EvmEIP1559DynamicFees":                      "EVM_EIP1559_DYNAMIC_FEES",
 		"EvmFinalityDepth":                           "ETH_FINALITY_DEPTH",
+		"EvmFinalityTagsEnabled":                     "EVM_FINALITY_TAGS_ENABLED",
 		"EvmGasBumpPercent":                          "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                        "ETH_GAS_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                          "ETH_GAS_BUMP_TX_DEPTH",
@@ -73,6 +79,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmMaxQueuedTransactions":                   "ETH_MAX_QUEUED_TRANSACTIONS",
 		"EvmMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
 		"EvmNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
+		"EvmPollInterval":                            "ETH_POLL_INTERVAL",
 		"EvmRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
 		"ExplorerAccessKey":                          "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                             "EXPLORER_SECRET",
@@ -83,6 +90,9 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureOffchainReporting":                   "FEATURE_OFFCHAIN_REPORTING",
 		"FeatureUICSAKeys":                           "FEATURE_UI_CSA_KEYS",
 		"FeatureUIFeedsManager":                      "FEATURE_UI_FEEDS_MANAGER",
+		"FeeHistoryEstimatorBaseFeeBufferBlocks":     "FEE_HISTORY_ESTIMATOR_BASE_FEE_BUFFER_BLOCKS",
+		"FeeHistoryEstimatorBlockCount":              "FEE_HISTORY_ESTIMATOR_BLOCK_COUNT",
+		"FeeHistoryEstimatorRewardPercentile":        "FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE",
 		"FlagsContractAddress":                       "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                           "GAS_ESTIMATOR_MODE",
 		"GasUpdaterBatchSize":                        "GAS_UPDATER_BATCH_SIZE",
@@ -99,6 +109,7 @@ func TestConfigSchema(t *testing.T) {
 		"JobPipelineReaperInterval":                  "JOB_PIPELINE_REAPER_INTERVAL",
 		"JobPipelineReaperThreshold":                 "JOB_PIPELINE_REAPER_THRESHOLD",
 		"JobPipelineResultWriteQueueDepth":           "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
+		"JobPipelineSecretsFile":                     "JOB_PIPELINE_SECRETS_FILE",
 		"KeeperDefaultTransactionQueueDepth":         "KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"KeeperGasPriceBufferPercent":                "KEEPER_GAS_PRICE_BUFFER_PERCENT",
 		"KeeperGasTipCapBufferPercent":               "KEEPER_GAS_TIP_CAP_BUFFER_PERCENT",
@@ -120,6 +131,9 @@ func TestConfigSchema(t *testing.T) {
 		"MinRequiredOutgoingConfirmations":           "MIN_OUTGOING_CONFIRMATIONS",
 		"MinimumContractPayment":                     "MINIMUM_CONTRACT_PAYMENT_LINK_JUELS",
 		"MinimumServiceDuration":                     "MINIMUM_SERVICE_DURATION",
+		"NodePollInterval":                           "NODE_POLL_INTERVAL",
+		"NodeSelectionMode":                          "NODE_SELECTION_MODE",
+		"NodeSyncThreshold":                          "NODE_SYNC_THRESHOLD",
 		"OCRBlockchainTimeout":                       "OCR_BLOCKCHAIN_TIMEOUT",
 		"OCRBootstrapCheckInterval":                  "OCR_BOOTSTRAP_CHECK_INTERVAL",
 		"OCRContractConfirmations":                   "OCR_CONTRACT_CONFIRMATIONS",
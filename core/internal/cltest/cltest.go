@@ -168,7 +168,8 @@ func NewJobPipelineV2(t testing.TB, cfg config.GeneralConfig, cc evm.ChainSet, d
 	lggr := logger.TestLogger(t)
 	prm := pipeline.NewORM(db, lggr)
 	jrm := job.NewORM(db, cc, prm, keyStore, lggr)
-	pr := pipeline.NewRunner(prm, cfg, cc, keyStore.Eth(), keyStore.VRF(), lggr)
+	pr, err := pipeline.NewRunner(prm, cfg, cc, keyStore.Eth(), keyStore.VRF(), lggr)
+	require.NoError(t, err)
 	return JobPipelineV2TestHelper{
 		prm,
 		jrm,
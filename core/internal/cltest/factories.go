@@ -75,8 +75,9 @@ func Random32Byte() (b [32]byte) {
 }
 
 type BridgeOpts struct {
-	Name string
-	URL  string
+	Name     string
+	URL      string
+	CacheTTL time.Duration
 }
 
 // NewBridgeType create new bridge type given info slice
@@ -97,6 +98,8 @@ func NewBridgeType(t testing.TB, opts BridgeOpts) (*bridges.BridgeTypeAuthentica
 	} else {
 		btr.URL = WebURL(t, fmt.Sprintf("https://bridge.example.com/api?%s", rnd))
 	}
+	cacheTTL := models.Interval(opts.CacheTTL)
+	btr.CacheTTL = &cacheTTL
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	require.NoError(t, err)
@@ -361,6 +364,7 @@ func MustInsertEthReceipt(t *testing.T, db *gorm.DB, blockNumber int64, blockHas
 		BlockHash:        blockHash,
 		TxHash:           txHash,
 		TransactionIndex: transactionIndex,
+		Status:           uint64(1),
 	}
 
 	data, err := json.Marshal(receipt)
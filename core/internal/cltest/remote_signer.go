@@ -0,0 +1,119 @@
+package cltest
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// RemoteSignerArgs are the arguments of eth_signTransaction received by a
+// MockRemoteSigner
+type RemoteSignerArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+// MockRemoteSigner is a JSON-RPC server which signs transactions with
+// eth_signTransaction using local keys, standing in for Clef or web3signer
+type MockRemoteSigner struct {
+	*httptest.Server
+	URL  *url.URL
+	keys map[common.Address]ethkey.KeyV2
+
+	// GethStyle makes the signer return {"raw": ..., "tx": ...} like Clef
+	// and geth, instead of the raw transaction like web3signer
+	GethStyle bool
+	// Tamper, if set, modifies the arguments of the transactions before they
+	// are signed
+	Tamper func(args *RemoteSignerArgs)
+}
+
+// NewMockRemoteSigner returns a MockRemoteSigner signing for the given keys
+func NewMockRemoteSigner(t *testing.T, keys ...ethkey.KeyV2) *MockRemoteSigner {
+	signer := &MockRemoteSigner{keys: make(map[common.Address]ethkey.KeyV2)}
+	for _, key := range keys {
+		signer.keys[key.Address.Address()] = key
+	}
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &mockRemoteSignerService{signer}))
+	signer.Server = httptest.NewServer(server)
+	t.Cleanup(func() {
+		signer.Server.Close()
+		server.Stop()
+	})
+
+	u, err := url.Parse(signer.Server.URL)
+	require.NoError(t, err)
+	signer.URL = u
+	return signer
+}
+
+type mockRemoteSignerService struct {
+	signer *MockRemoteSigner
+}
+
+// SignTransaction implements eth_signTransaction
+func (s *mockRemoteSignerService) SignTransaction(args RemoteSignerArgs) (interface{}, error) {
+	if s.signer.Tamper != nil {
+		s.signer.Tamper(&args)
+	}
+	key, exists := s.signer.keys[args.From]
+	if !exists {
+		return nil, errors.Errorf("unknown account %s", args.From.Hex())
+	}
+
+	var inner types.TxData
+	if args.MaxFeePerGas != nil {
+		inner = &types.DynamicFeeTx{
+			ChainID:   args.ChainID.ToInt(),
+			Nonce:     uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			To:        args.To,
+			Value:     args.Value.ToInt(),
+			Data:      args.Data,
+		}
+	} else {
+		inner = &types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		}
+	}
+	signedTx, err := types.SignTx(types.NewTx(inner), types.LatestSignerForChainID((*big.Int)(args.ChainID)), key.ToEcdsaPrivKey())
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.signer.GethStyle {
+		return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signedTx}, nil
+	}
+	return hexutil.Bytes(raw), nil
+}
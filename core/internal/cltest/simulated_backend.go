@@ -123,6 +123,10 @@ func (c *SimulatedBackendClient) Dial(context.Context) error {
 // other simulated clients might still be using it
 func (c *SimulatedBackendClient) Close() {}
 
+func (c *SimulatedBackendClient) NodeStates() map[string]string {
+	return nil
+}
+
 // checkEthCallArgs extracts and verifies the arguments for an eth_call RPC
 func (c *SimulatedBackendClient) checkEthCallArgs(
 	args []interface{}) (*eth.CallArgs, *big.Int, error) {
@@ -307,6 +311,12 @@ func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (
 	}, nil
 }
 
+// HeadByTag returns the latest head for any tag, as blocks of the simulated
+// backend are final once mined
+func (c *SimulatedBackendClient) HeadByTag(ctx context.Context, tag eth.BlockTag) (*eth.Head, error) {
+	return c.HeadByNumber(ctx, nil)
+}
+
 func (c *SimulatedBackendClient) BlockByNumber(ctx context.Context, n *big.Int) (*types.Block, error) {
 	return c.b.BlockByNumber(ctx, n)
 }
@@ -24,6 +24,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 
+	log "github.com/smartcontractkit/chainlink/core/services/log"
+
 	logger "github.com/smartcontractkit/chainlink/core/logger"
 
 	mock "github.com/stretchr/testify/mock"
@@ -98,6 +100,64 @@ func (_m *Application) BridgeORM() bridges.ORM {
 	return r0
 }
 
+// CancelEthTransaction provides a mock function with given fields: ctx, etxID
+func (_m *Application) CancelEthTransaction(ctx context.Context, etxID int64) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(ctx, etxID)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(context.Context, int64) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, etxID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelJobRunV2 provides a mock function with given fields: ctx, runID
+func (_m *Application) CancelJobRunV2(ctx context.Context, runID int64) error {
+	ret := _m.Called(ctx, runID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelSuspendedJobRunsV2 provides a mock function with given fields: ctx, jobID
+func (_m *Application) CancelSuspendedJobRunsV2(ctx context.Context, jobID int32) ([]int64, error) {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []int64); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -334,6 +394,29 @@ func (_m *Application) JobORM() job.ORM {
 	return r0
 }
 
+// JobReplays provides a mock function with given fields: chainID, jobID
+func (_m *Application) JobReplays(chainID *big.Int, jobID *int32) ([]log.JobReplay, error) {
+	ret := _m.Called(chainID, jobID)
+
+	var r0 []log.JobReplay
+	if rf, ok := ret.Get(0).(func(*big.Int, *int32) []log.JobReplay); ok {
+		r0 = rf(chainID, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.JobReplay)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, *int32) error); ok {
+		r1 = rf(chainID, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // JobSpawner provides a mock function with given fields:
 func (_m *Application) JobSpawner() job.Spawner {
 	ret := _m.Called()
@@ -380,6 +463,27 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// ReplaceEthTransaction provides a mock function with given fields: ctx, etxID, replacement
+func (_m *Application) ReplaceEthTransaction(ctx context.Context, etxID int64, replacement bulletprooftxmanager.EthTxReplacement) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(ctx, etxID, replacement)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bulletprooftxmanager.EthTxReplacement) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(ctx, etxID, replacement)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, bulletprooftxmanager.EthTxReplacement) error); ok {
+		r1 = rf(ctx, etxID, replacement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64) error {
 	ret := _m.Called(chainID, number)
@@ -394,6 +498,27 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64) error {
 	return r0
 }
 
+// ReplayJobFromBlock provides a mock function with given fields: chainID, opts
+func (_m *Application) ReplayJobFromBlock(chainID *big.Int, opts log.JobReplayOpts) (log.JobReplay, error) {
+	ret := _m.Called(chainID, opts)
+
+	var r0 log.JobReplay
+	if rf, ok := ret.Get(0).(func(*big.Int, log.JobReplayOpts) log.JobReplay); ok {
+		r0 = rf(chainID, opts)
+	} else {
+		r0 = ret.Get(0).(log.JobReplay)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, log.JobReplayOpts) error); ok {
+		r1 = rf(chainID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -408,6 +533,27 @@ func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result
 	return r0
 }
 
+// RetryJobRunV2 provides a mock function with given fields: ctx, runID, dotID
+func (_m *Application) RetryJobRunV2(ctx context.Context, runID int64, dotID string) (int64, error) {
+	ret := _m.Called(ctx, runID, dotID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) int64); ok {
+		r0 = rf(ctx, runID, dotID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, runID, dotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -494,6 +640,36 @@ func (_m *Application) SetServiceLogLevel(ctx context.Context, service string, l
 	return r0
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, jb, vars)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}) pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 pipeline.TaskRunResults
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, jb, vars)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, job.Job, map[string]interface{}) error); ok {
+		r2 = rf(ctx, jb, vars)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields:
 func (_m *Application) Start() error {
 	ret := _m.Called()
@@ -522,6 +698,22 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// SubscribeToJobRunEventsV2 provides a mock function with given fields: jobID
+func (_m *Application) SubscribeToJobRunEventsV2(jobID *int32) pipeline.RunEventSubscription {
+	ret := _m.Called(jobID)
+
+	var r0 pipeline.RunEventSubscription
+	if rf, ok := ret.Get(0).(func(*int32) pipeline.RunEventSubscription); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.RunEventSubscription)
+		}
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -338,6 +338,29 @@ func (_m *Client) HeadByNumber(ctx context.Context, n *big.Int) (*eth.Head, erro
 	return r0, r1
 }
 
+// HeadByTag provides a mock function with given fields: ctx, tag
+func (_m *Client) HeadByTag(ctx context.Context, tag eth.BlockTag) (*eth.Head, error) {
+	ret := _m.Called(ctx, tag)
+
+	var r0 *eth.Head
+	if rf, ok := ret.Get(0).(func(context.Context, eth.BlockTag) *eth.Head); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eth.Head)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, eth.BlockTag) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HeaderByNumber provides a mock function with given fields: _a0, _a1
 func (_m *Client) HeaderByNumber(_a0 context.Context, _a1 *big.Int) (*types.Header, error) {
 	ret := _m.Called(_a0, _a1)
@@ -361,6 +384,22 @@ func (_m *Client) HeaderByNumber(_a0 context.Context, _a1 *big.Int) (*types.Head
 	return r0, r1
 }
 
+// NodeStates provides a mock function with given fields:
+func (_m *Client) NodeStates() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
 // NonceAt provides a mock function with given fields: ctx, account, blockNumber
 func (_m *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
 	ret := _m.Called(ctx, account, blockNumber)
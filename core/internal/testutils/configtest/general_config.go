@@ -52,10 +52,14 @@ type GeneralConfigOverrides struct {
 	FeatureExternalInitiators                 null.Bool
 	GlobalBalanceMonitorEnabled               null.Bool
 	GlobalChainType                           null.String
+	GlobalEthTxBatchContractAddress           null.String
+	GlobalEthTxBatchMaxSize                   null.Int
+	GlobalEthTxBatchWindow                    *time.Duration
 	GlobalEthTxReaperThreshold                *time.Duration
 	GlobalEthTxResendAfterThreshold           *time.Duration
 	GlobalEvmEIP1559DynamicFees               null.Bool
 	GlobalEvmFinalityDepth                    null.Int
+	GlobalEvmFinalityTagsEnabled              null.Bool
 	GlobalEvmGasBumpPercent                   null.Int
 	GlobalEvmGasBumpTxDepth                   null.Int
 	GlobalEvmGasBumpWei                       *big.Int
@@ -71,12 +75,14 @@ type GeneralConfigOverrides struct {
 	GlobalEvmMaxGasPriceWei                   *big.Int
 	GlobalEvmMinGasPriceWei                   *big.Int
 	GlobalEvmNonceAutoSync                    null.Bool
+	GlobalEvmPollInterval                     *time.Duration
 	GlobalEvmRPCDefaultBatchSize              null.Int
 	GlobalFlagsContractAddress                null.String
 	GlobalGasEstimatorMode                    null.String
 	GlobalMinIncomingConfirmations            null.Int
 	GlobalMinRequiredOutgoingConfirmations    null.Int
 	GlobalMinimumContractPayment              *assets.Link
+	JobPipelineSecretsFile                    null.String
 	KeeperMaximumGracePeriod                  null.Int
 	KeeperMinimumRequiredConfirmations        null.Int
 	KeeperRegistrySyncInterval                *time.Duration
@@ -85,6 +91,8 @@ type GeneralConfigOverrides struct {
 	DefaultLogLevel                           *config.LogLevel
 	LogSQLStatements                          null.Bool
 	LogToDisk                                 null.Bool
+	NodePollInterval                          *time.Duration
+	NodeSelectionMode                         null.String
 	OCRBootstrapCheckInterval                 *time.Duration
 	OCRKeyBundleID                            null.String
 	OCRObservationGracePeriod                 *time.Duration
@@ -368,6 +376,20 @@ func (c *TestGeneralConfig) KeeperRegistrySyncInterval() time.Duration {
 	return c.GeneralConfig.KeeperRegistrySyncInterval()
 }
 
+func (c *TestGeneralConfig) NodePollInterval() time.Duration {
+	if c.Overrides.NodePollInterval != nil {
+		return *c.Overrides.NodePollInterval
+	}
+	return c.GeneralConfig.NodePollInterval()
+}
+
+func (c *TestGeneralConfig) NodeSelectionMode() string {
+	if c.Overrides.NodeSelectionMode.Valid {
+		return c.Overrides.NodeSelectionMode.String
+	}
+	return c.GeneralConfig.NodeSelectionMode()
+}
+
 func (c *TestGeneralConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	if c.Overrides.KeeperRegistrySyncUpkeepQueueSize.Valid {
 		return uint32(c.Overrides.KeeperRegistrySyncUpkeepQueueSize.Int64)
@@ -389,6 +411,13 @@ func (c *TestGeneralConfig) KeeperMinimumRequiredConfirmations() uint64 {
 	return c.GeneralConfig.KeeperMinimumRequiredConfirmations()
 }
 
+func (c *TestGeneralConfig) JobPipelineSecretsFile() string {
+	if c.Overrides.JobPipelineSecretsFile.Valid {
+		return c.Overrides.JobPipelineSecretsFile.String
+	}
+	return c.GeneralConfig.JobPipelineSecretsFile()
+}
+
 func (c *TestGeneralConfig) KeeperMaximumGracePeriod() int64 {
 	if c.Overrides.KeeperMaximumGracePeriod.Valid {
 		return c.Overrides.KeeperMaximumGracePeriod.Int64
@@ -458,6 +487,13 @@ func (c *TestGeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return c.GeneralConfig.GlobalEvmNonceAutoSync()
 }
+
+func (c *TestGeneralConfig) GlobalEvmPollInterval() (time.Duration, bool) {
+	if c.Overrides.GlobalEvmPollInterval != nil {
+		return *c.Overrides.GlobalEvmPollInterval, true
+	}
+	return c.GeneralConfig.GlobalEvmPollInterval()
+}
 func (c *TestGeneralConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	if c.Overrides.GlobalBalanceMonitorEnabled.Valid {
 		return c.Overrides.GlobalBalanceMonitorEnabled.Bool, true
@@ -514,6 +550,13 @@ func (c *TestGeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmFinalityDepth()
 }
 
+func (c *TestGeneralConfig) GlobalEvmFinalityTagsEnabled() (bool, bool) {
+	if c.Overrides.GlobalEvmFinalityTagsEnabled.Valid {
+		return c.Overrides.GlobalEvmFinalityTagsEnabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmFinalityTagsEnabled()
+}
+
 func (c *TestGeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	if c.Overrides.GlobalEvmLogBackfillBatchSize.Valid {
 		return uint32(c.Overrides.GlobalEvmLogBackfillBatchSize.Int64), true
@@ -598,6 +641,27 @@ func (c *TestGeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duratio
 	return c.GeneralConfig.GlobalEvmHeadTrackerSamplingInterval()
 }
 
+func (c *TestGeneralConfig) GlobalEthTxBatchContractAddress() (string, bool) {
+	if c.Overrides.GlobalEthTxBatchContractAddress.Valid {
+		return c.Overrides.GlobalEthTxBatchContractAddress.String, true
+	}
+	return c.GeneralConfig.GlobalEthTxBatchContractAddress()
+}
+
+func (c *TestGeneralConfig) GlobalEthTxBatchMaxSize() (uint32, bool) {
+	if c.Overrides.GlobalEthTxBatchMaxSize.Valid {
+		return uint32(c.Overrides.GlobalEthTxBatchMaxSize.Int64), true
+	}
+	return c.GeneralConfig.GlobalEthTxBatchMaxSize()
+}
+
+func (c *TestGeneralConfig) GlobalEthTxBatchWindow() (time.Duration, bool) {
+	if c.Overrides.GlobalEthTxBatchWindow != nil {
+		return *c.Overrides.GlobalEthTxBatchWindow, true
+	}
+	return c.GeneralConfig.GlobalEthTxBatchWindow()
+}
+
 func (c *TestGeneralConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
 	if c.Overrides.GlobalEthTxReaperThreshold != nil {
 		return *c.Overrides.GlobalEthTxReaperThreshold, true
@@ -0,0 +1,79 @@
+package bulletprooftxmanager
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+// BatchContractABI is the ABI of the tryAggregate function of Multicall2,
+// which the contract that eth_txes are batched into must implement.
+// tryAggregate(false, calls) makes each call in turn, and returns whether each
+// of them succeeded along with its return data instead of reverting.
+const BatchContractABI = `[{"inputs":[{"internalType":"bool","name":"requireSuccess","type":"bool"},{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall2.Call[]","name":"calls","type":"tuple[]"}],"name":"tryAggregate","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall2.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"nonpayable","type":"function"}]`
+
+var batchContractABI = eth.MustGetABI(BatchContractABI)
+
+// batchCallGasOverhead is the gas used by the batch contract to make each
+// call, on top of the gas limit of the batched eth_tx
+const batchCallGasOverhead = 10000
+
+// batchCall is a call made by the batch contract
+type batchCall struct {
+	Target   common.Address
+	CallData []byte
+}
+
+// batchResult is the outcome of a call made by the batch contract. The return
+// data of a failed call is its revert data.
+type batchResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// batchGasLimit returns the gas limit of an eth_tx which sends the given
+// eth_txes as a batch
+func batchGasLimit(etxs []EthTx) (gasLimit uint64) {
+	for _, etx := range etxs {
+		gasLimit += etx.GasLimit + batchCallGasOverhead
+	}
+	return gasLimit
+}
+
+// encodeBatch returns the payload and the gas limit of an eth_tx which calls
+// the batch contract to send the given eth_txes
+func encodeBatch(etxs []EthTx) (payload []byte, gasLimit uint64, err error) {
+	calls := make([]batchCall, len(etxs))
+	for i, etx := range etxs {
+		calls[i] = batchCall{Target: etx.ToAddress, CallData: etx.EncodedPayload}
+	}
+	payload, err = batchContractABI.Pack("tryAggregate", false, calls)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to encode batch")
+	}
+	return payload, batchGasLimit(etxs), nil
+}
+
+// decodeBatchResults decodes the return data of a call to the batch contract
+// into the results of the calls it made
+func decodeBatchResults(data []byte) ([]batchResult, error) {
+	var results []batchResult
+	if err := batchContractABI.UnpackIntoInterface(&results, "tryAggregate", data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode batch results")
+	}
+	return results, nil
+}
+
+// revertReason returns why the call failed, or an empty string if it succeeded
+func (r batchResult) revertReason() string {
+	if r.Success {
+		return ""
+	}
+	reason, err := defaultRevertReasonDecoder.Decode(r.ReturnData)
+	if err != nil {
+		// the revert data itself is the best reason we have
+		return err.Error()
+	}
+	return reason
+}
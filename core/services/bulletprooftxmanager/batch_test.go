@@ -0,0 +1,71 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+func Test_EncodeBatch(t *testing.T) {
+	t.Parallel()
+
+	etxs := []bulletprooftxmanager.EthTx{
+		{ToAddress: cltest.NewAddress(), EncodedPayload: []byte{1, 2, 3}, GasLimit: 100000},
+		{ToAddress: cltest.NewAddress(), EncodedPayload: []byte{}, GasLimit: 50000},
+	}
+
+	payload, gasLimit, err := bulletprooftxmanager.EncodeBatch(etxs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(150000+2*bulletprooftxmanager.BatchCallGasOverhead), gasLimit)
+
+	method := eth.MustGetABI(bulletprooftxmanager.BatchContractABI).Methods["tryAggregate"]
+	assert.Equal(t, method.ID, payload[:4])
+	args, err := method.Inputs.Unpack(payload[4:])
+	require.NoError(t, err)
+	assert.Equal(t, false, args[0])
+	calls := args[1].([]struct {
+		Target   common.Address `json:"target"`
+		CallData []byte         `json:"callData"`
+	})
+	require.Len(t, calls, 2)
+	for i, etx := range etxs {
+		assert.Equal(t, etx.ToAddress, calls[i].Target)
+		assert.Equal(t, etx.EncodedPayload, calls[i].CallData)
+	}
+}
+
+func Test_DecodeBatchResults(t *testing.T) {
+	t.Parallel()
+
+	method := eth.MustGetABI(bulletprooftxmanager.BatchContractABI).Methods["tryAggregate"]
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	// Error(string) with the reason "nope"
+	revertData := hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6e6f706500000000000000000000000000000000000000000000000000000000")
+
+	data, err := method.Outputs.Pack([]result{
+		{Success: true, ReturnData: []byte{1}},
+		{Success: false, ReturnData: revertData},
+		{Success: false, ReturnData: []byte{}},
+	})
+	require.NoError(t, err)
+
+	reasons, err := bulletprooftxmanager.DecodeBatchRevertReasons(data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "nope", "reverted without a reason"}, reasons)
+
+	_, err = bulletprooftxmanager.DecodeBatchRevertReasons([]byte{1, 2, 3})
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,7 +16,9 @@ import (
 	exchainutils "github.com/okex/exchain-ethereum-compatible/utils"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"go.uber.org/multierr"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
@@ -36,16 +39,23 @@ import (
 //go:generate mockery --recursive --name Config --output ./mocks/ --case=underscore --structname Config --filename config.go
 type Config interface {
 	gas.Config
+	EthTxBatchContractAddress() string
+	EthTxBatchMaxSize() uint32
+	EthTxBatchWindow() time.Duration
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasLimitDefault() uint64
+	EvmGasLimitTransfer() uint64
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmNonceAutoSync() bool
 	EvmRPCDefaultBatchSize() uint32
+	KeySpecificBudgetMaxSpendWei(addr common.Address) *big.Int
+	KeySpecificBudgetMaxTxs(addr common.Address) uint32
+	KeySpecificBudgetWindow(addr common.Address) time.Duration
 	KeySpecificMaxGasPriceWei(addr common.Address) *big.Int
 	TriggerFallbackDBPollInterval() time.Duration
 }
@@ -71,7 +81,10 @@ type TxManager interface {
 	service.Service
 	Trigger(addr common.Address)
 	CreateEthTransaction(newTx NewTx, qopts ...postgres.QOpt) (etx EthTx, err error)
+	CancelEthTransaction(id int64) (etx EthTx, err error)
+	ReplaceEthTransaction(id int64, replacement EthTxReplacement) (etx EthTx, err error)
 	GetGasEstimator() gas.Estimator
+	GetKeyBudgetUsage(address common.Address) (KeyBudgetUsage, error)
 	RegisterResumeCallback(fn ResumeCallback)
 }
 
@@ -202,11 +215,19 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 
 	close(b.chSubbed)
 
+	var latestBlockNum int64 = -1
+	var latestBlockGasLimit uint64
 	for {
 		select {
 		case address := <-b.trigger:
 			eb.Trigger(address)
 		case head := <-b.chHeads:
+			latestBlockNum = head.Number
+			if head.GasLimit.Valid {
+				latestBlockGasLimit = uint64(head.GasLimit.Int64)
+				eb.SetLatestBlockGasLimit(latestBlockGasLimit)
+			}
+			eb.SetLatestBlockNum(head.Number)
 			ec.mb.Deliver(head)
 		case <-b.chStop:
 			b.logger.ErrorIfClosing(eb, "EthBroadcaster")
@@ -229,6 +250,10 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 			if err := eb.Start(); err != nil {
 				b.logger.Errorw("Failed to start EthBroadcaster", "error", err)
 			}
+			eb.SetLatestBlockGasLimit(latestBlockGasLimit)
+			if latestBlockNum >= 0 {
+				eb.SetLatestBlockNum(latestBlockNum)
+			}
 			if err := ec.Start(); err != nil {
 				b.logger.Errorw("Failed to start EthConfirmer", "error", err)
 			}
@@ -272,6 +297,16 @@ type NewTx struct {
 	MinConfirmations  null.Uint32
 	PipelineTaskRunID *uuid.UUID
 
+	// NotBefore and NotBeforeBlock hold the transaction until the given time
+	// and block height, they are optional
+	NotBefore      *time.Time
+	NotBeforeBlock null.Int64
+	// ExpiresAt and ExpiresAtBlock abandon the transaction if it is not
+	// confirmed by the given time or block height, they are optional. An
+	// expired transaction which was already sent is cancelled on-chain.
+	ExpiresAt      *time.Time
+	ExpiresAtBlock null.Int64
+
 	Strategy TxStrategy
 }
 
@@ -284,6 +319,7 @@ func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...postgres.
 		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
 	}
 
+	var exceeded *KeyBudgetUsage
 	value := 0
 	err = q.Transaction(b.logger, func(tx postgres.Queryer) error {
 		if newTx.PipelineTaskRunID != nil {
@@ -300,13 +336,16 @@ func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...postgres.
 		if err = b.checkStateExists(tx, newTx.FromAddress); err != nil {
 			return err
 		}
+		if exceeded, err = b.checkKeyBudget(tx, newTx.FromAddress); err != nil {
+			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate, not_before, not_before_block, expires_at, expires_at_block, batchable)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate())
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate(), newTx.NotBefore, newTx.NotBeforeBlock, newTx.ExpiresAt, newTx.ExpiresAtBlock, newTx.Strategy.Batch())
 		if err != nil {
 			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to insert eth_tx")
 		}
@@ -320,6 +359,12 @@ RETURNING "eth_txes".*
 		}
 		return nil
 	})
+	if exceeded != nil {
+		// The key is tripped once the transaction has been rolled back
+		if tripErr := tripKeyBudget(q, b.logger, *exceeded, newTx.FromAddress, b.chainID); tripErr != nil {
+			err = multierr.Combine(err, tripErr)
+		}
+	}
 	return
 }
 
@@ -337,11 +382,300 @@ func (b *BulletproofTxManager) checkStateExists(q postgres.Queryer, addr common.
 	return nil
 }
 
+// checkKeyBudget returns ErrKeyBudgetExceeded, along with the usage of the
+// key to trip it with, if the key has no budget left for another transaction.
+// The state of the key is locked until the end of the transaction, so that
+// concurrent transactions from the key cannot overshoot its budget.
+func (b *BulletproofTxManager) checkKeyBudget(tx postgres.Queryer, addr common.Address) (*KeyBudgetUsage, error) {
+	budget := keyBudgetFromConfig(b.config, addr)
+	if !budget.Enabled() {
+		return nil, nil
+	}
+	if _, err := tx.Exec(`SELECT 1 FROM eth_key_states WHERE address = $1 AND evm_chain_id = $2 FOR UPDATE`, addr, b.chainID.String()); err != nil {
+		return nil, errors.Wrap(err, "failed to lock key state")
+	}
+	usage, err := GetKeyBudgetUsage(tx, addr, budget, b.chainID)
+	if err != nil {
+		return nil, err
+	}
+	if !usage.Exceeded() {
+		return nil, nil
+	}
+	return &usage, errors.Wrapf(ErrKeyBudgetExceeded, "%s spent %s wei in %d transactions over the last %s", addr.Hex(), usage.SpentWei, usage.Txs, budget.Window)
+}
+
+// GetKeyBudgetUsage returns the budget of the key and its usage over the
+// current window
+func (b *BulletproofTxManager) GetKeyBudgetUsage(address common.Address) (KeyBudgetUsage, error) {
+	q := postgres.NewQ(postgres.UnwrapGormDB(b.db))
+	return GetKeyBudgetUsage(q, address, keyBudgetFromConfig(b.config, address), b.chainID)
+}
+
+// Healthy returns an error if any key has exceeded its spending budget
+func (b *BulletproofTxManager) Healthy() error {
+	if err := b.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	q := postgres.NewQ(postgres.UnwrapGormDB(b.db))
+	var addresses []common.Address
+	err := q.Select(&addresses, `SELECT address FROM eth_key_states WHERE evm_chain_id = $1 AND budget_tripped_at IS NOT NULL ORDER BY address`, b.chainID.String())
+	if err != nil {
+		return errors.Wrap(err, "BulletproofTxManager#Healthy failed to load tripped keys")
+	}
+	if len(addresses) > 0 {
+		hexes := make([]string, len(addresses))
+		for i, address := range addresses {
+			hexes[i] = address.Hex()
+		}
+		return errors.Errorf("keys exceeded their spending budget: %s", strings.Join(hexes, ", "))
+	}
+	return nil
+}
+
 // GetGasEstimator returns the gas estimator, mostly useful for tests
 func (b *BulletproofTxManager) GetGasEstimator() gas.Estimator {
 	return b.gasEstimator
 }
 
+// EthTxReplacement is the new payload of a transaction being replaced.
+//
+// An unconfirmed transaction keeps its original fields when it is cancelled
+// or replaced, since its earlier attempts may still be mined. Its replacement
+// is saved separately instead, and the later attempts of the transaction are
+// created from it.
+type EthTxReplacement struct {
+	EthTxID        int64 `gorm:"primaryKey"`
+	ToAddress      common.Address
+	EncodedPayload []byte
+	Value          assets.Eth
+	// GasLimit of zero keeps the gas limit of the transaction
+	GasLimit uint64
+	// Cancelled is true if the replacement is a 0-value transfer to the
+	// sender of the transaction
+	Cancelled bool
+	CreatedAt time.Time
+}
+
+// withEthTxReplacement returns the eth_tx with the fields of what it was
+// cancelled or replaced with, if it was, to create new attempts of it
+func withEthTxReplacement(db *gorm.DB, etx EthTx) (EthTx, error) {
+	var replacements []EthTxReplacement
+	if err := db.Where("eth_tx_id = ?", etx.ID).Limit(1).Find(&replacements).Error; err != nil {
+		return etx, errors.Wrapf(err, "failed to load the replacement of eth_tx %v", etx.ID)
+	}
+	if len(replacements) == 0 {
+		return etx, nil
+	}
+	return replacements[0].apply(etx), nil
+}
+
+func (r EthTxReplacement) apply(etx EthTx) EthTx {
+	etx.ToAddress = r.ToAddress
+	etx.EncodedPayload = r.EncodedPayload
+	etx.Value = r.Value
+	etx.GasLimit = r.GasLimit
+	return etx
+}
+
+// ErrEthTxNotReplaceable is returned when cancelling or replacing a
+// transaction which is being broadcast, or which already finished
+var ErrEthTxNotReplaceable = errors.New("only unstarted and unconfirmed transactions can be cancelled or replaced")
+
+const (
+	// ethTxCancelledError is the error of cancelled transactions which were
+	// never broadcast
+	ethTxCancelledError = "transaction was cancelled"
+	// ethTxExpiredError is the error of transactions which expired before
+	// being broadcast
+	ethTxExpiredError = "transaction expired"
+)
+
+// CancelEthTransaction cancels the transaction with the given ID.
+//
+// An unstarted transaction is never sent, and fails with a fatal error.
+// An unconfirmed transaction is replaced with a 0-value transfer to its own
+// sender, at the same nonce and at a bumped gas price, so that the original
+// transaction cannot be mined anymore.
+//
+// If the transaction was sent by a job, the job run is resumed with an error.
+func (b *BulletproofTxManager) CancelEthTransaction(id int64) (etx EthTx, err error) {
+	return b.replaceEthTransaction(id, nil)
+}
+
+// ReplaceEthTransaction replaces the payload and gas limit of the transaction
+// with the given ID. An unconfirmed transaction is sent again with the new
+// payload, at the same nonce and at a bumped gas price.
+func (b *BulletproofTxManager) ReplaceEthTransaction(id int64, replacement EthTxReplacement) (etx EthTx, err error) {
+	return b.replaceEthTransaction(id, &replacement)
+}
+
+// replaceEthTransaction cancels the transaction if replacement is nil, or
+// replaces it otherwise.
+//
+// The replacement attempt of an unconfirmed transaction is saved in_progress,
+// and the EthConfirmer broadcasts it on the next head. The EthConfirmer may
+// still bump the gas of the original payload concurrently, in which case
+// either one of the attempts is mined; later gas bumps use the new payload.
+func (b *BulletproofTxManager) replaceEthTransaction(id int64, replacement *EthTxReplacement) (etx EthTx, err error) {
+	var cancelledTaskRunID uuid.NullUUID
+	err = postgres.GormTransactionWithDefaultContext(b.db, func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND evm_chain_id = ?", id, b.chainID.String()).
+			First(&etx).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return sql.ErrNoRows
+		} else if err != nil {
+			return errors.Wrap(err, "failed to load eth_tx")
+		}
+
+		// A batch cannot be cancelled or replaced, since the eth_txes in it
+		// would be resumed with the receipt of the replacement
+		var batchSize int64
+		if err = tx.Model(&EthTx{}).Where("batch_eth_tx_id = ?", etx.ID).Count(&batchSize).Error; err != nil {
+			return errors.Wrap(err, "failed to count batched eth_txes")
+		}
+		if batchSize > 0 {
+			return errors.Wrapf(ErrEthTxNotReplaceable, "eth_tx %v sends a batch of %d eth_txes", etx.ID, batchSize)
+		}
+
+		switch etx.State {
+		case EthTxUnstarted:
+			return b.replaceUnstartedEthTx(tx, &etx, replacement, &cancelledTaskRunID)
+		case EthTxUnconfirmed:
+			cancelledTaskRunID, err = replaceUnconfirmedEthTx(tx, &etx, replacement, b.gasEstimator, NewChainKeyStore(b.chainID, b.config, b.keyStore))
+			return err
+		default:
+			return errors.Wrapf(ErrEthTxNotReplaceable, "eth_tx %v is %s", etx.ID, etx.State)
+		}
+	})
+	if err != nil {
+		return etx, errors.Wrap(err, "BulletproofTxManager#replaceEthTransaction")
+	}
+
+	if cancelledTaskRunID.Valid && b.resumeCallback != nil {
+		err = b.resumeCallback(cancelledTaskRunID.UUID, nil, errors.Errorf("transaction %v was cancelled", etx.ID))
+		if errors.Is(err, sql.ErrNoRows) {
+			b.logger.Debugw("callback missing or already resumed", "etxID", etx.ID)
+		} else if err != nil {
+			return etx, errors.Wrap(err, "BulletproofTxManager#replaceEthTransaction failed to resume pipeline")
+		}
+	}
+	if replacement == nil {
+		b.logger.Infow("Cancelled transaction", "etxID", etx.ID, "state", etx.State, "nonce", etx.Nonce)
+	} else {
+		b.logger.Infow("Replaced transaction", "etxID", etx.ID, "state", etx.State, "nonce", etx.Nonce)
+	}
+	return etx, nil
+}
+
+func (b *BulletproofTxManager) replaceUnstartedEthTx(tx *gorm.DB, etx *EthTx, replacement *EthTxReplacement, cancelledTaskRunID *uuid.NullUUID) error {
+	if replacement == nil {
+		*cancelledTaskRunID = etx.PipelineTaskRunID
+		etx.State = EthTxFatalError
+		etx.Error.SetValid(ethTxCancelledError)
+		etx.PipelineTaskRunID = uuid.NullUUID{}
+	} else {
+		etx.EncodedPayload = replacement.EncodedPayload
+		if replacement.GasLimit > 0 {
+			etx.GasLimit = replacement.GasLimit
+		}
+	}
+	return errors.Wrap(tx.Save(etx).Error, "failed to save eth_tx")
+}
+
+// replaceUnconfirmedEthTx saves the replacement of the unconfirmed eth_tx,
+// or a 0-value transfer to its sender if replacement is nil, and a new
+// in_progress attempt sending it, priced above every attempt sent at its
+// nonce. The eth_tx itself keeps its original fields.
+// It returns the pipeline task run which was waiting on a cancelled eth_tx.
+func replaceUnconfirmedEthTx(tx *gorm.DB, etx *EthTx, replacement *EthTxReplacement, estimator gas.Estimator, cks ChainKeyStore) (cancelledTaskRunID uuid.NullUUID, err error) {
+	var inProgress int64
+	if err = tx.Model(&EthTxAttempt{}).Where("eth_tx_id = ? AND state = ?", etx.ID, EthTxAttemptInProgress).Count(&inProgress).Error; err != nil {
+		return cancelledTaskRunID, errors.Wrap(err, "failed to count in_progress attempts")
+	}
+	if inProgress > 0 {
+		return cancelledTaskRunID, errors.Errorf("eth_tx %v is being broadcast, try again after the next head", etx.ID)
+	}
+
+	var highest EthTxAttempt
+	err = tx.Where("eth_tx_id = ?", etx.ID).Order("gas_price DESC, gas_tip_cap DESC").First(&highest).Error
+	if err != nil {
+		return cancelledTaskRunID, errors.Wrapf(err, "failed to load the attempts of eth_tx %v", etx.ID)
+	}
+
+	// The replacement is relative to the original fields, whether the eth_tx
+	// was replaced before or not
+	r := EthTxReplacement{
+		EthTxID:   etx.ID,
+		ToAddress: etx.ToAddress,
+		Value:     etx.Value,
+		GasLimit:  etx.GasLimit,
+		CreatedAt: time.Now(),
+	}
+	if replacement == nil {
+		cancelledTaskRunID = etx.PipelineTaskRunID
+		r.ToAddress = etx.FromAddress
+		r.EncodedPayload = []byte{}
+		r.Value = assets.NewEthValue(0)
+		r.GasLimit = cks.config.EvmGasLimitTransfer()
+		r.Cancelled = true
+	} else {
+		r.EncodedPayload = replacement.EncodedPayload
+		if replacement.GasLimit > 0 {
+			r.GasLimit = replacement.GasLimit
+		}
+	}
+	replaced := r.apply(*etx)
+
+	var attempt EthTxAttempt
+	switch highest.TxType {
+	case 0x0:
+		var gasPrice *big.Int
+		var gasLimit uint64
+		gasPrice, gasLimit, err = estimator.BumpLegacyGas(highest.GasPrice.ToInt(), replaced.GasLimit)
+		if err != nil {
+			return cancelledTaskRunID, errors.Wrap(err, "failed to bump gas")
+		}
+		attempt, err = cks.NewLegacyAttempt(replaced, gasPrice, gasLimit)
+	case 0x2:
+		var fee gas.DynamicFee
+		var gasLimit uint64
+		fee, gasLimit, err = estimator.BumpDynamicFee(highest.DynamicFee(), replaced.GasLimit)
+		if err != nil {
+			return cancelledTaskRunID, errors.Wrap(err, "failed to bump gas")
+		}
+		attempt, err = cks.NewDynamicFeeAttempt(replaced, fee, gasLimit)
+	default:
+		err = errors.Errorf("attempt %v has unrecognised transaction type %v", highest.ID, highest.TxType)
+	}
+	if err != nil {
+		return cancelledTaskRunID, errors.Wrap(err, "failed to create replacement attempt")
+	}
+
+	err = tx.Exec(`
+INSERT INTO eth_tx_replacements (eth_tx_id, to_address, encoded_payload, value, gas_limit, cancelled, created_at)
+VALUES (?,?,?,?,?,?,?)
+ON CONFLICT (eth_tx_id) DO UPDATE SET
+to_address = EXCLUDED.to_address,
+encoded_payload = EXCLUDED.encoded_payload,
+value = EXCLUDED.value,
+gas_limit = EXCLUDED.gas_limit,
+cancelled = EXCLUDED.cancelled,
+created_at = EXCLUDED.created_at
+`, r.EthTxID, r.ToAddress, r.EncodedPayload, r.Value, r.GasLimit, r.Cancelled, r.CreatedAt).Error
+	if err != nil {
+		return cancelledTaskRunID, errors.Wrap(err, "failed to save eth_tx replacement")
+	}
+	if cancelledTaskRunID.Valid {
+		// The job run is resumed with an error now, not with the receipt
+		etx.PipelineTaskRunID = uuid.NullUUID{}
+		if err = tx.Exec(`UPDATE eth_txes SET pipeline_task_run_id = NULL WHERE id = ?`, etx.ID).Error; err != nil {
+			return cancelledTaskRunID, errors.Wrap(err, "failed to save eth_tx")
+		}
+	}
+	return cancelledTaskRunID, errors.Wrap(tx.Create(&attempt).Error, "failed to save replacement attempt")
+}
+
 // SendEther creates a transaction that transfers the given value of ether
 func SendEther(db *gorm.DB, chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint64) (etx EthTx, err error) {
 	if to == utils.ZeroAddress {
@@ -542,7 +876,16 @@ func (n *NullTxManager) Trigger(common.Address)                      { panic(n.E
 func (n *NullTxManager) CreateEthTransaction(NewTx, ...postgres.QOpt) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
 }
+func (n *NullTxManager) CancelEthTransaction(int64) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) ReplaceEthTransaction(int64, EthTxReplacement) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
 func (n *NullTxManager) Healthy() error                           { return nil }
 func (n *NullTxManager) Ready() error                             { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
 func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback) {}
+func (n *NullTxManager) GetKeyBudgetUsage(common.Address) (usage KeyBudgetUsage, err error) {
+	return usage, errors.New(n.ErrMsg)
+}
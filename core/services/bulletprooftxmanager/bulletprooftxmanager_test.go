@@ -2,6 +2,7 @@ package bulletprooftxmanager_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
@@ -184,10 +187,16 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 	gasLimit := uint64(1000)
 	payload := []byte{1, 2, 3}
 
+	_, budgetAddress := cltest.MustInsertRandomKey(t, keyStore.Eth(), 0)
+
 	config := new(bptxmmocks.Config)
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("KeySpecificBudgetWindow", mock.Anything).Return(time.Hour)
+	config.On("KeySpecificBudgetMaxSpendWei", mock.Anything).Return(nil)
+	config.On("KeySpecificBudgetMaxTxs", budgetAddress).Return(uint32(1))
+	config.On("KeySpecificBudgetMaxTxs", mock.Anything).Return(uint32(0))
 	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 
 	lggr := logger.TestLogger(t)
@@ -298,12 +307,95 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), fmt.Sprintf("cannot send transaction on chain ID 0; eth key with address %s is pegged to chain ID 1337", otherAddress.Hex()))
 	})
+
+	t.Run("with key budget exceeded does not insert eth_tx and trips the key", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(3)).Twice()
+		strategy := newMockTxStrategy(t)
+		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("PruneQueue", mock.AnythingOfType("*sqlx.Tx")).Return(int64(0), nil)
+		newTx := bulletprooftxmanager.NewTx{
+			FromAddress:    budgetAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Strategy:       strategy,
+		}
+		_, err := bptxm.CreateEthTransaction(newTx)
+		require.NoError(t, err)
+
+		_, err = bptxm.CreateEthTransaction(newTx)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrKeyBudgetExceeded))
+
+		var state ethkey.State
+		require.NoError(t, sqlxdb.Get(&state, `SELECT * FROM eth_key_states WHERE address = $1`, budgetAddress))
+		assert.NotNil(t, state.BudgetTrippedAt)
+	})
+}
+
+func TestBulletproofTxManager_GetKeyBudgetUsage(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	budget := bulletprooftxmanager.KeyBudget{Window: time.Hour, MaxSpendWei: big.NewInt(1000), MaxTxs: 3}
+
+	t.Run("with no eth_txes returns no usage", func(t *testing.T) {
+		usage, err := bulletprooftxmanager.GetKeyBudgetUsage(sqlxdb, fromAddress, budget, cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, budget, usage.KeyBudget)
+		assert.Equal(t, "0", usage.SpentWei.String())
+		assert.Equal(t, uint32(0), usage.Txs)
+		assert.Nil(t, usage.TrippedAt)
+		assert.False(t, usage.Exceeded())
+	})
+
+	cltest.MustInsertUnstartedEthTx(t, db, otherAddress)
+	cltest.MustInsertFatalErrorEthTx(t, db, fromAddress)
+	cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 0, 42, fromAddress)
+
+	t.Run("counts the value and the gas of the most expensive attempt of eth_txes from the key", func(t *testing.T) {
+		usage, err := bulletprooftxmanager.GetKeyBudgetUsage(sqlxdb, fromAddress, budget, cltest.FixtureChainID)
+		require.NoError(t, err)
+		// 142 wei for each eth_tx, plus 42 gas at 1 wei for the attempt
+		assert.Equal(t, "326", usage.SpentWei.String())
+		assert.Equal(t, uint32(2), usage.Txs)
+		assert.False(t, usage.Exceeded())
+		assert.False(t, usage.ExceededBy(big.NewInt(674)))
+		assert.True(t, usage.ExceededBy(big.NewInt(675)))
+	})
+
+	t.Run("is exceeded at the transaction or spending limit", func(t *testing.T) {
+		usage, err := bulletprooftxmanager.GetKeyBudgetUsage(sqlxdb, fromAddress, bulletprooftxmanager.KeyBudget{Window: time.Hour, MaxTxs: 2}, cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.True(t, usage.Exceeded())
+
+		usage, err = bulletprooftxmanager.GetKeyBudgetUsage(sqlxdb, fromAddress, bulletprooftxmanager.KeyBudget{Window: time.Hour, MaxSpendWei: big.NewInt(326)}, cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.True(t, usage.Exceeded())
+	})
+
+	t.Run("ignores eth_txes created before the window", func(t *testing.T) {
+		require.NoError(t, db.Exec(`UPDATE eth_txes SET created_at = NOW() - interval '2 hours'`).Error)
+
+		usage, err := bulletprooftxmanager.GetKeyBudgetUsage(sqlxdb, fromAddress, budget, cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, "0", usage.SpentWei.String())
+		assert.Equal(t, uint32(0), usage.Txs)
+	})
 }
 
 func newMockTxStrategy(t *testing.T) *bptxmmocks.TxStrategy {
 	strategy := new(bptxmmocks.TxStrategy)
 	strategy.Test(t)
 	strategy.On("Simulate").Return(true)
+	strategy.On("Batch").Return(false)
 	return strategy
 }
 
@@ -323,6 +415,9 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("KeySpecificBudgetWindow", mock.Anything).Return(time.Hour)
+	config.On("KeySpecificBudgetMaxSpendWei", mock.Anything).Return(nil)
+	config.On("KeySpecificBudgetMaxTxs", mock.Anything).Return(uint32(0))
 	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
 	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, nil, nil, lggr)
@@ -501,3 +596,193 @@ func TestBulletproofTxManager_SignTx(t *testing.T) {
 		require.Equal(t, "0x1458742e3ba53316481eb18237ced517a536c1cdef61e7b7fb2a9569d84e41a6", hash.Hex())
 	})
 }
+
+func TestBulletproofTxManager_CancelEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	keyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore, 0)
+	config := newTestChainScopedConfig(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	lggr := logger.TestLogger(t)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, keyStore, nil, lggr)
+
+	var resumedTaskRunID uuid.UUID
+	var resumedErr error
+	bptxm.RegisterResumeCallback(func(id uuid.UUID, result interface{}, err error) error {
+		resumedTaskRunID = id
+		resumedErr = err
+		return nil
+	})
+
+	t.Run("marks unstarted eth_tx as errored and resumes its pipeline run", func(t *testing.T) {
+		taskRunID := uuid.NewV4()
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.PipelineTaskRunID = uuid.NullUUID{UUID: taskRunID, Valid: true}
+		require.NoError(t, db.Save(&etx).Error)
+
+		cancelled, err := bptxm.CancelEthTransaction(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, cancelled.State)
+
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Equal(t, "transaction was cancelled", etx.Error.String)
+		assert.False(t, etx.PipelineTaskRunID.Valid)
+		assert.Equal(t, taskRunID, resumedTaskRunID)
+		assert.Error(t, resumedErr)
+	})
+
+	t.Run("replaces unconfirmed eth_tx with a self transfer at a higher gas price", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+		require.Len(t, etx.EthTxAttempts, 1)
+		original := etx.EthTxAttempts[0]
+
+		cancelled, err := bptxm.CancelEthTransaction(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, cancelled.State)
+
+		// The original transaction is kept, since its attempt may still be mined
+		toAddress, payload, gasLimit := etx.ToAddress, etx.EncodedPayload, etx.GasLimit
+		etx, err = cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, toAddress, etx.ToAddress)
+		assert.Equal(t, payload, etx.EncodedPayload)
+		assert.Equal(t, gasLimit, etx.GasLimit)
+
+		var r bulletprooftxmanager.EthTxReplacement
+		require.NoError(t, db.First(&r, "eth_tx_id = ?", etx.ID).Error)
+		assert.True(t, r.Cancelled)
+		assert.Equal(t, fromAddress, r.ToAddress)
+		assert.Empty(t, r.EncodedPayload)
+		assert.Equal(t, assets.NewEthValue(0), r.Value)
+		assert.Equal(t, config.EvmGasLimitTransfer(), r.GasLimit)
+
+		require.Len(t, etx.EthTxAttempts, 2)
+		replacement := etx.EthTxAttempts[1]
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptInProgress, replacement.State)
+		assert.Equal(t, 1, replacement.GasPrice.ToInt().Cmp(original.GasPrice.ToInt()))
+
+		tx, err := replacement.GetSignedTx()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(*etx.Nonce), tx.Nonce())
+		assert.Equal(t, fromAddress, *tx.To())
+		assert.Equal(t, int64(0), tx.Value().Int64())
+		assert.Empty(t, tx.Data())
+		assert.Equal(t, config.EvmGasLimitTransfer(), tx.Gas())
+	})
+
+	t.Run("does not cancel eth_tx being broadcast twice", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 1, fromAddress)
+
+		_, err := bptxm.CancelEthTransaction(etx.ID)
+		require.NoError(t, err)
+		_, err = bptxm.CancelEthTransaction(etx.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is being broadcast")
+	})
+
+	t.Run("does not cancel confirmed eth_tx", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 2, 1, fromAddress)
+
+		_, err := bptxm.CancelEthTransaction(etx.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable))
+	})
+
+	t.Run("returns sql.ErrNoRows for a missing eth_tx", func(t *testing.T) {
+		_, err := bptxm.CancelEthTransaction(-1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+}
+
+func TestBulletproofTxManager_ReplaceEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	keyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore, 0)
+	config := newTestChainScopedConfig(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	lggr := logger.TestLogger(t)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, keyStore, nil, lggr)
+
+	payload := []byte{4, 5, 6}
+
+	t.Run("updates the payload of unstarted eth_tx", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+
+		replaced, err := bptxm.ReplaceEthTransaction(etx.ID, bulletprooftxmanager.EthTxReplacement{EncodedPayload: payload, GasLimit: 42000})
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, replaced.State)
+
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, payload, etx.EncodedPayload)
+		assert.Equal(t, uint64(42000), etx.GasLimit)
+	})
+
+	t.Run("sends the new payload of unconfirmed eth_tx at the same nonce", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+		originalPayload, gasLimit := etx.EncodedPayload, etx.GasLimit
+
+		_, err := bptxm.ReplaceEthTransaction(etx.ID, bulletprooftxmanager.EthTxReplacement{EncodedPayload: payload})
+		require.NoError(t, err)
+
+		etx, err = cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, originalPayload, etx.EncodedPayload)
+
+		var r bulletprooftxmanager.EthTxReplacement
+		require.NoError(t, db.First(&r, "eth_tx_id = ?", etx.ID).Error)
+		assert.False(t, r.Cancelled)
+		assert.Equal(t, etx.ToAddress, r.ToAddress)
+		assert.Equal(t, payload, r.EncodedPayload)
+		assert.Equal(t, gasLimit, r.GasLimit)
+
+		require.Len(t, etx.EthTxAttempts, 2)
+		tx, err := etx.EthTxAttempts[1].GetSignedTx()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(*etx.Nonce), tx.Nonce())
+		assert.Equal(t, payload, tx.Data())
+	})
+}
+
+func TestEthTx_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	earlier := now.Add(-time.Second)
+	later := now.Add(time.Second)
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		atBlock   null.Int64
+		blockNum  int64
+		expired   bool
+	}{
+		{"no expiry", nil, null.Int64{}, 100, false},
+		{"expires later", &later, null.Int64{}, 100, false},
+		{"expired now", &now, null.Int64{}, 100, true},
+		{"expired earlier", &earlier, null.Int64{}, 100, true},
+		{"expires at a later block", nil, null.Int64From(101), 100, false},
+		{"expires at the block", nil, null.Int64From(100), 100, true},
+		{"expires at an earlier block", nil, null.Int64From(99), 100, true},
+		{"block height unknown", nil, null.Int64From(0), -1, false},
+		{"expired earlier, block height unknown", &earlier, null.Int64From(0), -1, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			etx := bulletprooftxmanager.EthTx{ExpiresAt: test.expiresAt, ExpiresAtBlock: test.atBlock}
+			assert.Equal(t, test.expired, etx.IsExpired(now, test.blockNum))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package bulletprooftxmanager
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/jackc/pgconn"
 	"github.com/lib/pq"
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/gas"
@@ -21,7 +23,9 @@ import (
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"go.uber.org/atomic"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // InFlightTransactionRecheckInterval controls how often the EthBroadcaster
@@ -29,7 +33,10 @@ import (
 // transaction
 const InFlightTransactionRecheckInterval = 1 * time.Second
 
-var errEthTxRemoved = errors.New("eth_tx removed")
+var (
+	errEthTxRemoved = errors.New("eth_tx removed")
+	errEthTxChanged = errors.New("eth_tx changed")
+)
 
 // EthBroadcaster monitors eth_txes for transactions that need to
 // be broadcast, assigns nonces and ensures that at least one eth node
@@ -57,6 +64,13 @@ type EthBroadcaster struct {
 
 	keyStates []ethkey.State
 
+	// latestBlockNum is the height of the latest head, or -1 before the first
+	// head. It gates the eth_txes held until a block height.
+	latestBlockNum *atomic.Int64
+	// latestBlockGasLimit is the gas limit of the latest head, or 0 if it is
+	// not known. It bounds the gas limit of batches.
+	latestBlockGasLimit *atomic.Uint64
+
 	// triggers allow other goroutines to force EthBroadcaster to rescan the
 	// database early (before the next poll interval)
 	// Each key has its own trigger
@@ -84,12 +98,15 @@ func NewEthBroadcaster(db *gorm.DB, ethClient eth.Client, config Config, keystor
 			config:   config,
 			keystore: keystore,
 		},
-		estimator:        estimator,
-		eventBroadcaster: eventBroadcaster,
-		keyStates:        keyStates,
-		triggers:         triggers,
-		chStop:           make(chan struct{}),
-		wg:               sync.WaitGroup{},
+		estimator:           estimator,
+		resumeCallback:      resumeCallback,
+		eventBroadcaster:    eventBroadcaster,
+		keyStates:           keyStates,
+		latestBlockNum:      atomic.NewInt64(-1),
+		latestBlockGasLimit: atomic.NewUint64(0),
+		triggers:            triggers,
+		chStop:              make(chan struct{}),
+		wg:                  sync.WaitGroup{},
 	}
 }
 
@@ -159,6 +176,20 @@ func (eb *EthBroadcaster) Trigger(addr gethCommon.Address) {
 	}
 }
 
+// SetLatestBlockGasLimit records the gas limit of the latest head
+func (eb *EthBroadcaster) SetLatestBlockGasLimit(gasLimit uint64) {
+	eb.latestBlockGasLimit.Store(gasLimit)
+}
+
+// SetLatestBlockNum records the height of the latest head, and triggers all
+// monitors to send the eth_txes which were held until it
+func (eb *EthBroadcaster) SetLatestBlockNum(blockNum int64) {
+	eb.latestBlockNum.Store(blockNum)
+	for _, k := range eb.keyStates {
+		eb.Trigger(k.Address.Address())
+	}
+}
+
 func (eb *EthBroadcaster) ethTxInsertTriggerer() {
 	defer eb.wg.Done()
 	for {
@@ -183,7 +214,13 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{})
 
 	defer eb.wg.Done()
 	for {
-		pollDBTimer := time.NewTimer(utils.WithJitter(eb.config.TriggerFallbackDBPollInterval()))
+		pollInterval := eb.config.TriggerFallbackDBPollInterval()
+		if window := eb.batchWindow(); window > 0 && window < pollInterval {
+			// Batchable eth_txes are held for the batch window, so there must
+			// be a poll soon after it ends
+			pollInterval = window
+		}
+		pollDBTimer := time.NewTimer(utils.WithJitter(pollInterval))
 
 		if err := eb.ProcessUnstartedEthTxs(ctx, k); err != nil {
 			eb.logger.Errorw("Error in ProcessUnstartedEthTxs", "error", err)
@@ -232,6 +269,12 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	} else if err != nil {
 		return errors.Wrap(err, "processUnstartedEthTxs failed")
 	}
+	if err := eb.abandonExpiredEthTxs(fromAddress); err != nil {
+		return errors.Wrap(err, "processUnstartedEthTxs failed")
+	}
+	if err := eb.batchEthTxs(fromAddress); err != nil {
+		return errors.Wrap(err, "processUnstartedEthTxs failed")
+	}
 	for {
 		maxInFlightTransactions := eb.config.EvmMaxInFlightTransactions()
 		if maxInFlightTransactions > 0 {
@@ -281,6 +324,9 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 		if err := eb.saveInProgressTransaction(etx, &a); errors.Is(err, errEthTxRemoved) {
 			eb.logger.Debugw("eth_tx removed", "etxID", etx.ID, "subject", etx.Subject)
 			continue
+		} else if errors.Is(err, errEthTxChanged) {
+			eb.logger.Debugw("eth_tx cancelled or replaced while being processed", "etxID", etx.ID, "subject", etx.Subject)
+			continue
 		} else if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
@@ -464,11 +510,152 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 	return errors.Wrapf(sendError, "error while sending transaction %v", etx.ID)
 }
 
+// abandonExpiredEthTxs marks the unstarted eth_txes of the address which
+// expired before being sent as fatally errored, and resumes the pipeline runs
+// waiting on them with an error
+func (eb *EthBroadcaster) abandonExpiredEthTxs(fromAddress gethCommon.Address) error {
+	var etxs []EthTx
+	err := eb.db.
+		Where("from_address = ? AND state = 'unstarted' AND evm_chain_id = ? AND (expires_at IS NOT NULL OR expires_at_block IS NOT NULL)", fromAddress, eb.chainID.String()).
+		Find(&etxs).Error
+	if err != nil {
+		return errors.Wrap(err, "abandonExpiredEthTxs failed to load eth_txes")
+	}
+
+	now := time.Now()
+	blockNum := eb.latestBlockNum.Load()
+	for _, etx := range etxs {
+		if !etx.IsExpired(now, blockNum) {
+			continue
+		}
+		eb.logger.Warnw("Transaction expired before being sent, abandoning it", "etxID", etx.ID, "expiresAt", etx.ExpiresAt, "expiresAtBlock", etx.ExpiresAtBlock, "blockNum", blockNum)
+		if etx.PipelineTaskRunID.Valid && eb.resumeCallback != nil {
+			err = eb.resumeCallback(etx.PipelineTaskRunID.UUID, nil, errors.Errorf("transaction %v expired before being sent", etx.ID))
+			if errors.Is(err, sql.ErrNoRows) {
+				eb.logger.Debugw("callback missing or already resumed", "etxID", etx.ID)
+			} else if err != nil {
+				return errors.Wrap(err, "abandonExpiredEthTxs failed to resume pipeline")
+			}
+		}
+		err = eb.db.Exec(`UPDATE eth_txes SET state = 'fatal_error', error = ? WHERE id = ? AND state = 'unstarted'`, ethTxExpiredError, etx.ID).Error
+		if err != nil {
+			return errors.Wrap(err, "abandonExpiredEthTxs failed to save eth_tx")
+		}
+	}
+	return nil
+}
+
+// batchWindow returns how long batchable eth_txes are held for other eth_txes
+// to batch them with, or zero if batching is disabled
+func (eb *EthBroadcaster) batchWindow() time.Duration {
+	if eb.config.EthTxBatchContractAddress() == "" {
+		return 0
+	}
+	return eb.config.EthTxBatchWindow()
+}
+
+// batchEthTxs replaces the batchable unstarted eth_txes of the address with
+// eth_txes calling the batch contract, each of which sends a batch of them.
+// A batch is sent once the oldest eth_tx in it has been held for the batch
+// window, or as soon as it is full, either of eth_txes or of gas. An eth_tx
+// which is not batched with any other by then is sent on its own.
+func (eb *EthBroadcaster) batchEthTxs(fromAddress gethCommon.Address) error {
+	contractAddress := eb.config.EthTxBatchContractAddress()
+	if contractAddress == "" {
+		return nil
+	}
+	for {
+		batched, err := eb.batchNextEthTxs(fromAddress, gethCommon.HexToAddress(contractAddress))
+		if err != nil {
+			return errors.Wrap(err, "batchEthTxs failed")
+		}
+		if !batched {
+			return nil
+		}
+	}
+}
+
+// batchMaxGasLimit returns the highest gas limit of a batch, which is the gas
+// limit of the latest block, or the default gas limit until a head is received
+func (eb *EthBroadcaster) batchMaxGasLimit() uint64 {
+	if gasLimit := eb.latestBlockGasLimit.Load(); gasLimit > 0 {
+		return gasLimit
+	}
+	return eb.config.EvmGasLimitDefault()
+}
+
+func (eb *EthBroadcaster) batchNextEthTxs(fromAddress gethCommon.Address, contractAddress gethCommon.Address) (batched bool, err error) {
+	maxSize := int(eb.config.EthTxBatchMaxSize())
+	maxGasLimit := eb.batchMaxGasLimit()
+	err = postgres.GormTransactionWithDefaultContext(eb.db, func(tx *gorm.DB) error {
+		// Scheduled eth_txes are not batched, since their batch would have to
+		// be held or abandoned with them
+		var etxs []EthTx
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("from_address = ? AND state = 'unstarted' AND evm_chain_id = ? AND batchable AND value = 0", fromAddress, eb.chainID.String()).
+			Where("not_before IS NULL AND not_before_block IS NULL AND expires_at IS NULL AND expires_at_block IS NULL").
+			Order("created_at ASC, id ASC").
+			Limit(maxSize).
+			Find(&etxs).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to load eth_txes")
+		}
+		full := len(etxs) == maxSize
+		for n := range etxs {
+			if batchGasLimit(etxs[:n+1]) > maxGasLimit {
+				etxs = etxs[:n]
+				full = true
+				break
+			}
+		}
+		if len(etxs) < 2 || (!full && time.Since(etxs[0].CreatedAt) < eb.config.EthTxBatchWindow()) {
+			return nil
+		}
+
+		payload, gasLimit, err := encodeBatch(etxs)
+		if err != nil {
+			return err
+		}
+		// The batch is simulated only if every eth_tx in it can be
+		simulate := true
+		ids := make([]int64, len(etxs))
+		for i, etx := range etxs {
+			simulate = simulate && etx.Simulate
+			ids[i] = etx.ID
+		}
+		// The batch takes the place of the oldest eth_tx in the queue
+		batch := EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      contractAddress,
+			EncodedPayload: payload,
+			Value:          assets.NewEthValue(0),
+			GasLimit:       gasLimit,
+			State:          EthTxUnstarted,
+			CreatedAt:      etxs[0].CreatedAt,
+			EVMChainID:     *utils.NewBig(&eb.chainID),
+			Simulate:       simulate,
+		}
+		if err = tx.Create(&batch).Error; err != nil {
+			return errors.Wrap(err, "failed to insert batch eth_tx")
+		}
+		for i, id := range ids {
+			err = tx.Exec(`UPDATE eth_txes SET state = 'batched', batch_eth_tx_id = ?, batch_index = ? WHERE id = ?`, batch.ID, i, id).Error
+			if err != nil {
+				return errors.Wrap(err, "failed to save batched eth_txes")
+			}
+		}
+		eb.logger.Infow(fmt.Sprintf("Batched %d transactions", len(etxs)), "batchEthTxID", batch.ID, "etxIDs", ids, "fromAddress", fromAddress)
+		batched = true
+		return nil
+	})
+	return batched, err
+}
+
 // Finds next transaction in the queue, assigns a nonce, and moves it to "in_progress" state ready for broadcast.
 // Returns nil if no transactions are in queue
 func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address) (*EthTx, error) {
 	etx := &EthTx{}
-	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID); err != nil {
+	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress, eb.chainID, eb.latestBlockNum.Load(), eb.batchWindow()); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			// Finish. No more transactions left to process. Hoorah!
 			return nil, nil
@@ -507,14 +694,29 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 			}
 			return errors.Wrap(err, "saveInProgressTransaction failed to create eth_tx_attempt")
 		}
+		// The eth_tx may have been cancelled or replaced since it was loaded
+		var current EthTx
+		if err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", etx.ID).First(&current).Error; err != nil {
+			return errors.Wrap(err, "saveInProgressTransaction failed to load eth_tx")
+		}
+		if current.State != EthTxUnstarted || current.GasLimit != etx.GasLimit || !bytes.Equal(current.EncodedPayload, etx.EncodedPayload) {
+			return errEthTxChanged
+		}
 		return errors.Wrap(tx.Save(etx).Error, "saveInProgressTransaction failed to save eth_tx")
 	})
 }
 
 // Finds earliest saved transaction that has yet to be broadcast from the given address
-func findNextUnstartedTransactionFromAddress(db *gorm.DB, etx *EthTx, fromAddress gethCommon.Address, chainID big.Int) error {
-	return db.
+// Skips transactions held until a later time or block height (or any block height, if blockNum is unknown),
+// and batchable transactions held for the batch window
+func findNextUnstartedTransactionFromAddress(db *gorm.DB, etx *EthTx, fromAddress gethCommon.Address, chainID big.Int, blockNum int64, batchWindow time.Duration) error {
+	q := db.
 		Where("from_address = ? AND state = 'unstarted' AND evm_chain_id = ?", fromAddress, chainID.String()).
+		Where("(not_before IS NULL OR not_before <= NOW()) AND (not_before_block IS NULL OR (? >= 0 AND not_before_block <= ?))", blockNum, blockNum)
+	if batchWindow > 0 {
+		q = q.Where("(NOT batchable OR created_at <= ?)", time.Now().Add(-batchWindow))
+	}
+	return q.
 		Order("value ASC, created_at ASC, id ASC").
 		First(etx).
 		Error
@@ -624,6 +826,12 @@ func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx) error {
 		if err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = ?`, etx.ID).Error; err != nil {
 			return errors.Wrapf(err, "saveFatallyErroredTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
 		}
+		// The eth_txes of a batch which was never sent are sent on their own
+		// instead, so that only the ones at fault fail
+		err := tx.Exec(`UPDATE eth_txes SET state = 'unstarted', batch_eth_tx_id = NULL, batch_index = NULL, batchable = false WHERE batch_eth_tx_id = ?`, etx.ID).Error
+		if err != nil {
+			return errors.Wrap(err, "saveFatallyErroredTransaction failed to unbatch eth_txes")
+		}
 		return errors.Wrap(tx.Save(etx).Error, "saveFatallyErroredTransaction failed to save eth_tx")
 	})
 }
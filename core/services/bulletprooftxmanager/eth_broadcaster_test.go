@@ -29,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
@@ -1462,6 +1463,216 @@ func TestEthBroadcaster_Trigger(t *testing.T) {
 	eb.Trigger(cltest.NewAddress())
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Scheduling(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("holds eth_tx until its not before time", func(t *testing.T) {
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.NotBefore = &future
+		require.NoError(t, db.Save(&etx).Error)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+		require.NoError(t, db.Delete(&etx).Error)
+	})
+
+	t.Run("holds eth_tx until its not before block, or while the block height is unknown", func(t *testing.T) {
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.NotBeforeBlock = clnull.Int64From(100)
+		require.NoError(t, db.Save(&etx).Error)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+
+		eb.SetLatestBlockNum(99)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+
+		eb.SetLatestBlockNum(100)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(0)
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	})
+
+	t.Run("abandons eth_tx which expired before being sent", func(t *testing.T) {
+		expired := cltest.NewEthTx(t, fromAddress)
+		expired.ExpiresAt = &past
+		require.NoError(t, db.Save(&expired).Error)
+
+		expiredAtBlock := cltest.NewEthTx(t, fromAddress)
+		expiredAtBlock.ExpiresAtBlock = clnull.Int64From(100)
+		require.NoError(t, db.Save(&expiredAtBlock).Error)
+
+		notExpired := cltest.NewEthTx(t, fromAddress)
+		notExpired.ExpiresAt = &future
+		notExpired.ExpiresAtBlock = clnull.Int64From(101)
+		require.NoError(t, db.Save(&notExpired).Error)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(1)
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		for _, etx := range []bulletprooftxmanager.EthTx{expired, expiredAtBlock} {
+			require.NoError(t, db.First(&etx, etx.ID).Error)
+			assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+			assert.Equal(t, "transaction expired", etx.Error.String)
+			assert.Nil(t, etx.Nonce)
+		}
+		require.NoError(t, db.First(&notExpired, notExpired.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, notExpired.State)
+	})
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Batching(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	batchContractAddress := cltest.NewAddress()
+	window := time.Hour
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEthTxBatchContractAddress = null.StringFrom(batchContractAddress.Hex())
+	cfg.Overrides.GlobalEthTxBatchMaxSize = null.IntFrom(3)
+	cfg.Overrides.GlobalEthTxBatchWindow = &window
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	newBatchableEthTx := func(t *testing.T, createdAt time.Time) bulletprooftxmanager.EthTx {
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.Value = assets.NewEthValue(0)
+		etx.GasLimit = 100000
+		etx.Batchable = true
+		etx.CreatedAt = createdAt
+		require.NoError(t, db.Save(&etx).Error)
+		return etx
+	}
+	sentToBatchContract := mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return *tx.To() == batchContractAddress
+	})
+
+	t.Run("holds batchable eth_txes for the batch window, and sends them as a batch once the batch is full", func(t *testing.T) {
+		etxs := []bulletprooftxmanager.EthTx{newBatchableEthTx(t, time.Now()), newBatchableEthTx(t, time.Now())}
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+		for _, etx := range etxs {
+			require.NoError(t, db.First(&etx, etx.ID).Error)
+			assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+		}
+
+		etxs = append(etxs, newBatchableEthTx(t, time.Now()))
+		ethClient.On("SendTransaction", mock.Anything, sentToBatchContract).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		var batch bulletprooftxmanager.EthTx
+		require.NoError(t, db.Where("to_address = ?", batchContractAddress).First(&batch).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, batch.State)
+		payload, gasLimit, err := bulletprooftxmanager.EncodeBatch(etxs)
+		require.NoError(t, err)
+		assert.Equal(t, payload, batch.EncodedPayload)
+		assert.Equal(t, gasLimit, batch.GasLimit)
+
+		for i, etx := range etxs {
+			require.NoError(t, db.First(&etx, etx.ID).Error)
+			assert.Equal(t, bulletprooftxmanager.EthTxBatched, etx.State)
+			require.NotNil(t, etx.BatchEthTxID)
+			assert.Equal(t, batch.ID, *etx.BatchEthTxID)
+			require.NotNil(t, etx.BatchIndex)
+			assert.Equal(t, i, *etx.BatchIndex)
+			assert.Nil(t, etx.Nonce)
+		}
+	})
+
+	t.Run("sends a batchable eth_tx on its own if nothing was batched with it during the batch window", func(t *testing.T) {
+		etx := newBatchableEthTx(t, time.Now().Add(-2*window))
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return *tx.To() == etx.ToAddress
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.Nil(t, etx.BatchEthTxID)
+	})
+
+	t.Run("sends the eth_txes of a batch which fatally errored on their own", func(t *testing.T) {
+		etxs := []bulletprooftxmanager.EthTx{newBatchableEthTx(t, time.Now().Add(-2*window)), newBatchableEthTx(t, time.Now().Add(-2*window))}
+		ethClient.On("SendTransaction", mock.Anything, sentToBatchContract).Return(errors.New("exceeds block gas limit")).Once()
+		for _, etx := range etxs {
+			to := etx.ToAddress
+			ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+				return *tx.To() == to
+			})).Return(nil).Once()
+		}
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		for _, etx := range etxs {
+			require.NoError(t, db.First(&etx, etx.ID).Error)
+			assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+			assert.Nil(t, etx.BatchEthTxID)
+			assert.False(t, etx.Batchable)
+		}
+	})
+
+	t.Run("sends a batch as soon as its gas limit would exceed the block gas limit", func(t *testing.T) {
+		etxs := []bulletprooftxmanager.EthTx{newBatchableEthTx(t, time.Now()), newBatchableEthTx(t, time.Now()), newBatchableEthTx(t, time.Now())}
+		eb.SetLatestBlockGasLimit(bulletprooftxmanager.BatchGasLimit(etxs[:2]))
+		t.Cleanup(func() { eb.SetLatestBlockGasLimit(0) })
+		ethClient.On("SendTransaction", mock.Anything, sentToBatchContract).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		var batch bulletprooftxmanager.EthTx
+		require.NoError(t, db.Where("to_address = ?", batchContractAddress).Order("id DESC").First(&batch).Error)
+		_, gasLimit, err := bulletprooftxmanager.EncodeBatch(etxs[:2])
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit, batch.GasLimit)
+
+		for _, etx := range etxs[:2] {
+			require.NoError(t, db.First(&etx, etx.ID).Error)
+			assert.Equal(t, bulletprooftxmanager.EthTxBatched, etx.State)
+			require.NotNil(t, etx.BatchEthTxID)
+			assert.Equal(t, batch.ID, *etx.BatchEthTxID)
+		}
+		etx := etxs[2]
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+		assert.Nil(t, etx.BatchEthTxID)
+	})
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthBroadcaster_EthTxInsertEventCausesTriggerToFire(t *testing.T) {
 	// NOTE: Testing triggers requires committing transactions and does not work with transactional tests
 	cfg, sqlxdb, db := heavyweight.FullTestDB(t, "eth_tx_triggers", true, true)
@@ -2,6 +2,7 @@ package bulletprooftxmanager
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -10,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -19,7 +21,9 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"go.uber.org/multierr"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -100,6 +104,8 @@ type EthConfirmer struct {
 	wg        sync.WaitGroup
 
 	nConsecutiveBlocksChainTooShort int
+
+	revertReasonWorker utils.SleeperTask
 }
 
 // NewEthConfirmer instantiates a new eth confirmer
@@ -107,7 +113,7 @@ func NewEthConfirmer(db *gorm.DB, ethClient eth.Client, config Config, keystore
 	keyStates []ethkey.State, estimator gas.Estimator, resumeCallback ResumeCallback, lggr logger.Logger) *EthConfirmer {
 
 	context, cancel := context.WithCancel(context.Background())
-	return &EthConfirmer{
+	ec := &EthConfirmer{
 		utils.StartStopOnce{},
 		lggr.Named("EthConfirmer"),
 		db,
@@ -125,7 +131,12 @@ func NewEthConfirmer(db *gorm.DB, ethClient eth.Client, config Config, keystore
 		cancel,
 		sync.WaitGroup{},
 		0,
+		nil,
 	}
+	ec.revertReasonWorker = utils.NewSleeperTask(
+		utils.SleeperTaskFuncWorker(ec.fetchPendingRevertReasons),
+	)
+	return ec
 }
 
 func (ec *EthConfirmer) Start() error {
@@ -138,6 +149,9 @@ func (ec *EthConfirmer) Start() error {
 
 		ec.wg.Add(1)
 		go ec.runLoop()
+		// Receipts may have been saved before a restart without their
+		// revert reasons
+		ec.revertReasonWorker.WakeUp()
 
 		return nil
 	})
@@ -148,7 +162,7 @@ func (ec *EthConfirmer) Close() error {
 		ec.ctxCancel()
 		ec.wg.Wait()
 
-		return nil
+		return ec.revertReasonWorker.Stop()
 	})
 }
 
@@ -206,6 +220,20 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head eth.Head) error {
 	ec.lggr.Debugw("Finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
+	if err := ec.ConfirmBatchedTransactions(ctx, head.Number); err != nil {
+		return errors.Wrap(err, "ConfirmBatchedTransactions failed")
+	}
+
+	ec.lggr.Debugw("Finished ConfirmBatchedTransactions", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	mark = time.Now()
+
+	if err := ec.AbandonExpiredTransactions(ctx, head.Number); err != nil {
+		return errors.Wrap(err, "AbandonExpiredTransactions failed")
+	}
+
+	ec.lggr.Debugw("Finished AbandonExpiredTransactions", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	mark = time.Now()
+
 	if err := ec.RebroadcastWhereNecessary(ctx, head.Number); err != nil {
 		return errors.Wrap(err, "RebroadcastWhereNecessary failed")
 	}
@@ -218,6 +246,13 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head eth.Head) error {
 	}
 
 	ec.lggr.Debugw("Finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	mark = time.Now()
+
+	if err := ec.CheckKeyBudgets(ctx); err != nil {
+		return errors.Wrap(err, "CheckKeyBudgets failed")
+	}
+
+	ec.lggr.Debugw("Finished CheckKeyBudgets", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 
 	if ec.resumeCallback != nil {
 		mark = time.Now()
@@ -231,6 +266,95 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head eth.Head) error {
 	return nil
 }
 
+// CheckKeyBudgets clears the tripped state of the keys which are back within
+// their spending budget, so that they can send transactions again. Keys stay
+// tripped for as long as their budget is exceeded.
+func (ec *EthConfirmer) CheckKeyBudgets(ctx context.Context) error {
+	q := postgres.NewQ(postgres.UnwrapGormDB(ec.db), postgres.WithParentCtx(ctx))
+	for _, key := range ec.keyStates {
+		address := key.Address.Address()
+		budget := keyBudgetFromConfig(ec.config, address)
+		if budget.Enabled() {
+			usage, err := GetKeyBudgetUsage(q, address, budget, ec.chainID)
+			if err != nil {
+				return err
+			}
+			if usage.TrippedAt != nil && usage.Exceeded() {
+				promKeyBudgetTripped.WithLabelValues(ec.chainID.String(), address.Hex()).Set(1)
+				continue
+			}
+		}
+		if err := resetKeyBudget(q, ec.lggr, address, ec.chainID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AbandonExpiredTransactions cancels the unconfirmed eth_txes which expired
+// at the given time or block height, by replacing them with a 0-value
+// transfer to their sender at the same nonce. The replacement attempts are
+// sent by RebroadcastWhereNecessary. Once cancelled, an eth_tx no longer
+// expires, and the pipeline run waiting on it is resumed with an error.
+func (ec *EthConfirmer) AbandonExpiredTransactions(ctx context.Context, blockNum int64) error {
+	for _, key := range ec.keyStates {
+		var etxs []EthTx
+		err := ec.db.
+			WithContext(ctx).
+			Where("from_address = ? AND state = 'unconfirmed' AND evm_chain_id = ? AND (expires_at IS NOT NULL OR expires_at_block IS NOT NULL)", key.Address.Address(), ec.chainID.String()).
+			Where("NOT EXISTS (SELECT 1 FROM eth_tx_attempts WHERE eth_tx_attempts.eth_tx_id = eth_txes.id AND eth_tx_attempts.state = 'in_progress')").
+			Order("nonce ASC").
+			Find(&etxs).Error
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "AbandonExpiredTransactions failed to load eth_txes")
+		}
+
+		now := time.Now()
+		for _, etx := range etxs {
+			if !etx.IsExpired(now, blockNum) {
+				continue
+			}
+			if err := ec.abandonExpiredTransaction(etx, blockNum); err != nil {
+				return errors.Wrapf(err, "AbandonExpiredTransactions failed to cancel eth_tx %v", etx.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func (ec *EthConfirmer) abandonExpiredTransaction(etx EthTx, blockNum int64) error {
+	ec.lggr.Warnw("Transaction expired before being confirmed, cancelling it", "etxID", etx.ID, "nonce", etx.Nonce, "expiresAt", etx.ExpiresAt, "expiresAtBlock", etx.ExpiresAtBlock, "blockNum", blockNum)
+
+	var cancelledTaskRunID uuid.NullUUID
+	err := postgres.GormTransactionWithDefaultContext(ec.db, func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND state = 'unconfirmed'", etx.ID).
+			First(&etx).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to load eth_tx")
+		}
+		etx.ExpiresAt = nil
+		etx.ExpiresAtBlock = null.Int64{}
+		cancelledTaskRunID, err = replaceUnconfirmedEthTx(tx, &etx, nil, ec.estimator, ec.ChainKeyStore)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if cancelledTaskRunID.Valid && ec.resumeCallback != nil {
+		err = ec.resumeCallback(cancelledTaskRunID.UUID, nil, errors.Errorf("transaction %v expired before being confirmed", etx.ID))
+		if errors.Is(err, sql.ErrNoRows) {
+			ec.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID)
+		} else if err != nil {
+			return errors.Wrap(err, "failed to resume pipeline")
+		}
+	}
+	return nil
+}
+
 // SetBroadcastBeforeBlockNum updates already broadcast attempts with the
 // current block number. This is safe no matter how old the head is because if
 // the attempt is already broadcast it _must_ have been before this head.
@@ -349,6 +473,7 @@ func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []Eth
 		}
 		promNumConfirmedTxs.WithLabelValues(ec.chainID.String()).Add(float64(len(receipts)))
 	}
+	ec.IfStarted(ec.revertReasonWorker.WakeUp)
 	return nil
 }
 
@@ -453,6 +578,115 @@ func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTx
 	return
 }
 
+// pendingRevertReason is a reverted transaction whose receipt was saved
+// before its revert reason was fetched
+type pendingRevertReason struct {
+	EthTxID     int64              `db:"eth_tx_id"`
+	FromAddress gethCommon.Address `db:"from_address"`
+	ToAddress   gethCommon.Address `db:"to_address"`
+	Value       assets.Eth         `db:"value"`
+	Payload     []byte             `db:"encoded_payload"`
+	GasLimit    uint64             `db:"chain_specific_gas_limit"`
+	TxHash      gethCommon.Hash    `db:"tx_hash"`
+	BlockNumber int64              `db:"block_number"`
+}
+
+func (ec *EthConfirmer) fetchPendingRevertReasons() {
+	if err := ec.FetchPendingRevertReasons(ec.ctx); err != nil && ec.ctx.Err() == nil {
+		ec.lggr.Errorw("Error fetching revert reasons", "err", err)
+	}
+}
+
+// FetchPendingRevertReasons replays the reverted transactions whose receipts
+// were saved without looking up why they reverted. This runs in the
+// background after receipts are saved, so that saving them never waits on
+// eth_call. Each receipt is replayed once, failing to get a revert reason
+// leaves it without one.
+func (ec *EthConfirmer) FetchPendingRevertReasons(ctx context.Context) error {
+	var pending []pendingRevertReason
+	err := postgres.UnwrapGormDB(ec.db).SelectContext(ctx, &pending, `
+SELECT eth_txes.id AS eth_tx_id, eth_txes.from_address, eth_txes.to_address, eth_txes.value, eth_txes.encoded_payload, eth_tx_attempts.chain_specific_gas_limit,
+	eth_receipts.tx_hash, eth_receipts.block_number
+FROM eth_receipts
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+WHERE eth_receipts.revert_reason_pending AND eth_txes.evm_chain_id = $1
+ORDER BY eth_receipts.block_number ASC, eth_txes.id ASC
+`, ec.chainID.String())
+	if err != nil {
+		return errors.Wrap(err, "FetchPendingRevertReasons failed to load reverted transactions")
+	}
+
+	for _, p := range pending {
+		if ctx.Err() != nil {
+			return nil
+		}
+		l := ec.lggr.With("txHash", p.TxHash.Hex(), "ethTxID", p.EthTxID, "blockNumber", p.BlockNumber)
+		revertReason := sql.NullString{}
+		reason, err := ec.fetchRevertReason(ctx, p)
+		if err != nil {
+			l.Warnw("Failed to get revert reason for reverted transaction", "err", err)
+		} else {
+			l.Warnw("Transaction reverted on-chain", "revertReason", reason)
+			revertReason = sql.NullString{String: reason, Valid: true}
+		}
+		if err = ec.saveRevertReason(ctx, p, revertReason); err != nil {
+			return errors.Wrapf(err, "FetchPendingRevertReasons failed to save the revert reason of eth_tx %v", p.EthTxID)
+		}
+	}
+	return nil
+}
+
+// fetchRevertReason replays a reverted transaction with eth_call on top of
+// the block before the one it was mined in. Like for batch results, the replay
+// is only accurate if no earlier transaction in the same block affected it.
+func (ec *EthConfirmer) fetchRevertReason(ctx context.Context, p pendingRevertReason) (string, error) {
+	msg := ethereum.CallMsg{
+		From:  p.FromAddress,
+		To:    &p.ToAddress,
+		Gas:   p.GasLimit,
+		Value: p.Value.ToInt(),
+		Data:  p.Payload,
+	}
+
+	ctx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+
+	_, err := ec.ethClient.CallContract(ctx, msg, big.NewInt(p.BlockNumber-1))
+	if err == nil {
+		return "", errors.New("transaction did not revert when replayed")
+	}
+	data, dataErr := eth.ExtractRevertDataFromRPCError(err)
+	if dataErr != nil {
+		// Some reverts have no data, e.g. running out of gas, in which case
+		// the RPC error message is the best reason we have
+		if jErr := eth.ExtractRPCError(err); jErr != nil && jErr.Message != "" {
+			return jErr.Message, nil
+		}
+		return "", errors.Wrap(err, "failed to replay transaction")
+	}
+	return defaultRevertReasonDecoder.Decode(data)
+}
+
+// saveRevertReason saves the revert reason of a receipt, if it is still there,
+// and copies it onto its eth_tx
+func (ec *EthConfirmer) saveRevertReason(ctx context.Context, p pendingRevertReason, revertReason sql.NullString) error {
+	ctx, cancel := postgres.DefaultQueryCtxWithParent(ctx)
+	defer cancel()
+
+	_, err := postgres.UnwrapGormDB(ec.db).ExecContext(ctx, `
+WITH updated_receipts AS (
+	UPDATE eth_receipts SET revert_reason = $1, revert_reason_pending = false
+	WHERE tx_hash = $2 AND revert_reason_pending
+	RETURNING tx_hash
+)
+UPDATE eth_txes SET revert_reason = $1
+FROM updated_receipts
+WHERE eth_txes.id = $3 AND eth_txes.state = 'confirmed'
+`, revertReason, p.TxHash, p.EthTxID)
+	return err
+}
+
 func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	if len(receipts) == 0 {
 		return nil
@@ -483,6 +717,12 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	//
 	// # EthTxes update
 	// Should be self-explanatory. If we got a receipt, the eth_tx is confirmed.
+	// The revert reason of the receipt, if any, is copied onto the eth_tx.
+	//
+	// # Revert reasons
+	// Receipts of reverted transactions are saved with revert_reason_pending
+	// set, their revert reasons are fetched afterwards by
+	// FetchPendingRevertReasons. An upserted receipt keeps what it had.
 	//
 	var valueStrs []string
 	var valueArgs []interface{}
@@ -492,21 +732,21 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 		if err != nil {
 			return errors.Wrap(err, "saveFetchedReceipts failed to marshal JSON")
 		}
-		valueStrs = append(valueStrs, "(?,?,?,?,?,NOW())")
-		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON)
+		valueStrs = append(valueStrs, "(?,?,?,?,?,?,NOW())")
+		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON, r.Status == 0)
 	}
 	valueArgs = append(valueArgs, ec.chainID.String())
 
 	/* #nosec G201 */
 	sql := `
 	WITH inserted_receipts AS (
-		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at)
+		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, revert_reason_pending, created_at)
 		VALUES %s
 		ON CONFLICT (tx_hash, block_hash) DO UPDATE SET
 			block_number = EXCLUDED.block_number,
 			transaction_index = EXCLUDED.transaction_index,
 			receipt = EXCLUDED.receipt
-		RETURNING eth_receipts.tx_hash, eth_receipts.block_number
+		RETURNING eth_receipts.tx_hash, eth_receipts.block_number, eth_receipts.revert_reason
 	),
 	updated_eth_tx_attempts AS (
 		UPDATE eth_tx_attempts
@@ -515,10 +755,10 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 			broadcast_before_block_num = COALESCE(eth_tx_attempts.broadcast_before_block_num, inserted_receipts.block_number)
 		FROM inserted_receipts
 		WHERE inserted_receipts.tx_hash = eth_tx_attempts.hash
-		RETURNING eth_tx_attempts.eth_tx_id
+		RETURNING eth_tx_attempts.eth_tx_id, inserted_receipts.revert_reason
 	)
 	UPDATE eth_txes
-	SET state = 'confirmed'
+	SET state = 'confirmed', revert_reason = updated_eth_tx_attempts.revert_reason
 	FROM updated_eth_tx_attempts
 	WHERE updated_eth_tx_attempts.eth_tx_id = eth_txes.id
 	AND evm_chain_id = ?
@@ -533,6 +773,139 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
 }
 
+// confirmedBatch is a confirmed eth_tx which sends a batch of eth_txes
+type confirmedBatch struct {
+	EthTxID      int64              `db:"eth_tx_id"`
+	FromAddress  gethCommon.Address `db:"from_address"`
+	ToAddress    gethCommon.Address `db:"to_address"`
+	Payload      []byte             `db:"encoded_payload"`
+	GasLimit     uint64             `db:"chain_specific_gas_limit"`
+	TxHash       gethCommon.Hash    `db:"tx_hash"`
+	BlockNumber  int64              `db:"block_number"`
+	Receipt      []byte             `db:"receipt"`
+	RevertReason sql.NullString     `db:"revert_reason"`
+}
+
+// ConfirmBatchedTransactions confirms the eth_txes sent by confirmed batches,
+// each with the result of its own call in the batch. A failed call is saved
+// as the revert reason of its eth_tx. If the results of a batch cannot be
+// fetched, this is retried on later heads until the batch is final, after
+// which its eth_txes are confirmed as failed.
+func (ec *EthConfirmer) ConfirmBatchedTransactions(ctx context.Context, blockNum int64) error {
+	var batches []confirmedBatch
+	err := postgres.UnwrapGormDB(ec.db).SelectContext(ctx, &batches, `
+SELECT eth_txes.id AS eth_tx_id, eth_txes.from_address, eth_txes.to_address, eth_txes.encoded_payload, eth_tx_attempts.chain_specific_gas_limit,
+	eth_receipts.tx_hash, eth_receipts.block_number, eth_receipts.receipt, eth_receipts.revert_reason
+FROM eth_txes
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+WHERE eth_txes.state = 'confirmed' AND eth_txes.evm_chain_id = $1 AND NOT eth_receipts.revert_reason_pending
+AND EXISTS (SELECT 1 FROM eth_txes batched WHERE batched.batch_eth_tx_id = eth_txes.id AND batched.state = 'batched')
+ORDER BY eth_receipts.block_number ASC, eth_txes.id ASC
+`, ec.chainID.String())
+	if ctx.Err() != nil {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "ConfirmBatchedTransactions failed to load batches")
+	}
+
+	for _, batch := range batches {
+		l := ec.lggr.With("batchEthTxID", batch.EthTxID, "txHash", batch.TxHash.Hex(), "blockNumber", batch.BlockNumber)
+		var receipt Receipt
+		if err = json.Unmarshal(batch.Receipt, &receipt); err != nil {
+			return errors.Wrapf(err, "ConfirmBatchedTransactions failed to unmarshal receipt of eth_tx %v", batch.EthTxID)
+		}
+
+		var results []batchResult
+		var failure string
+		if receipt.Status == 0 {
+			failure = "batch transaction reverted"
+			if batch.RevertReason.Valid {
+				failure = fmt.Sprintf("%s: %s", failure, batch.RevertReason.String)
+			}
+		} else {
+			results, err = ec.fetchBatchResults(ctx, batch)
+			if err != nil {
+				if batch.BlockNumber > blockNum-int64(ec.config.EvmFinalityDepth()) {
+					l.Warnw("Failed to get the results of the batch, will retry on the next head", "err", err)
+					continue
+				}
+				l.Errorw("Failed to get the results of the final batch, its transactions are failed", "err", err)
+				failure = fmt.Sprintf("failed to get the result of the call in batch transaction %s: %v", batch.TxHash.Hex(), err)
+			}
+		}
+
+		if err = ec.confirmBatchedEthTxs(batch, results, failure); err != nil {
+			return errors.Wrapf(err, "ConfirmBatchedTransactions failed to confirm the eth_txes of batch %v", batch.EthTxID)
+		}
+	}
+	return nil
+}
+
+// fetchBatchResults returns the results of the calls made by a batch, which
+// are the return data of the batch transaction. They are taken from a trace of
+// the transaction or, if the node cannot trace transactions, from replaying it
+// with eth_call on top of the block before it. A replay is only accurate if
+// no earlier transaction in the same block affected the calls.
+func (ec *EthConfirmer) fetchBatchResults(ctx context.Context, batch confirmedBatch) ([]batchResult, error) {
+	ctx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+
+	var trace struct {
+		Output hexutil.Bytes `json:"output"`
+	}
+	err := ec.ethClient.CallContext(ctx, &trace, "debug_traceTransaction", batch.TxHash, map[string]interface{}{"tracer": "callTracer"})
+	if err == nil {
+		return decodeBatchResults(trace.Output)
+	}
+	ec.lggr.Debugw("Failed to trace batch transaction, replaying it instead", "err", err, "txHash", batch.TxHash.Hex())
+
+	msg := ethereum.CallMsg{
+		From: batch.FromAddress,
+		To:   &batch.ToAddress,
+		Gas:  batch.GasLimit,
+		Data: batch.Payload,
+	}
+	output, err := ec.ethClient.CallContract(ctx, msg, big.NewInt(batch.BlockNumber-1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to replay batch transaction")
+	}
+	return decodeBatchResults(output)
+}
+
+// confirmBatchedEthTxs confirms the eth_txes of the batch with the results of
+// their calls, or fails all of them if failure is set
+func (ec *EthConfirmer) confirmBatchedEthTxs(batch confirmedBatch, results []batchResult, failure string) error {
+	return postgres.GormTransactionWithDefaultContext(ec.db, func(tx *gorm.DB) error {
+		var etxs []EthTx
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("batch_eth_tx_id = ? AND state = 'batched'", batch.EthTxID).
+			Order("batch_index ASC").
+			Find(&etxs).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to load batched eth_txes")
+		}
+		for _, etx := range etxs {
+			revertReason := failure
+			if failure == "" {
+				if etx.BatchIndex == nil || *etx.BatchIndex >= len(results) {
+					revertReason = fmt.Sprintf("no result for the call in batch transaction %s", batch.TxHash.Hex())
+				} else {
+					revertReason = results[*etx.BatchIndex].revertReason()
+				}
+			}
+			if revertReason != "" {
+				ec.lggr.Warnw("Batched transaction failed", "ethTxID", etx.ID, "batchEthTxID", batch.EthTxID, "revertReason", revertReason)
+			}
+			err = tx.Exec(`UPDATE eth_txes SET state = 'confirmed', revert_reason = ? WHERE id = ?`, sql.NullString{String: revertReason, Valid: revertReason != ""}, etx.ID).Error
+			if err != nil {
+				return errors.Wrapf(err, "failed to confirm batched eth_tx %v", etx.ID)
+			}
+		}
+		return nil
+	})
+}
+
 // markConfirmedMissingReceipt
 // It is possible that we can fail to get a receipt for all eth_tx_attempts
 // even though a transaction with this nonce has long since been confirmed (we
@@ -866,6 +1239,12 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 			previousAttempt.State = EthTxAttemptInProgress
 			return previousAttempt, nil
 		}
+		if err == nil && ec.bumpExceedsKeyBudget(ctx, previousAttempt, attempt) {
+			// Hold the gas price of the previous attempt until the key is within its budget again
+			previousAttempt.BroadcastBeforeBlockNum = nil
+			previousAttempt.State = EthTxAttemptInProgress
+			return previousAttempt, nil
+		}
 		return attempt, err
 	}
 	return attempt, errors.Errorf("invariant violation: EthTx %v was unconfirmed but didn't have any attempts. "+
@@ -873,6 +1252,37 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 		"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", etx.ID)
 }
 
+// bumpExceedsKeyBudget returns true if the extra gas of the bumped attempt
+// would put the key over its spending budget. Like CheckKeyBudgets, the key is
+// only tripped if its budget is already exceeded, so that a held bump does not
+// trip and reset the key on every head.
+func (ec *EthConfirmer) bumpExceedsKeyBudget(ctx context.Context, previousAttempt, bumpedAttempt EthTxAttempt) bool {
+	address := previousAttempt.EthTx.FromAddress
+	budget := keyBudgetFromConfig(ec.config, address)
+	if budget.MaxSpendWei == nil {
+		return false
+	}
+	q := postgres.NewQ(postgres.UnwrapGormDB(ec.db), postgres.WithParentCtx(ctx))
+	usage, err := GetKeyBudgetUsage(q, address, budget, ec.chainID)
+	if err != nil {
+		ec.lggr.Errorw("Failed to check key budget, bumping gas anyway", "fromAddress", address, "err", err)
+		return false
+	}
+	extra := new(big.Int).Sub(attemptCost(bumpedAttempt), attemptCost(previousAttempt))
+	if !usage.ExceededBy(extra) {
+		return false
+	}
+	ec.lggr.Warnw("Not bumping gas, bumping would exceed the spending budget of the key", append(ec.logFieldsPreviousAttempt(previousAttempt), "spentWei", usage.SpentWei, "extraWei", extra, "maxSpendWei", budget.MaxSpendWei)...)
+	if !usage.Exceeded() {
+		promKeyBudgetExceeded.WithLabelValues(ec.chainID.String(), address.Hex()).Inc()
+		return true
+	}
+	if err = tripKeyBudget(q, ec.lggr, usage, address, ec.chainID); err != nil {
+		ec.lggr.Errorw("Failed to trip key budget", "fromAddress", address, "err", err)
+	}
+	return true
+}
+
 func (ec *EthConfirmer) logFieldsPreviousAttempt(attempt EthTxAttempt) []interface{} {
 	etx := attempt.EthTx
 	return []interface{}{
@@ -886,6 +1296,11 @@ func (ec *EthConfirmer) logFieldsPreviousAttempt(attempt EthTxAttempt) []interfa
 }
 
 func (ec *EthConfirmer) bumpGas(previousAttempt EthTxAttempt) (bumpedAttempt EthTxAttempt, err error) {
+	// A cancelled or replaced eth_tx is bumped with its replacement
+	previousAttempt.EthTx, err = withEthTxReplacement(ec.db, previousAttempt.EthTx)
+	if err != nil {
+		return bumpedAttempt, errors.Wrap(err, "error bumping gas")
+	}
 	logFields := ec.logFieldsPreviousAttempt(previousAttempt)
 	switch previousAttempt.TxType {
 	case 0x0:
@@ -1223,6 +1638,9 @@ func (ec *EthConfirmer) markForRebroadcast(etx EthTx, head eth.Head) error {
 		if err := unconfirmEthTx(tx, etx); err != nil {
 			return errors.Wrapf(err, "unconfirmEthTx failed for etx %v", etx.ID)
 		}
+		if err := unconfirmBatchedEthTxs(tx, etx); err != nil {
+			return errors.Wrapf(err, "unconfirmBatchedEthTxs failed for etx %v", etx.ID)
+		}
 		return unbroadcastAttempt(tx, attempt)
 	})
 	return errors.Wrap(err, "markForRebroadcast failed")
@@ -1241,7 +1659,13 @@ func unconfirmEthTx(db *gorm.DB, etx EthTx) error {
 	if etx.State != EthTxConfirmed {
 		return errors.New("expected eth_tx state to be confirmed")
 	}
-	return errors.Wrap(db.Exec(`UPDATE eth_txes SET state = 'unconfirmed' WHERE id = ?`, etx.ID).Error, "unconfirmEthTx failed")
+	return errors.Wrap(db.Exec(`UPDATE eth_txes SET state = 'unconfirmed', revert_reason = NULL WHERE id = ?`, etx.ID).Error, "unconfirmEthTx failed")
+}
+
+// unconfirmBatchedEthTxs puts the eth_txes sent by a batch back to awaiting
+// the results of their calls, if the batch is unconfirmed
+func unconfirmBatchedEthTxs(db *gorm.DB, etx EthTx) error {
+	return errors.Wrap(db.Exec(`UPDATE eth_txes SET state = 'batched', revert_reason = NULL WHERE batch_eth_tx_id = ? AND state = 'confirmed'`, etx.ID).Error, "unconfirmBatchedEthTxs failed")
 }
 
 func unbroadcastAttempt(db *gorm.DB, attempt EthTxAttempt) error {
@@ -1275,6 +1699,11 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 			ec.lggr.Infow("ForceRebroadcast: successfully rebroadcast empty transaction", "nonce", n, "hash", hash.String())
 		} else {
 			ec.lggr.Debugf("ForceRebroadcast: got eth_tx %v with nonce %v, will rebroadcast this transaction", etx.ID, *etx.Nonce)
+			replaced, err := withEthTxReplacement(ec.db, *etx)
+			if err != nil {
+				return errors.Wrap(err, "ForceRebroadcast failed")
+			}
+			etx = &replaced
 			if overrideGasLimit != 0 {
 				etx.GasLimit = overrideGasLimit
 			}
@@ -1328,25 +1757,45 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head eth.Head
 	sqlxDB := postgres.UnwrapGormDB(ec.db)
 
 	type x struct {
-		ID      uuid.UUID
-		Receipt []byte
+		ID           uuid.UUID
+		Receipt      []byte
+		TxHash       gethCommon.Hash `db:"tx_hash"`
+		RevertReason sql.NullString  `db:"revert_reason"`
 	}
 	var receipts []x
 	// NOTE: we don't filter on eth_txes.state = 'confirmed', because a transaction with an attached receipt
 	// is guaranteed to be confirmed. This results in a slightly better query plan.
+	// A batched transaction gets the receipt of its batch once it is confirmed
+	// with the result of its own call, which is saved as its revert reason if
+	// the call failed.
+	// A reverted transaction resumes the task run with an error, which includes
+	// the revert reason if it is known, once it has been looked up.
 	if err := sqlxDB.Select(&receipts, `
-	SELECT pipeline_task_runs.id, eth_receipts.receipt FROM pipeline_task_runs
+	SELECT pipeline_task_runs.id, eth_receipts.receipt, eth_receipts.tx_hash,
+		CASE WHEN eth_txes.batch_eth_tx_id IS NULL THEN eth_receipts.revert_reason ELSE eth_txes.revert_reason END AS revert_reason
+	FROM pipeline_task_runs
 	INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
 	INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
-	INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id
+	INNER JOIN eth_tx_attempts ON COALESCE(eth_txes.batch_eth_tx_id, eth_txes.id) = eth_tx_attempts.eth_tx_id
 	INNER JOIN eth_receipts ON eth_tx_attempts.hash = eth_receipts.tx_hash
 	WHERE pipeline_runs.state = 'suspended' AND eth_receipts.block_number <= ($1 - eth_txes.min_confirmations) AND eth_txes.evm_chain_id = $2
+	AND (eth_txes.batch_eth_tx_id IS NULL OR eth_txes.state = 'confirmed') AND NOT eth_receipts.revert_reason_pending
 	`, head.Number, ec.chainID.String()); err != nil {
 		return err
 	}
 
 	for _, data := range receipts {
-		if err := ec.resumeCallback(data.ID, data.Receipt, nil); err != nil {
+		var receipt Receipt
+		if err := json.Unmarshal(data.Receipt, &receipt); err != nil {
+			return errors.Wrapf(err, "ResumePendingTaskRuns failed to unmarshal receipt for task run %s", data.ID)
+		}
+		var taskErr error
+		if data.RevertReason.Valid {
+			taskErr = errors.Errorf("transaction %s reverted on-chain: %s", data.TxHash.Hex(), data.RevertReason.String)
+		} else if receipt.Status == 0 {
+			taskErr = errors.Errorf("transaction %s reverted on-chain", data.TxHash.Hex())
+		}
+		if err := ec.resumeCallback(data.ID, data.Receipt, taskErr); err != nil {
 			return err
 		}
 	}
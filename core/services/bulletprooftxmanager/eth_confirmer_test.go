@@ -1,6 +1,7 @@
 package bulletprooftxmanager_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -18,7 +19,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
@@ -26,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gorm.io/gorm"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -507,6 +511,75 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_CheckForReceipts_revert_reason(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	state := cltest.MustGetStateForKey(t, ethKeyStore, key)
+
+	config := newTestChainScopedConfig(t)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+	attempt := etx.EthTxAttempts[0]
+
+	bptxmReceipt := bulletprooftxmanager.Receipt{
+		TxHash:           attempt.Hash,
+		BlockHash:        utils.NewHash(),
+		BlockNumber:      big.NewInt(42),
+		TransactionIndex: uint(1),
+		Status:           0,
+	}
+
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], attempt.Hash)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &bptxmReceipt
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(context.Background(), 42))
+
+	// The receipt is saved before the revert reason is fetched
+	confirmed, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxConfirmed, confirmed.State)
+	assert.False(t, confirmed.RevertReason.Valid)
+	var pending bool
+	require.NoError(t, sqlxdb.Get(&pending, `SELECT revert_reason_pending FROM eth_receipts WHERE tx_hash = $1`, attempt.Hash))
+	assert.True(t, pending)
+
+	// Replaying the transaction on top of the block before its receipt panics
+	// with an arithmetic overflow
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.From == fromAddress && *msg.To == etx.ToAddress && msg.Gas == attempt.ChainSpecificGasLimit
+	}), big.NewInt(41)).Return(nil, &eth.JsonError{
+		Code:    3,
+		Message: "execution reverted",
+		Data:    "0x4e487b710000000000000000000000000000000000000000000000000000000000000011",
+	}).Once()
+
+	require.NoError(t, ec.FetchPendingRevertReasons(context.Background()))
+	// Each receipt is only replayed once
+	require.NoError(t, ec.FetchPendingRevertReasons(context.Background()))
+
+	etx, err = cltest.FindEthTxWithAttempts(db, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx.State)
+	assert.Equal(t, null.StringFrom("panic: arithmetic underflow or overflow (0x11)"), etx.RevertReason)
+	require.Len(t, etx.EthTxAttempts[0].EthReceipts, 1)
+	assert.Equal(t, null.StringFrom("panic: arithmetic underflow or overflow (0x11)"), etx.EthTxAttempts[0].EthReceipts[0].RevertReason)
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthConfirmer_CheckForReceipts_batching(t *testing.T) {
 	t.Parallel()
 
@@ -2466,4 +2539,378 @@ func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 		}
 	})
 
+	t.Run("processes confirmed batched eth_txes with the receipt of their batch", func(t *testing.T) {
+		ch := make(chan interface{})
+		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
+			require.Nil(t, err)
+			ch <- value
+			return nil
+		})
+
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		err := db.Exec(`UPDATE pipeline_runs SET state = 'suspended' WHERE id = ?`, run.ID).Error
+		require.NoError(t, err)
+
+		batch := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 4, 1, fromAddress)
+		attempt := batch.EthTxAttempts[0]
+		receipt := cltest.MustInsertEthReceipt(t, db, head.Number-minConfirmations, head.Hash, attempt.Hash)
+
+		batchIndex := 0
+		etx := cltest.NewEthTx(t, fromAddress)
+		etx.State = bulletprooftxmanager.EthTxConfirmed
+		etx.BatchEthTxID = &batch.ID
+		etx.BatchIndex = &batchIndex
+		etx.PipelineTaskRunID = uuid.NullUUID{UUID: tr.ID, Valid: true}
+		etx.MinConfirmations = clnull.Uint32From(uint32(minConfirmations))
+		require.NoError(t, db.Save(&etx).Error)
+
+		go func() {
+			err = ec.ResumePendingTaskRuns(context.Background(), head)
+			require.NoError(t, err)
+		}()
+
+		select {
+		case data := <-ch:
+			require.IsType(t, []byte{}, data)
+
+			var r bulletprooftxmanager.Receipt
+			err = json.Unmarshal(data.([]byte), &r)
+			require.NoError(t, err)
+			require.Equal(t, receipt.TxHash, r.TxHash)
+
+		case <-time.After(time.Second):
+			t.Fatal("no value received")
+		}
+	})
+
+	t.Run("resumes reverted eth_txes with their revert reason as the error", func(t *testing.T) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		err := db.Exec(`UPDATE pipeline_runs SET state = 'suspended' WHERE id = ?`, run.ID).Error
+		require.NoError(t, err)
+
+		ch := make(chan error)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
+			if id == tr.ID {
+				ch <- err
+			}
+			return nil
+		})
+
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 5, 1, fromAddress)
+		attempt := etx.EthTxAttempts[0]
+		cltest.MustInsertEthReceipt(t, db, head.Number-minConfirmations, head.Hash, attempt.Hash)
+		err = db.Exec(`UPDATE eth_receipts SET revert_reason = 'not enough LINK' WHERE tx_hash = ?`, attempt.Hash).Error
+		require.NoError(t, err)
+
+		err = db.Exec(`UPDATE eth_txes SET pipeline_task_run_id = ?, min_confirmations = ? WHERE id = ?`, &tr.ID, minConfirmations, etx.ID).Error
+		require.NoError(t, err)
+
+		go func() {
+			err = ec.ResumePendingTaskRuns(context.Background(), head)
+			require.NoError(t, err)
+		}()
+
+		select {
+		case err := <-ch:
+			require.EqualError(t, err, fmt.Sprintf("transaction %s reverted on-chain: not enough LINK", attempt.Hash.Hex()))
+		case <-time.After(time.Second):
+			t.Fatal("no value received")
+		}
+	})
+
+	t.Run("resumes reverted eth_txes without a revert reason with an error", func(t *testing.T) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		err := db.Exec(`UPDATE pipeline_runs SET state = 'suspended' WHERE id = ?`, run.ID).Error
+		require.NoError(t, err)
+
+		ch := make(chan error)
+		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
+			if id == tr.ID {
+				ch <- err
+			}
+			return nil
+		})
+
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 6, 1, fromAddress)
+		attempt := etx.EthTxAttempts[0]
+		cltest.MustInsertEthReceipt(t, db, head.Number-minConfirmations, head.Hash, attempt.Hash)
+		err = db.Exec(`UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{status}', '"0x0"') WHERE tx_hash = ?`, attempt.Hash).Error
+		require.NoError(t, err)
+
+		err = db.Exec(`UPDATE eth_txes SET pipeline_task_run_id = ?, min_confirmations = ? WHERE id = ?`, &tr.ID, minConfirmations, etx.ID).Error
+		require.NoError(t, err)
+
+		go func() {
+			err = ec.ResumePendingTaskRuns(context.Background(), head)
+			require.NoError(t, err)
+		}()
+
+		select {
+		case err := <-ch:
+			require.EqualError(t, err, fmt.Sprintf("transaction %s reverted on-chain", attempt.Hash.Hex()))
+		case <-time.After(time.Second):
+			t.Fatal("no value received")
+		}
+	})
+
+	t.Run("does not resume reverted eth_txes until their revert reason was fetched", func(t *testing.T) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		err := db.Exec(`UPDATE pipeline_runs SET state = 'suspended' WHERE id = ?`, run.ID).Error
+		require.NoError(t, err)
+
+		resumed := false
+		ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
+			if id == tr.ID {
+				resumed = true
+			}
+			return nil
+		})
+
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 7, 1, fromAddress)
+		attempt := etx.EthTxAttempts[0]
+		cltest.MustInsertEthReceipt(t, db, head.Number-minConfirmations, head.Hash, attempt.Hash)
+		err = db.Exec(`UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{status}', '"0x0"'), revert_reason_pending = true WHERE tx_hash = ?`, attempt.Hash).Error
+		require.NoError(t, err)
+
+		err = db.Exec(`UPDATE eth_txes SET pipeline_task_run_id = ?, min_confirmations = ? WHERE id = ?`, &tr.ID, minConfirmations, etx.ID).Error
+		require.NoError(t, err)
+
+		require.NoError(t, ec.ResumePendingTaskRuns(context.Background(), head))
+		assert.False(t, resumed)
+	})
+}
+
+func TestEthConfirmer_ConfirmBatchedTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	state := cltest.MustGetStateForKey(t, ethKeyStore, key)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	config := cltest.NewTestGeneralConfig(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, config)
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, nil)
+
+	tryAggregate := eth.MustGetABI(bulletprooftxmanager.BatchContractABI).Methods["tryAggregate"]
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	// Error(string) with the reason "nope"
+	revertData := hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6e6f706500000000000000000000000000000000000000000000000000000000")
+
+	var nonce int64
+	insertBatch := func(t *testing.T, blockNum int64, status uint64) (batch bulletprooftxmanager.EthTx, etxs []bulletprooftxmanager.EthTx) {
+		batch = cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, nonce, blockNum, fromAddress)
+		nonce++
+		cltest.MustInsertEthReceipt(t, db, blockNum, utils.NewHash(), batch.EthTxAttempts[0].Hash)
+		require.NoError(t, db.Exec(`UPDATE eth_receipts SET receipt = jsonb_set(receipt, '{status}', to_jsonb(?::text)) WHERE tx_hash = ?`,
+			hexutil.EncodeUint64(status), batch.EthTxAttempts[0].Hash).Error)
+		for i := 0; i < 2; i++ {
+			batchIndex := i
+			etx := cltest.NewEthTx(t, fromAddress)
+			etx.State = bulletprooftxmanager.EthTxBatched
+			etx.BatchEthTxID = &batch.ID
+			etx.BatchIndex = &batchIndex
+			require.NoError(t, db.Save(&etx).Error)
+			etxs = append(etxs, etx)
+		}
+		return batch, etxs
+	}
+	requireConfirmed := func(t *testing.T, etx bulletprooftxmanager.EthTx, revertReason string) {
+		require.NoError(t, db.First(&etx, etx.ID).Error)
+		assert.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx.State)
+		if revertReason == "" {
+			assert.False(t, etx.RevertReason.Valid)
+		} else {
+			assert.Equal(t, revertReason, etx.RevertReason.String)
+		}
+	}
+	isTraceOf := func(batch bulletprooftxmanager.EthTx) interface{} {
+		return mock.MatchedBy(func(txHash gethCommon.Hash) bool { return txHash == batch.EthTxAttempts[0].Hash })
+	}
+
+	t.Run("confirms each batched eth_tx with the result of its own call", func(t *testing.T) {
+		batch, etxs := insertBatch(t, 10, 1)
+		output, err := tryAggregate.Outputs.Pack([]result{{Success: true, ReturnData: []byte{}}, {Success: false, ReturnData: revertData}})
+		require.NoError(t, err)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", isTraceOf(batch), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"output":"%s"}`, hexutil.Encode(output))), args.Get(1)))
+		}).Once()
+
+		require.NoError(t, ec.ConfirmBatchedTransactions(context.Background(), 10))
+
+		requireConfirmed(t, etxs[0], "")
+		requireConfirmed(t, etxs[1], "nope")
+	})
+
+	t.Run("replays the batch if it cannot be traced", func(t *testing.T) {
+		batch, etxs := insertBatch(t, 11, 1)
+		output, err := tryAggregate.Outputs.Pack([]result{{Success: false, ReturnData: []byte{}}, {Success: true, ReturnData: []byte{}}})
+		require.NoError(t, err)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", isTraceOf(batch), mock.Anything).Return(errors.New("method not found")).Once()
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return *msg.To == batch.ToAddress && bytes.Equal(msg.Data, batch.EncodedPayload)
+		}), big.NewInt(10)).Return(output, nil).Once()
+
+		require.NoError(t, ec.ConfirmBatchedTransactions(context.Background(), 11))
+
+		requireConfirmed(t, etxs[0], "reverted without a reason")
+		requireConfirmed(t, etxs[1], "")
+	})
+
+	t.Run("fails every batched eth_tx of a reverted batch", func(t *testing.T) {
+		_, etxs := insertBatch(t, 12, 0)
+
+		require.NoError(t, ec.ConfirmBatchedTransactions(context.Background(), 12))
+
+		requireConfirmed(t, etxs[0], "batch transaction reverted")
+		requireConfirmed(t, etxs[1], "batch transaction reverted")
+	})
+
+	t.Run("leaves batched eth_txes until the batch is final if its results cannot be fetched", func(t *testing.T) {
+		batch, etxs := insertBatch(t, 13, 1)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "debug_traceTransaction", isTraceOf(batch), mock.Anything).Return(errors.New("method not found"))
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return *msg.To == batch.ToAddress
+		}), big.NewInt(12)).Return(nil, errors.New("missing trie node"))
+
+		require.NoError(t, ec.ConfirmBatchedTransactions(context.Background(), 13))
+
+		for _, etx := range etxs {
+			require.NoError(t, db.First(&etx, etx.ID).Error)
+			assert.Equal(t, bulletprooftxmanager.EthTxBatched, etx.State)
+		}
+
+		require.NoError(t, ec.ConfirmBatchedTransactions(context.Background(), 13+int64(evmcfg.EvmFinalityDepth())))
+
+		for _, etx := range etxs {
+			require.NoError(t, db.First(&etx, etx.ID).Error)
+			assert.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx.State)
+			assert.Contains(t, etx.RevertReason.String, "failed to get the result of the call")
+		}
+	})
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthConfirmer_CheckKeyBudgets(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	state := cltest.MustGetStateForKey(t, ethKeyStore, key)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	config := new(bptxmmocks.Config)
+	config.On("KeySpecificBudgetWindow", fromAddress).Return(time.Hour)
+	// 142 wei of value plus 42 gas at 1 wei for the attempt
+	config.On("KeySpecificBudgetMaxSpendWei", fromAddress).Return(big.NewInt(184))
+	config.On("KeySpecificBudgetMaxTxs", fromAddress).Return(uint32(0))
+
+	ec := bulletprooftxmanager.NewEthConfirmer(db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil, nil, logger.TestLogger(t))
+
+	trippedAt := func(t *testing.T) *time.Time {
+		require.NoError(t, sqlxdb.Get(&state, `SELECT * FROM eth_key_states WHERE address = $1`, fromAddress))
+		return state.BudgetTrippedAt
+	}
+
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 0, 42, fromAddress)
+	require.NoError(t, db.Exec(`UPDATE eth_key_states SET budget_tripped_at = NOW() WHERE address = ?`, fromAddress).Error)
+
+	t.Run("keeps keys tripped while their budget is exceeded", func(t *testing.T) {
+		require.NoError(t, ec.CheckKeyBudgets(context.Background()))
+		require.NoError(t, ec.CheckKeyBudgets(context.Background()))
+		assert.NotNil(t, trippedAt(t))
+	})
+
+	t.Run("resets keys once their budget is no longer exceeded", func(t *testing.T) {
+		require.NoError(t, db.Exec(`UPDATE eth_txes SET created_at = NOW() - interval '2 hours' WHERE id = ?`, etx.ID).Error)
+
+		require.NoError(t, ec.CheckKeyBudgets(context.Background()))
+		assert.Nil(t, trippedAt(t))
+	})
+}
+
+func TestEthConfirmer_AbandonExpiredTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	state := cltest.MustGetStateForKey(t, ethKeyStore, key)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	config := cltest.NewTestGeneralConfig(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, config)
+
+	var resumedTaskRunID uuid.UUID
+	var resumedErr error
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, value interface{}, err error) error {
+		resumedTaskRunID = id
+		resumedErr = err
+		return nil
+	})
+
+	taskRunID := uuid.NewV4()
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET expires_at_block = 10, pipeline_task_run_id = ? WHERE id = ?`, taskRunID, etx.ID).Error)
+
+	t.Run("does not cancel eth_tx before its expiry", func(t *testing.T) {
+		require.NoError(t, ec.AbandonExpiredTransactions(context.Background(), 9))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 1)
+		assert.True(t, etx.ExpiresAtBlock.Valid)
+		assert.Equal(t, uuid.Nil, resumedTaskRunID)
+	})
+
+	t.Run("cancels eth_tx at its expiry and resumes its pipeline run with an error", func(t *testing.T) {
+		require.NoError(t, ec.AbandonExpiredTransactions(context.Background(), 10))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Empty(t, etx.EncodedPayload)
+		assert.False(t, etx.ExpiresAtBlock.Valid)
+		assert.False(t, etx.PipelineTaskRunID.Valid)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptInProgress, etx.EthTxAttempts[1].State)
+
+		assert.Equal(t, taskRunID, resumedTaskRunID)
+		assert.Error(t, resumedErr)
+	})
+
+	t.Run("does not cancel eth_tx twice", func(t *testing.T) {
+		require.NoError(t, ec.AbandonExpiredTransactions(context.Background(), 11))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 2)
+	})
 }
@@ -11,3 +11,27 @@ func SetEthClientOnEthConfirmer(ethClient eth.Client, ethConfirmer *EthConfirmer
 func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadcaster *EthBroadcaster) {
 	ethBroadcaster.resumeCallback = resumeCallback
 }
+
+const BatchCallGasOverhead = batchCallGasOverhead
+
+func EncodeBatch(etxs []EthTx) (payload []byte, gasLimit uint64, err error) {
+	return encodeBatch(etxs)
+}
+
+func BatchGasLimit(etxs []EthTx) uint64 {
+	return batchGasLimit(etxs)
+}
+
+// DecodeBatchRevertReasons decodes the results of a batch into the revert
+// reason of each call, which is empty if the call succeeded
+func DecodeBatchRevertReasons(data []byte) ([]string, error) {
+	results, err := decodeBatchResults(data)
+	if err != nil {
+		return nil, err
+	}
+	reasons := make([]string, len(results))
+	for i, result := range results {
+		reasons[i] = result.revertReason()
+	}
+	return reasons, nil
+}
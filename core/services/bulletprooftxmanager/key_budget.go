@@ -0,0 +1,153 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	// ErrKeyBudgetExceeded is returned when creating a transaction from a key
+	// which has exhausted its spending budget
+	ErrKeyBudgetExceeded = errors.New("spending budget exceeded for key")
+
+	promKeyBudgetExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_key_budget_exceeded",
+		Help: "Number of transactions rejected, and gas bumps held, because the sending key exceeded its spending budget",
+	}, []string{"evmChainID", "fromAddress"})
+	promKeyBudgetTripped = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_key_budget_tripped",
+		Help: "Set to 1 while the sending key has exceeded its spending budget, 0 otherwise",
+	}, []string{"evmChainID", "fromAddress"})
+)
+
+// KeyBudget limits what a sending key may spend over a rolling window
+type KeyBudget struct {
+	Window time.Duration
+	// MaxSpendWei is nil if spending is unlimited
+	MaxSpendWei *big.Int
+	// MaxTxs is zero if the number of transactions is unlimited
+	MaxTxs uint32
+}
+
+func keyBudgetFromConfig(config Config, address common.Address) KeyBudget {
+	return KeyBudget{
+		Window:      config.KeySpecificBudgetWindow(address),
+		MaxSpendWei: config.KeySpecificBudgetMaxSpendWei(address),
+		MaxTxs:      config.KeySpecificBudgetMaxTxs(address),
+	}
+}
+
+// Enabled returns true if the budget limits the key in any way
+func (b KeyBudget) Enabled() bool {
+	return b.MaxSpendWei != nil || b.MaxTxs > 0
+}
+
+// KeyBudgetUsage is the usage of a key's budget over the current window
+type KeyBudgetUsage struct {
+	KeyBudget
+	// SpentWei is the most the transactions created in the window can cost,
+	// i.e. their value plus the gas of their most expensive attempt
+	SpentWei *big.Int
+	// Txs is the number of transactions created in the window
+	Txs uint32
+	// TrippedAt is set while the key has exceeded its budget
+	TrippedAt *time.Time
+}
+
+// Exceeded returns true if the key has no budget left for another
+// transaction. Keys are both tripped and reset on this condition.
+func (u KeyBudgetUsage) Exceeded() bool {
+	if u.MaxTxs > 0 && u.Txs >= u.MaxTxs {
+		return true
+	}
+	return u.MaxSpendWei != nil && u.SpentWei.Cmp(u.MaxSpendWei) >= 0
+}
+
+// ExceededBy returns true if spending extraWei more would go over the spending
+// budget of the key
+func (u KeyBudgetUsage) ExceededBy(extraWei *big.Int) bool {
+	return u.MaxSpendWei != nil && new(big.Int).Add(u.SpentWei, extraWei).Cmp(u.MaxSpendWei) > 0
+}
+
+// GetKeyBudgetUsage returns the usage of the budget of the given key over the
+// window ending now. Transactions which were never sent, and batched
+// transactions, do not count towards the budget.
+func GetKeyBudgetUsage(q postgres.Queryer, address common.Address, budget KeyBudget, chainID big.Int) (usage KeyBudgetUsage, err error) {
+	usage.KeyBudget = budget
+	var row struct {
+		SpentWei        utils.Big
+		Txs             uint32
+		BudgetTrippedAt *time.Time
+	}
+	err = q.Get(&row, `
+SELECT
+	COALESCE(SUM(eth_txes.value + COALESCE(attempts.max_cost, 0)), 0) AS spent_wei,
+	COUNT(eth_txes.id) AS txs,
+	eth_key_states.budget_tripped_at
+FROM eth_key_states
+LEFT JOIN eth_txes ON eth_txes.from_address = eth_key_states.address AND eth_txes.evm_chain_id = eth_key_states.evm_chain_id
+	AND eth_txes.created_at > $3 AND eth_txes.state NOT IN ('fatal_error', 'batched')
+LEFT JOIN LATERAL (
+	SELECT MAX(eth_tx_attempts.chain_specific_gas_limit * COALESCE(eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap)) AS max_cost
+	FROM eth_tx_attempts WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
+) attempts ON TRUE
+WHERE eth_key_states.address = $1 AND eth_key_states.evm_chain_id = $2
+GROUP BY eth_key_states.budget_tripped_at
+`, address, chainID.String(), time.Now().Add(-budget.Window))
+	if err != nil {
+		return usage, errors.Wrap(err, "bulletprooftxmanager.GetKeyBudgetUsage query failed")
+	}
+	usage.SpentWei = row.SpentWei.ToInt()
+	usage.Txs = row.Txs
+	usage.TrippedAt = row.BudgetTrippedAt
+	return usage, nil
+}
+
+// tripKeyBudget marks the key as having exceeded its budget
+func tripKeyBudget(q postgres.Queryer, lggr logger.Logger, usage KeyBudgetUsage, address common.Address, chainID big.Int) error {
+	promKeyBudgetExceeded.WithLabelValues(chainID.String(), address.Hex()).Inc()
+	promKeyBudgetTripped.WithLabelValues(chainID.String(), address.Hex()).Set(1)
+	res, err := q.Exec(`UPDATE eth_key_states SET budget_tripped_at = NOW(), updated_at = NOW() WHERE address = $1 AND evm_chain_id = $2 AND budget_tripped_at IS NULL`, address, chainID.String())
+	if err != nil {
+		return errors.Wrap(err, "tripKeyBudget failed to update eth_key_states")
+	}
+	if rowsAffected, err := res.RowsAffected(); err == nil && rowsAffected > 0 {
+		lggr.Errorw("Sending key exceeded its spending budget, transactions from this key will be rejected until the budget window allows for more",
+			"fromAddress", address, "window", usage.Window, "maxSpendWei", usage.MaxSpendWei, "spentWei", usage.SpentWei, "maxTxs", usage.MaxTxs, "txs", usage.Txs)
+	}
+	return nil
+}
+
+// resetKeyBudget clears the tripped state of a key which is within its budget
+func resetKeyBudget(q postgres.Queryer, lggr logger.Logger, address common.Address, chainID big.Int) error {
+	promKeyBudgetTripped.WithLabelValues(chainID.String(), address.Hex()).Set(0)
+	res, err := q.Exec(`UPDATE eth_key_states SET budget_tripped_at = NULL, updated_at = NOW() WHERE address = $1 AND evm_chain_id = $2 AND budget_tripped_at IS NOT NULL`, address, chainID.String())
+	if err != nil {
+		return errors.Wrap(err, "resetKeyBudget failed to update eth_key_states")
+	}
+	if rowsAffected, err := res.RowsAffected(); err == nil && rowsAffected > 0 {
+		lggr.Infow("Sending key is within its spending budget again", "fromAddress", address)
+	}
+	return nil
+}
+
+// attemptCost is the most the attempt can cost in gas
+func attemptCost(attempt EthTxAttempt) *big.Int {
+	price := attempt.GasPrice
+	if attempt.TxType == 0x2 {
+		price = attempt.GasFeeCap
+	}
+	if price == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(price.ToInt(), new(big.Int).SetUint64(attempt.ChainSpecificGasLimit))
+}
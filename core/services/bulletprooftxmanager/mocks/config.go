@@ -89,6 +89,48 @@ func (_m *Config) ChainType() chains.ChainType {
 	return r0
 }
 
+// EthTxBatchContractAddress provides a mock function with given fields:
+func (_m *Config) EthTxBatchContractAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthTxBatchMaxSize provides a mock function with given fields:
+func (_m *Config) EthTxBatchMaxSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EthTxBatchWindow provides a mock function with given fields:
+func (_m *Config) EthTxBatchWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *Config) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -261,6 +303,20 @@ func (_m *Config) EvmGasLimitMultiplier() float32 {
 	return r0
 }
 
+// EvmGasLimitTransfer provides a mock function with given fields:
+func (_m *Config) EvmGasLimitTransfer() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EvmGasPriceDefault provides a mock function with given fields:
 func (_m *Config) EvmGasPriceDefault() *big.Int {
 	ret := _m.Called()
@@ -397,6 +453,54 @@ func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeBufferBlocks provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBaseFeeBufferBlocks() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uint16)
+		}
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uint16)
+		}
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uint16)
+		}
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -411,6 +515,50 @@ func (_m *Config) GasEstimatorMode() string {
 	return r0
 }
 
+// KeySpecificBudgetMaxSpendWei provides a mock function with given fields: addr
+func (_m *Config) KeySpecificBudgetMaxSpendWei(addr common.Address) *big.Int {
+	ret := _m.Called(addr)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(common.Address) *big.Int); ok {
+		r0 = rf(addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// KeySpecificBudgetMaxTxs provides a mock function with given fields: addr
+func (_m *Config) KeySpecificBudgetMaxTxs(addr common.Address) uint32 {
+	ret := _m.Called(addr)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(common.Address) uint32); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// KeySpecificBudgetWindow provides a mock function with given fields: addr
+func (_m *Config) KeySpecificBudgetWindow(addr common.Address) time.Duration {
+	ret := _m.Called(addr)
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(common.Address) time.Duration); ok {
+		r0 = rf(addr)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // KeySpecificMaxGasPriceWei provides a mock function with given fields: addr
 func (_m *Config) KeySpecificMaxGasPriceWei(addr common.Address) *big.Int {
 	ret := _m.Called(addr)
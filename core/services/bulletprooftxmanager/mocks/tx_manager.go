@@ -22,6 +22,27 @@ type TxManager struct {
 	mock.Mock
 }
 
+// CancelEthTransaction provides a mock function with given fields: id
+func (_m *TxManager) CancelEthTransaction(id int64) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(id)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(int64) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
@@ -80,6 +101,27 @@ func (_m *TxManager) GetGasEstimator() gas.Estimator {
 	return r0
 }
 
+// GetKeyBudgetUsage provides a mock function with given fields: address
+func (_m *TxManager) GetKeyBudgetUsage(address common.Address) (bulletprooftxmanager.KeyBudgetUsage, error) {
+	ret := _m.Called(address)
+
+	var r0 bulletprooftxmanager.KeyBudgetUsage
+	if rf, ok := ret.Get(0).(func(common.Address) bulletprooftxmanager.KeyBudgetUsage); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.KeyBudgetUsage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Healthy provides a mock function with given fields:
 func (_m *TxManager) Healthy() error {
 	ret := _m.Called()
@@ -118,6 +160,27 @@ func (_m *TxManager) RegisterResumeCallback(fn bulletprooftxmanager.ResumeCallba
 	_m.Called(fn)
 }
 
+// ReplaceEthTransaction provides a mock function with given fields: id, replacement
+func (_m *TxManager) ReplaceEthTransaction(id int64, replacement bulletprooftxmanager.EthTxReplacement) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(id, replacement)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(int64, bulletprooftxmanager.EthTxReplacement) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(id, replacement)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, bulletprooftxmanager.EthTxReplacement) error); ok {
+		r1 = rf(id, replacement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *TxManager) Start() error {
 	ret := _m.Called()
@@ -13,6 +13,20 @@ type TxStrategy struct {
 	mock.Mock
 }
 
+// Batch provides a mock function with given fields:
+func (_m *TxStrategy) Batch() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // PruneQueue provides a mock function with given fields: q
 func (_m *TxStrategy) PruneQueue(q postgres.Queryer) (int64, error) {
 	ret := _m.Called(q)
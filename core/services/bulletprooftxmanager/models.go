@@ -45,6 +45,7 @@ const (
 	EthTxUnconfirmed             = EthTxState("unconfirmed")
 	EthTxConfirmed               = EthTxState("confirmed")
 	EthTxConfirmedMissingReceipt = EthTxState("confirmed_missing_receipt")
+	EthTxBatched                 = EthTxState("batched")
 
 	EthTxAttemptInProgress      = EthTxAttemptState("in_progress")
 	EthTxAttemptInsufficientEth = EthTxAttemptState("insufficient_eth")
@@ -147,6 +148,35 @@ type EthTx struct {
 	// Simulate if set to true will cause this eth_tx to be simulated before
 	// initial send and aborted on revert
 	Simulate bool
+
+	// NotBefore and NotBeforeBlock hold an unstarted eth_tx until the given
+	// time and block height
+	NotBefore      *time.Time
+	NotBeforeBlock cnull.Int64
+	// ExpiresAt and ExpiresAtBlock abandon an eth_tx which is not confirmed
+	// by the given time or block height
+	ExpiresAt      *time.Time
+	ExpiresAtBlock cnull.Int64
+
+	// Batchable allows an unstarted eth_tx to be sent as part of a batch, see
+	// BatchStrategy
+	Batchable bool
+	// BatchEthTxID is the eth_tx which sends a batched eth_tx, as the call at
+	// BatchIndex of the batch
+	BatchEthTxID *int64
+	BatchIndex   *int
+
+	// RevertReason is the decoded reason a confirmed eth_tx reverted on-chain
+	RevertReason null.String
+}
+
+// IsExpired returns true if the eth_tx expired at the given time or block
+// height. A blockNum below zero is unknown and never expires it.
+func (e EthTx) IsExpired(now time.Time, blockNum int64) bool {
+	if e.ExpiresAt != nil && !now.Before(*e.ExpiresAt) {
+		return true
+	}
+	return e.ExpiresAtBlock.Valid && blockNum >= 0 && blockNum >= e.ExpiresAtBlock.Int64
 }
 
 func (e EthTx) GetError() error {
@@ -207,4 +237,5 @@ type EthReceipt struct {
 	TransactionIndex uint
 	Receipt          []byte
 	CreatedAt        time.Time
+	RevertReason     null.String
 }
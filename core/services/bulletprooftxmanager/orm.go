@@ -9,6 +9,7 @@ type ORM interface {
 	EthTransactionsWithAttempts(offset, limit int) ([]EthTx, int, error)
 	EthTxAttempts(offset, limit int) ([]EthTxAttempt, int, error)
 	FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error)
+	FindEthTxWithAttempts(id int64) (*EthTx, error)
 }
 
 type orm struct {
@@ -124,3 +125,16 @@ func (o *orm) FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error) {
 	err := o.preloadTxes(attempts)
 	return &attempts[0], err
 }
+
+// FindEthTxWithAttempts returns an individual EthTx with its attempts sorted
+// by created_at descending
+func (o *orm) FindEthTxWithAttempts(id int64) (*EthTx, error) {
+	etx := EthTx{}
+	sql := `SELECT * FROM eth_txes WHERE id = $1`
+	if err := o.db.Get(&etx, sql, id); err != nil {
+		return nil, err
+	}
+	txs := []EthTx{etx}
+	err := o.preloadTxAttempts(txs)
+	return &txs[0], err
+}
@@ -113,10 +113,39 @@ func (r *Reaper) ReapEthTxes(headNum int64) error {
 
 	r.log.Debugw(fmt.Sprintf("BPTXMReaper: reaping old eth_txes created before %s", timeThreshold.Format(time.RFC3339)), "ageThreshold", threshold, "timeThreshold", timeThreshold, "minBlockNumberToKeep", minBlockNumberToKeep)
 
-	// Delete old confirmed eth_txes
+	// Delete the confirmed eth_txes sent by old batches, which are reaped
+	// along with their batch below
+	err := postgres.Batch(func(_, limit uint) (count uint, err error) {
+		res := r.db.Exec(`
+WITH old_enough_batches AS (
+	SELECT eth_txes.id FROM eth_txes
+	INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+	INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+	WHERE eth_receipts.block_number < ?
+	AND eth_txes.created_at < ?
+	AND eth_txes.state = 'confirmed'
+	AND evm_chain_id = ?
+	AND EXISTS (SELECT 1 FROM eth_txes batched WHERE batched.batch_eth_tx_id = eth_txes.id)
+	AND NOT EXISTS (SELECT 1 FROM eth_txes batched WHERE batched.batch_eth_tx_id = eth_txes.id AND batched.state <> 'confirmed')
+	ORDER BY eth_receipts.block_number ASC, eth_txes.id ASC
+	LIMIT ?
+)
+DELETE FROM eth_txes
+USING old_enough_batches
+WHERE eth_txes.batch_eth_tx_id = old_enough_batches.id`, minBlockNumberToKeep, timeThreshold, r.chainID, limit)
+		if res.Error != nil {
+			return count, res.Error
+		}
+		return uint(res.RowsAffected), res.Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "BPTXMReaper#reapEthTxes batch delete of batched eth_txes failed")
+	}
+	// Delete old confirmed eth_txes, except for batches which still have
+	// eth_txes awaiting the results of their calls
 	// NOTE that this relies on foreign key triggers automatically removing
 	// the eth_tx_attempts and eth_receipts linked to every eth_tx
-	err := postgres.Batch(func(_, limit uint) (count uint, err error) {
+	err = postgres.Batch(func(_, limit uint) (count uint, err error) {
 		res := r.db.Exec(`
 WITH old_enough_receipts AS (
 	SELECT tx_hash FROM eth_receipts
@@ -130,7 +159,8 @@ WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
 AND eth_tx_attempts.hash = old_enough_receipts.tx_hash
 AND eth_txes.created_at < ?
 AND eth_txes.state = 'confirmed'
-AND evm_chain_id = ?`, minBlockNumberToKeep, limit, timeThreshold, r.chainID)
+AND evm_chain_id = ?
+AND NOT EXISTS (SELECT 1 FROM eth_txes batched WHERE batched.batch_eth_tx_id = eth_txes.id)`, minBlockNumberToKeep, limit, timeThreshold, r.chainID)
 		if res.Error != nil {
 			return count, res.Error
 		}
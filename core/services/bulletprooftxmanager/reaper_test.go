@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
@@ -125,4 +126,37 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 		// Deleted because it is old enough now
 		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 0)
 	})
+
+	t.Run("deletes batches along with their batched eth_txes once every one of them is confirmed", func(t *testing.T) {
+		config := new(mocks.ReaperConfig)
+		config.On("EvmFinalityDepth").Return(uint32(10))
+		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
+		config.On("EthTxReaperInterval").Return(1 * time.Hour)
+
+		r := newReaper(t, db, config)
+
+		batch := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, from, nonce, 5)
+		nonce++
+		etxs := make([]bulletprooftxmanager.EthTx, 2)
+		for i, state := range []bulletprooftxmanager.EthTxState{bulletprooftxmanager.EthTxConfirmed, bulletprooftxmanager.EthTxBatched} {
+			batchIndex := i
+			etxs[i] = cltest.NewEthTx(t, from)
+			etxs[i].State = state
+			etxs[i].BatchEthTxID = &batch.ID
+			etxs[i].BatchIndex = &batchIndex
+			require.NoError(t, db.Save(&etxs[i]).Error)
+		}
+		db.Exec(`UPDATE eth_txes SET created_at=?`, oneDayAgo)
+
+		err := r.ReapEthTxes(42)
+		assert.NoError(t, err)
+		// Didn't delete because the batch still has an eth_tx awaiting its result
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 3)
+
+		require.NoError(t, db.Exec(`UPDATE eth_txes SET state = 'confirmed' WHERE id = ?`, etxs[1].ID).Error)
+
+		err = r.ReapEthTxes(42)
+		assert.NoError(t, err)
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 0)
+	})
 }
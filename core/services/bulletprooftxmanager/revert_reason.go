@@ -0,0 +1,138 @@
+package bulletprooftxmanager
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/offchain_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/oracle_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// panicReasons are the compiler generated panic codes, see
+	// https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+	panicReasons = map[uint64]string{
+		0x00: "generic compiler panic",
+		0x01: "assertion failed",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "invalid enum value",
+		0x22: "invalid storage byte array",
+		0x31: "pop on empty array",
+		0x32: "array index out of bounds",
+		0x41: "out of memory",
+		0x51: "call to zero-initialized internal function",
+	}
+
+	// knownContractABIs are the ABIs of the contracts the node sends
+	// transactions to, used to decode their custom errors
+	knownContractABIs = []string{
+		flux_aggregator_wrapper.FluxAggregatorABI,
+		keeper_registry_wrapper.KeeperRegistryABI,
+		offchain_aggregator_wrapper.OffchainAggregatorABI,
+		operator_wrapper.OperatorABI,
+		oracle_wrapper.OracleABI,
+		solidity_vrf_coordinator_interface.VRFCoordinatorABI,
+		vrf_coordinator_v2.VRFCoordinatorV2ABI,
+	}
+
+	defaultRevertReasonDecoder = MustNewRevertReasonDecoder(knownContractABIs...)
+)
+
+// RevertReasonDecoder decodes the data returned by a reverted call into a
+// human readable revert reason
+type RevertReasonDecoder struct {
+	customErrors map[[4]byte]abi.Error
+}
+
+// NewRevertReasonDecoder returns a decoder which decodes Error(string),
+// Panic(uint256), and the custom errors defined in the given contract ABIs
+func NewRevertReasonDecoder(contractABIs ...string) (*RevertReasonDecoder, error) {
+	d := &RevertReasonDecoder{customErrors: make(map[[4]byte]abi.Error)}
+	for _, contractABI := range contractABIs {
+		parsed, err := abi.JSON(strings.NewReader(contractABI))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse contract ABI")
+		}
+		for _, customErr := range parsed.Errors {
+			var selector [4]byte
+			copy(selector[:], customErr.ID[:4])
+			d.customErrors[selector] = customErr
+		}
+	}
+	return d, nil
+}
+
+// MustNewRevertReasonDecoder is like NewRevertReasonDecoder but panics on
+// an invalid ABI
+func MustNewRevertReasonDecoder(contractABIs ...string) *RevertReasonDecoder {
+	d, err := NewRevertReasonDecoder(contractABIs...)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Decode returns the revert reason encoded in data, which is the return data
+// of the reverted call including the 4 byte selector
+func (d *RevertReasonDecoder) Decode(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "reverted without a reason", nil
+	}
+	if len(data) < 4 {
+		return "", errors.Errorf("revert data too short: %s", hexutil.Encode(data))
+	}
+	selector := data[:4]
+
+	switch {
+	case bytes.Equal(selector, errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		return reason, errors.Wrap(err, "failed to decode Error(string)")
+	case bytes.Equal(selector, panicSelector):
+		return decodePanic(data[4:])
+	}
+
+	var id [4]byte
+	copy(id[:], selector)
+	customErr, exists := d.customErrors[id]
+	if !exists {
+		return "", errors.Errorf("unknown revert data: %s", hexutil.Encode(data))
+	}
+	values, err := customErr.Inputs.Unpack(data[4:])
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode %s", customErr.Sig)
+	}
+	args := make([]string, len(values))
+	for i, value := range values {
+		args[i] = fmt.Sprintf("%s=%v", customErr.Inputs[i].Name, value)
+	}
+	return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(args, ", ")), nil
+}
+
+func decodePanic(data []byte) (string, error) {
+	if len(data) != 32 {
+		return "", errors.Errorf("failed to decode Panic(uint256): expected 32 bytes, got %d", len(data))
+	}
+	code := new(big.Int).SetBytes(data)
+	if code.IsUint64() {
+		if reason, exists := panicReasons[code.Uint64()]; exists {
+			return fmt.Sprintf("panic: %s (0x%x)", reason, code), nil
+		}
+	}
+	return fmt.Sprintf("panic: unknown code (0x%x)", code), nil
+}
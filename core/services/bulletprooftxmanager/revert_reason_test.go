@@ -0,0 +1,84 @@
+package bulletprooftxmanager_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+const insufficientBalanceABI = `[{"inputs":[{"internalType":"uint256","name":"available","type":"uint256"},{"internalType":"address","name":"account","type":"address"}],"name":"InsufficientBalance","type":"error"}]`
+
+func encodeRevert(t *testing.T, sig string, args abi.Arguments, values ...interface{}) []byte {
+	packed, err := args.Pack(values...)
+	require.NoError(t, err)
+	return append(crypto.Keccak256([]byte(sig))[:4], packed...)
+}
+
+func TestRevertReasonDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	addressType, err := abi.NewType("address", "", nil)
+	require.NoError(t, err)
+
+	d, err := bulletprooftxmanager.NewRevertReasonDecoder(insufficientBalanceABI)
+	require.NoError(t, err)
+
+	t.Run("decodes Error(string)", func(t *testing.T) {
+		data := encodeRevert(t, "Error(string)", abi.Arguments{{Type: stringType}}, "not enough LINK")
+		reason, err := d.Decode(data)
+		require.NoError(t, err)
+		assert.Equal(t, "not enough LINK", reason)
+	})
+
+	t.Run("decodes Panic(uint256)", func(t *testing.T) {
+		data := encodeRevert(t, "Panic(uint256)", abi.Arguments{{Type: uint256Type}}, big.NewInt(0x11))
+		reason, err := d.Decode(data)
+		require.NoError(t, err)
+		assert.Equal(t, "panic: arithmetic underflow or overflow (0x11)", reason)
+
+		data = encodeRevert(t, "Panic(uint256)", abi.Arguments{{Type: uint256Type}}, big.NewInt(0x99))
+		reason, err = d.Decode(data)
+		require.NoError(t, err)
+		assert.Equal(t, "panic: unknown code (0x99)", reason)
+	})
+
+	t.Run("decodes custom errors from the given ABIs", func(t *testing.T) {
+		account := common.HexToAddress("0x2")
+		data := encodeRevert(t, "InsufficientBalance(uint256,address)", abi.Arguments{{Type: uint256Type}, {Type: addressType}}, big.NewInt(42), account)
+		reason, err := d.Decode(data)
+		require.NoError(t, err)
+		assert.Equal(t, "InsufficientBalance(available=42, account="+account.Hex()+")", reason)
+	})
+
+	t.Run("returns a reason for an empty revert", func(t *testing.T) {
+		reason, err := d.Decode(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "reverted without a reason", reason)
+	})
+
+	t.Run("errors on unknown revert data", func(t *testing.T) {
+		_, err := d.Decode(hexutil.MustDecode("0x12345678"))
+		require.EqualError(t, err, "unknown revert data: 0x12345678")
+
+		_, err = d.Decode([]byte{0x01})
+		require.EqualError(t, err, "revert data too short: 0x01")
+	})
+
+	t.Run("errors on an invalid ABI", func(t *testing.T) {
+		_, err := bulletprooftxmanager.NewRevertReasonDecoder(strings.TrimSuffix(insufficientBalanceABI, "]"))
+		require.Error(t, err)
+	})
+}
@@ -19,6 +19,11 @@ type TxStrategy interface {
 	// they can call arbitrary user-specified code, because there could be a case where
 	// it would erroneously fail during simulation but would succeed for real
 	Simulate() bool
+	// Batch indicates whether this transaction can be sent together with
+	// others in a single call to the batch contract, if one is configured
+	// BE CAREFUL - the batch contract becomes msg.sender of the calls, so the
+	// contracts called must accept calls from it
+	Batch() bool
 }
 
 var _ TxStrategy = SendEveryStrategy{}
@@ -44,6 +49,7 @@ type SendEveryStrategy struct {
 func (SendEveryStrategy) Subject() uuid.NullUUID                     { return uuid.NullUUID{} }
 func (SendEveryStrategy) PruneQueue(postgres.Queryer) (int64, error) { return 0, nil }
 func (s SendEveryStrategy) Simulate() bool                           { return s.simulate }
+func (SendEveryStrategy) Batch() bool                                { return false }
 
 var _ TxStrategy = DropOldestStrategy{}
 
@@ -85,3 +91,26 @@ id < (
 func (s DropOldestStrategy) Simulate() bool {
 	return s.simulate
 }
+
+func (DropOldestStrategy) Batch() bool {
+	return false
+}
+
+var _ TxStrategy = BatchStrategy{}
+
+// BatchStrategy will send the tx, possibly batched together with other txes
+// from the same address which are queued at the same time. A batch is a
+// single call to the tryAggregate function of the batch contract. Each call in
+// the batch succeeds or fails on its own.
+type BatchStrategy struct {
+	simulate bool
+}
+
+func NewBatchStrategy(simulate bool) TxStrategy {
+	return BatchStrategy{simulate}
+}
+
+func (BatchStrategy) Subject() uuid.NullUUID                     { return uuid.NullUUID{} }
+func (BatchStrategy) PruneQueue(postgres.Queryer) (int64, error) { return 0, nil }
+func (s BatchStrategy) Simulate() bool                           { return s.simulate }
+func (BatchStrategy) Batch() bool                                { return true }
@@ -24,6 +24,22 @@ func Test_SendEveryStrategy(t *testing.T) {
 	assert.Equal(t, int64(0), n)
 }
 
+func Test_BatchStrategy(t *testing.T) {
+	t.Parallel()
+
+	s := bulletprooftxmanager.NewBatchStrategy(true)
+
+	assert.Equal(t, uuid.NullUUID{}, s.Subject())
+	assert.True(t, s.Simulate())
+	assert.True(t, s.Batch())
+
+	n, err := s.PruneQueue(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	assert.False(t, bulletprooftxmanager.NewSendEveryStrategy(true).Batch())
+}
+
 func Test_DropOldestStrategy_Subject(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +36,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -82,6 +83,11 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	CancelJobRunV2(ctx context.Context, runID int64) error
+	CancelSuspendedJobRunsV2(ctx context.Context, jobID int32) ([]int64, error)
+	RetryJobRunV2(ctx context.Context, runID int64, dotID string) (int64, error)
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, pipeline.TaskRunResults, error)
+	SubscribeToJobRunEventsV2(jobID *int32) pipeline.RunEventSubscription
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	SetServiceLogLevel(ctx context.Context, service string, level zapcore.Level) error
@@ -91,6 +97,14 @@ type Application interface {
 
 	// ReplayFromBlock of blocks
 	ReplayFromBlock(chainID *big.Int, number uint64) error
+	// ReplayJobFromBlock replays the logs of blocks to the listeners of a single job
+	ReplayJobFromBlock(chainID *big.Int, opts log.JobReplayOpts) (log.JobReplay, error)
+	// JobReplays returns the progress of the latest job replays on the chain, of jobID only if it is set
+	JobReplays(chainID *big.Int, jobID *int32) ([]log.JobReplay, error)
+
+	// Transactions
+	CancelEthTransaction(ctx context.Context, etxID int64) (bulletprooftxmanager.EthTx, error)
+	ReplaceEthTransaction(ctx context.Context, etxID int64, replacement bulletprooftxmanager.EthTxReplacement) (bulletprooftxmanager.EthTx, error)
 
 	// ID is unique to this particular application instance
 	ID() uuid.UUID
@@ -197,13 +211,16 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	subservices = append(subservices, promReporter)
 
 	var (
-		pipelineORM    = pipeline.NewORM(sqlxDB, globalLogger)
-		bridgeORM      = bridges.NewORM(sqlxDB)
-		sessionORM     = sessions.NewORM(sqlxDB, cfg.SessionTimeout().Duration(), globalLogger)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chainSet, keyStore.Eth(), keyStore.VRF(), globalLogger)
-		jobORM         = job.NewORM(sqlxDB, chainSet, pipelineORM, keyStore, globalLogger)
-		bptxmORM       = bulletprooftxmanager.NewORM(sqlxDB)
+		pipelineORM = pipeline.NewORM(sqlxDB, globalLogger)
+		bridgeORM   = bridges.NewORM(sqlxDB)
+		sessionORM  = sessions.NewORM(sqlxDB, cfg.SessionTimeout().Duration(), globalLogger)
+		jobORM      = job.NewORM(sqlxDB, chainSet, pipelineORM, keyStore, globalLogger)
+		bptxmORM    = bulletprooftxmanager.NewORM(sqlxDB)
 	)
+	pipelineRunner, err := pipeline.NewRunner(pipelineORM, cfg, chainSet, keyStore.Eth(), keyStore.VRF(), globalLogger)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create pipeline runner")
+	}
 
 	for _, chain := range chainSet.Chains() {
 		chain.HeadBroadcaster().Subscribe(promReporter)
@@ -628,6 +645,40 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+func (app *ChainlinkApplication) CancelJobRunV2(ctx context.Context, runID int64) error {
+	return app.pipelineRunner.CancelRun(runID)
+}
+
+func (app *ChainlinkApplication) CancelSuspendedJobRunsV2(ctx context.Context, jobID int32) ([]int64, error) {
+	if _, err := app.jobORM.FindJob(ctx, jobID); err != nil {
+		return nil, err
+	}
+	return app.pipelineRunner.CancelSuspendedRuns(jobID)
+}
+
+// RetryJobRunV2 retries an errored run from the task with the given dot ID, or
+// from its errored tasks if dotID is empty, and returns the ID of the new run.
+func (app *ChainlinkApplication) RetryJobRunV2(ctx context.Context, runID int64, dotID string) (int64, error) {
+	return app.pipelineRunner.RetryRun(ctx, runID, dotID, app.logger)
+}
+
+// SimulateJobV2 executes the pipeline of an unsaved job once without storing
+// the run. Tasks with side effects, such as ethtx, are stubbed out.
+func (app *ChainlinkApplication) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}) (pipeline.Run, pipeline.TaskRunResults, error) {
+	spec := pipeline.Spec{
+		DotDagSource:    jb.Pipeline.Source,
+		MaxTaskDuration: jb.MaxTaskDuration,
+		JobName:         jb.Name.ValueOrZero(),
+	}
+	return app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), app.logger.Named("Simulation"))
+}
+
+// SubscribeToJobRunEventsV2 returns a live stream of the lifecycle events of
+// the runs of the job, or of all jobs if jobID is nil.
+func (app *ChainlinkApplication) SubscribeToJobRunEventsV2(jobID *int32) pipeline.RunEventSubscription {
+	return app.pipelineRunner.SubscribeToRunEvents(jobID)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -647,6 +698,69 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	return nil
 }
 
+func (app *ChainlinkApplication) ReplayJobFromBlock(chainID *big.Int, opts log.JobReplayOpts) (log.JobReplay, error) {
+	chain, err := app.ChainSet.Get(chainID)
+	if err != nil {
+		return log.JobReplay{}, err
+	}
+	return chain.LogBroadcaster().ReplayJob(opts)
+}
+
+func (app *ChainlinkApplication) JobReplays(chainID *big.Int, jobID *int32) ([]log.JobReplay, error) {
+	chain, err := app.ChainSet.Get(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return chain.LogBroadcaster().JobReplays(jobID)
+}
+
+// CancelEthTransaction cancels an unstarted or unconfirmed transaction through
+// the tx manager of its chain, and returns it with its attempts
+func (app *ChainlinkApplication) CancelEthTransaction(ctx context.Context, etxID int64) (bulletprooftxmanager.EthTx, error) {
+	txm, err := app.txManagerForEthTx(etxID)
+	if err != nil {
+		return bulletprooftxmanager.EthTx{}, err
+	}
+	if _, err = txm.CancelEthTransaction(etxID); err != nil {
+		return bulletprooftxmanager.EthTx{}, err
+	}
+	return app.findEthTxWithAttempts(etxID)
+}
+
+// ReplaceEthTransaction replaces the payload of an unstarted or unconfirmed
+// transaction through the tx manager of its chain, and returns it with its
+// attempts
+func (app *ChainlinkApplication) ReplaceEthTransaction(ctx context.Context, etxID int64, replacement bulletprooftxmanager.EthTxReplacement) (bulletprooftxmanager.EthTx, error) {
+	txm, err := app.txManagerForEthTx(etxID)
+	if err != nil {
+		return bulletprooftxmanager.EthTx{}, err
+	}
+	if _, err = txm.ReplaceEthTransaction(etxID, replacement); err != nil {
+		return bulletprooftxmanager.EthTx{}, err
+	}
+	return app.findEthTxWithAttempts(etxID)
+}
+
+func (app *ChainlinkApplication) findEthTxWithAttempts(etxID int64) (bulletprooftxmanager.EthTx, error) {
+	etx, err := app.bptxmORM.FindEthTxWithAttempts(etxID)
+	if err != nil {
+		return bulletprooftxmanager.EthTx{}, err
+	}
+	return *etx, nil
+}
+
+func (app *ChainlinkApplication) txManagerForEthTx(etxID int64) (bulletprooftxmanager.TxManager, error) {
+	etx, err := app.findEthTxWithAttempts(etxID)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := app.ChainSet.Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return chain.TxManager(), nil
+}
+
 func (app *ChainlinkApplication) GetChainSet() evm.ChainSet {
 	return app.ChainSet
 }
@@ -101,6 +101,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 		mbOracleRequests:         utils.NewHighCapacityMailbox(),
 		mbOracleCancelRequests:   utils.NewHighCapacityMailbox(),
 		minIncomingConfirmations: uint64(concreteSpec.MinIncomingConfirmations.Uint32),
+		confirmationTag:          concreteSpec.ConfirmationTag,
 		requesters:               concreteSpec.Requesters,
 		minContractPayment:       concreteSpec.MinContractPayment,
 		chStop:                   make(chan struct{}),
@@ -130,6 +131,7 @@ type listener struct {
 	mbOracleRequests         *utils.Mailbox
 	mbOracleCancelRequests   *utils.Mailbox
 	minIncomingConfirmations uint64
+	confirmationTag          eth.BlockTag
 	requesters               models.AddressCollection
 	minContractPayment       *assets.Link
 	chStop                   chan struct{}
@@ -147,6 +149,7 @@ func (l *listener) Start() error {
 				operator_wrapper.OperatorCancelOracleRequest{}.Topic(): {{log.Topic(l.job.ExternalIDEncodeBytesToTopic()), log.Topic(l.job.ExternalIDEncodeStringToTopic())}},
 			},
 			NumConfirmations: l.minIncomingConfirmations,
+			ConfirmationTag:  l.confirmationTag,
 		})
 		l.shutdownWaitGroup.Add(3)
 		go l.processOracleRequests()
@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -13,6 +14,7 @@ import (
 
 type DirectRequestToml struct {
 	ContractAddress    ethkey.EIP55Address      `toml:"contractAddress"`
+	ConfirmationTag    eth.BlockTag             `toml:"confirmationTag"`
 	Requesters         models.AddressCollection `toml:"requesters"`
 	MinContractPayment *assets.Link             `toml:"minContractPaymentLinkJuels"`
 	EVMChainID         *utils.Big               `toml:"evmChainID"`
@@ -35,6 +37,7 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 	}
 	jb.DirectRequestSpec = &job.DirectRequestSpec{
 		ContractAddress:    spec.ContractAddress,
+		ConfirmationTag:    spec.ConfirmationTag,
 		Requesters:         spec.Requesters,
 		MinContractPayment: spec.MinContractPayment,
 		EVMChainID:         spec.EVMChainID,
@@ -43,5 +46,8 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if err := job.ValidateConfirmationTag(spec.ConfirmationTag); err != nil {
+		return jb, err
+	}
 	return jb, nil
 }
@@ -1,11 +1,14 @@
 package directrequest
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 )
 
 func TestValidatedDirectRequestSpec(t *testing.T) {
@@ -34,3 +37,25 @@ observationSource   = """
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.CreatedAt)
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.UpdatedAt)
 }
+
+func TestValidatedDirectRequestSpec_ConfirmationTag(t *testing.T) {
+	toml := `
+type                = "directrequest"
+schemaVersion       = 1
+name                = "example eth request event spec"
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+confirmationTag     = "%s"
+observationSource   = """
+    ds1          [type=http method=GET url="example.com" allowunrestrictednetworkaccess="true"];
+    ds1_parse    [type=jsonparse path="USD"];
+    ds1 -> ds1_parse;
+"""
+`
+
+	s, err := ValidatedDirectRequestSpec(fmt.Sprintf(toml, "finalized"))
+	require.NoError(t, err)
+	assert.Equal(t, eth.BlockTagFinalized, s.DirectRequestSpec.ConfirmationTag)
+
+	_, err = ValidatedDirectRequestSpec(fmt.Sprintf(toml, "latest"))
+	require.EqualError(t, err, `unsupported confirmationTag "latest", must be "finalized" or "safe"`)
+}
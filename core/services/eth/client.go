@@ -27,6 +27,8 @@ type Client interface {
 	Dial(ctx context.Context) error
 	Close()
 	ChainID() *big.Int
+	// NodeStates returns the state of each primary node by name
+	NodeStates() map[string]string
 
 	GetERC20Balance(address common.Address, contractAddress common.Address) (*big.Int, error)
 	GetLINKBalance(linkAddress common.Address, address common.Address) (*assets.Link, error)
@@ -42,6 +44,9 @@ type Client interface {
 	// running on Kovan.  We have to return our own wrapper type to capture the
 	// correct hash from the RPC response.
 	HeadByNumber(ctx context.Context, n *big.Int) (*Head, error)
+	// HeadByTag returns the latest head with the given tag, e.g. the latest
+	// finalized head
+	HeadByTag(ctx context.Context, tag BlockTag) (*Head, error)
 	SubscribeNewHead(ctx context.Context, ch chan<- *Head) (ethereum.Subscription, error)
 
 	// Wrapped Geth client methods
@@ -95,8 +100,8 @@ var _ Client = (*client)(nil)
 
 // NewClientWithNodes instantiates a client from a list of nodes
 // Currently only supports one primary
-func NewClientWithNodes(logger logger.Logger, primaryNodes []Node, sendOnlyNodes []SendOnlyNode, chainID *big.Int) (*client, error) {
-	pool := NewPool(logger, primaryNodes, sendOnlyNodes, chainID)
+func NewClientWithNodes(logger logger.Logger, config PoolConfig, primaryNodes []Node, sendOnlyNodes []SendOnlyNode, chainID *big.Int) (*client, error) {
+	pool := NewPool(logger, config, primaryNodes, sendOnlyNodes, chainID)
 	return &client{
 		logger:  logger,
 		pool:    pool,
@@ -120,6 +125,10 @@ func (client *client) Close() {
 	client.pool.Close()
 }
 
+func (client *client) NodeStates() map[string]string {
+	return client.pool.NodeStates()
+}
+
 // CallArgs represents the data used to call the balance method of a contract.
 // "To" is the address of the ERC contract. "Data" is the message sent
 // to the contract.
@@ -233,6 +242,19 @@ func (client *client) HeadByNumber(ctx context.Context, number *big.Int) (head *
 	return
 }
 
+func (client *client) HeadByTag(ctx context.Context, tag BlockTag) (head *Head, err error) {
+	err = client.pool.CallContext(ctx, &head, "eth_getBlockByNumber", string(tag), false)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		err = ethereum.NotFound
+		return
+	}
+	head.EVMChainID = utils.NewBig(client.chainID)
+	return
+}
+
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
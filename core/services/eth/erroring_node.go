@@ -102,3 +102,15 @@ func (e *erroringNode) EthSubscribe(ctx context.Context, channel interface{}, ar
 func (e *erroringNode) String() string {
 	return "<erroring node>"
 }
+
+func (e *erroringNode) Name() string {
+	return ""
+}
+
+func (e *erroringNode) RequestCounts() (requests, failures uint32) {
+	return 0, 0
+}
+
+func (e *erroringNode) SupportsSubscriptions() bool {
+	return false
+}
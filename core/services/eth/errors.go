@@ -264,26 +264,14 @@ func extractRPCError(baseErr error) (*JsonError, error) {
 // rinkeby / ropsten (geth)
 // { "error":  { "code": 3, "data": "0x0xABC123...", "message": "execution reverted: hello world" } } // revert reason included in message
 func ExtractRevertReasonFromRPCError(err error) (string, error) {
-	jErr, eErr := extractRPCError(err)
-	if eErr != nil {
-		return "", eErr
-	}
-	dataStr, ok := jErr.Data.(string)
-	if !ok {
-		return "", errors.New("invalid error type")
-	}
-	matches := hexDataRegex.FindStringSubmatch(dataStr)
-	if len(matches) != 1 {
-		return "", errors.New("unknown data payload format")
+	data, err := ExtractRevertDataFromRPCError(err)
+	if err != nil {
+		return "", err
 	}
-	hexData := utils.RemoveHexPrefix(matches[0])
-	if len(hexData) < 8 {
+	if len(data) < 4 {
 		return "", errors.New("unknown data payload format")
 	}
-	revertReasonBytes, err := hex.DecodeString(hexData[8:])
-	if err != nil {
-		return "", errors.Wrap(err, "unable to decode hex to bytes")
-	}
+	revertReasonBytes := data[4:]
 
 	ln := len(revertReasonBytes)
 	breaker := time.After(time.Second * 5)
@@ -306,3 +294,26 @@ cleanup:
 	revertReason := strings.TrimSpace(string(revertReasonBytes))
 	return revertReason, nil
 }
+
+// ExtractRevertDataFromRPCError returns the raw data, including the 4 byte
+// selector, from the "data" field of the response of an RPC eth_call that
+// reverted
+func ExtractRevertDataFromRPCError(err error) ([]byte, error) {
+	jErr, eErr := extractRPCError(err)
+	if eErr != nil {
+		return nil, eErr
+	}
+	dataStr, ok := jErr.Data.(string)
+	if !ok {
+		return nil, errors.New("invalid error type")
+	}
+	matches := hexDataRegex.FindStringSubmatch(dataStr)
+	if len(matches) != 1 {
+		return nil, errors.New("unknown data payload format")
+	}
+	data, err := hex.DecodeString(utils.RemoveHexPrefix(matches[0]))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode hex to bytes")
+	}
+	return data, nil
+}
@@ -319,3 +319,32 @@ func Test_ExtractRevertReasonFromRPCError(t *testing.T) {
 		require.Error(tt, err)
 	})
 }
+
+func Test_ExtractRevertDataFromRPCError(t *testing.T) {
+	t.Run("it extracts the revert data including the selector", func(t *testing.T) {
+		var jsonErr error = &eth.JsonError{
+			Code:    3,
+			Data:    "0x4e487b710000000000000000000000000000000000000000000000000000000000000011",
+			Message: "execution reverted",
+		}
+		data, err := eth.ExtractRevertDataFromRPCError(errors.Wrap(jsonErr, "wrapped message"))
+		require.NoError(t, err)
+		require.Equal(t, "0x4e487b710000000000000000000000000000000000000000000000000000000000000011", hexutil.Encode(data))
+	})
+
+	t.Run("it extracts parity style revert data", func(t *testing.T) {
+		var jsonErr error = &eth.JsonError{
+			Code:    -32015,
+			Data:    "Reverted 0x12345678",
+			Message: "VM execution error.",
+		}
+		data, err := eth.ExtractRevertDataFromRPCError(jsonErr)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x12, 0x34, 0x56, 0x78}, data)
+	})
+
+	t.Run("gracefully errors when given a normal error", func(t *testing.T) {
+		_, err := eth.ExtractRevertDataFromRPCError(errors.New("normal error"))
+		require.Error(t, err)
+	})
+}
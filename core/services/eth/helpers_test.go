@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -21,7 +22,7 @@ func NewClient(lggr logger.Logger, rpcUrl string, rpcHTTPURL *url.URL, sendonlyR
 
 	c := client{logger: lggr, chainID: chainID}
 
-	primaries := []Node{NewNode(lggr, *parsed, rpcHTTPURL, "eth-primary-0")}
+	primaries := []Node{NewNode(lggr, parsed, rpcHTTPURL, "eth-primary-0")}
 
 	var sendonlys []SendOnlyNode
 	for i, url := range sendonlyRPCURLs {
@@ -32,6 +33,16 @@ func NewClient(lggr logger.Logger, rpcUrl string, rpcHTTPURL *url.URL, sendonlyR
 		sendonlys = append(sendonlys, s)
 	}
 
-	c.pool = NewPool(lggr, primaries, sendonlys, chainID)
+	c.pool = NewPool(lggr, poolConfig{selectionMode: NodeSelectionModeRoundRobin}, primaries, sendonlys, chainID)
 	return &c, nil
 }
+
+type poolConfig struct {
+	selectionMode string
+	pollInterval  time.Duration
+	syncThreshold uint32
+}
+
+func (c poolConfig) NodeSelectionMode() string       { return c.selectionMode }
+func (c poolConfig) NodePollInterval() time.Duration { return c.pollInterval }
+func (c poolConfig) NodeSyncThreshold() uint32       { return c.syncThreshold }
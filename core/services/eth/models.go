@@ -30,6 +30,43 @@ type Head struct {
 	Timestamp     time.Time
 	CreatedAt     time.Time
 	BaseFeePerGas *utils.Big
+	// FinalizedNumber and SafeNumber are the numbers of the latest finalized
+	// and safe blocks when this head was received. They are only set if the
+	// chain has finality tags enabled.
+	FinalizedNumber null.Int64 `gorm:"-"`
+	SafeNumber      null.Int64 `gorm:"-"`
+	// GasLimit is the gas limit of the block. It is only set on heads
+	// received from the node, not on heads loaded from the database.
+	GasLimit null.Int64 `gorm:"-"`
+}
+
+// BlockTag refers to a block by its status instead of its number, see
+// https://ethereum.org/en/developers/docs/apis/json-rpc/#default-block
+type BlockTag string
+
+const (
+	// BlockTagFinalized is the latest block which can no longer be re-orged
+	BlockTagFinalized BlockTag = "finalized"
+	// BlockTagSafe is the latest block which is unlikely to be re-orged
+	BlockTagSafe BlockTag = "safe"
+)
+
+// IsValid returns true if the tag is one that the head tracker tracks
+func (t BlockTag) IsValid() bool {
+	return t == BlockTagFinalized || t == BlockTagSafe
+}
+
+// TagNumber returns the number of the latest block with the given tag when
+// this head was received, if known
+func (h *Head) TagNumber(tag BlockTag) null.Int64 {
+	switch tag {
+	case BlockTagFinalized:
+		return h.FinalizedNumber
+	case BlockTagSafe:
+		return h.SafeNumber
+	default:
+		return null.Int64{}
+	}
 }
 
 // NewHead returns a Head instance.
@@ -179,12 +216,13 @@ func (h *Head) NextInt() *big.Int {
 
 func (h *Head) UnmarshalJSON(bs []byte) error {
 	type head struct {
-		Hash          common.Hash    `json:"hash"`
-		Number        *hexutil.Big   `json:"number"`
-		ParentHash    common.Hash    `json:"parentHash"`
-		Timestamp     hexutil.Uint64 `json:"timestamp"`
-		L1BlockNumber *hexutil.Big   `json:"l1BlockNumber"`
-		BaseFeePerGas *hexutil.Big   `json:"baseFeePerGas"`
+		Hash          common.Hash     `json:"hash"`
+		Number        *hexutil.Big    `json:"number"`
+		ParentHash    common.Hash     `json:"parentHash"`
+		Timestamp     hexutil.Uint64  `json:"timestamp"`
+		L1BlockNumber *hexutil.Big    `json:"l1BlockNumber"`
+		BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+		GasLimit      *hexutil.Uint64 `json:"gasLimit"`
 	}
 
 	var jsonHead head
@@ -206,6 +244,9 @@ func (h *Head) UnmarshalJSON(bs []byte) error {
 	if jsonHead.L1BlockNumber != nil {
 		h.L1BlockNumber = null.Int64From((*big.Int)(jsonHead.L1BlockNumber).Int64())
 	}
+	if jsonHead.GasLimit != nil {
+		h.GasLimit = null.Int64From(int64(*jsonHead.GasLimit))
+	}
 	return nil
 }
 
@@ -278,6 +278,7 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 				Number:     0x100,
 				ParentHash: common.HexToHash("0x41941023680923e0fe4d74a34bdac8141f2540e3ae90623718e47d66d1ca4a2d"),
 				Timestamp:  time.Unix(0x58318da2, 0).UTC(),
+				GasLimit:   null.Int64From(0xffc001),
 			},
 		},
 		{"parity",
@@ -287,6 +288,7 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 				Number:     0x100,
 				ParentHash: common.HexToHash("0x41941023680923e0fe4d74a34bdac8141f2540e3ae90623718e47d66d1ca4a2d"),
 				Timestamp:  time.Unix(0x58318da2, 0).UTC(),
+				GasLimit:   null.Int64From(0xffc001),
 			},
 		},
 		{"arbitrum",
@@ -297,6 +299,7 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 				ParentHash:    common.HexToHash("0x923ad1e27c1d43cb2d2fb09e26d2502ca4b4914a2e0599161d279c6c06117d34"),
 				Timestamp:     time.Unix(0x60d0952d, 0).UTC(),
 				L1BlockNumber: null.Int64From(0x8652f9),
+				GasLimit:      null.Int64From(0x11278208),
 			},
 		},
 		{"not found",
@@ -316,6 +319,7 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 			assert.Equal(t, test.expected.ParentHash, head.ParentHash)
 			assert.Equal(t, test.expected.Timestamp.UTC().Unix(), head.Timestamp.UTC().Unix())
 			assert.Equal(t, test.expected.L1BlockNumber, head.L1BlockNumber)
+			assert.Equal(t, test.expected.GasLimit, head.GasLimit)
 		})
 	}
 }
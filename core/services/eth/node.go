@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"go.uber.org/atomic"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
@@ -40,6 +41,14 @@ type Node interface {
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 	EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error)
 
+	// Name is the name the node was configured with
+	Name() string
+	// SupportsSubscriptions is true if the node has a websocket connection
+	SupportsSubscriptions() bool
+	// RequestCounts returns the number of requests made to the node, and how
+	// many of them failed to get a response, since it was last called
+	RequestCounts() (requests, failures uint32)
+
 	String() string
 }
 
@@ -49,24 +58,40 @@ type rawclient struct {
 	uri  url.URL
 }
 
+// requestCounts counts the requests made to a node
+type requestCounts struct {
+	requests atomic.Uint32
+	failures atomic.Uint32
+}
+
+// ErrSubscriptionsNotSupported is returned when subscribing via a node, or a
+// pool of nodes, which only has HTTP connections
+var ErrSubscriptionsNotSupported = errors.New("subscriptions are not supported without a websocket url")
+
 // Node represents one ethereum node.
-// It must have a ws url and may have a http url
+// It must have a ws url, a http url or both
 type node struct {
-	ws     rawclient
+	ws     *rawclient
 	http   *rawclient
 	log    logger.Logger
 	name   string
 	dialed bool
+	counts *requestCounts
 }
 
-func NewNode(lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string) Node {
+// NewNode returns a primary node. At least one of wsuri and httpuri must be
+// given
+func NewNode(lggr logger.Logger, wsuri *url.URL, httpuri *url.URL, name string) Node {
 	n := new(node)
 	n.name = name
+	n.counts = new(requestCounts)
 	n.log = lggr.With(
 		"nodeName", name,
 		"nodeTier", "primary",
 	)
-	n.ws.uri = wsuri
+	if wsuri != nil {
+		n.ws = &rawclient{uri: *wsuri}
+	}
 	if httpuri != nil {
 		n.http = &rawclient{uri: *httpuri}
 	}
@@ -79,14 +104,17 @@ func (n *node) Dial(ctx context.Context) error {
 	}
 
 	{
-		var httpuri string
+		var wsuri, httpuri string
+		if n.ws != nil {
+			wsuri = n.ws.uri.String()
+		}
 		if n.http != nil {
 			httpuri = n.http.uri.String()
 		}
-		n.log.Debugw("eth.Client#Dial(...)", "wsuri", n.ws.uri.String(), "httpuri", httpuri)
+		n.log.Debugw("eth.Client#Dial(...)", "wsuri", wsuri, "httpuri", httpuri)
 	}
 
-	{
+	if n.ws != nil {
 		uri := n.ws.uri.String()
 		rpc, err := rpc.DialWebsocket(ctx, uri, "")
 		if err != nil {
@@ -103,6 +131,7 @@ func (n *node) Dial(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrapf(err, "Error while dialing HTTP: %v", uri)
 		}
+		n.dialed = true
 		n.http.rpc = rpc
 		n.http.geth = ethclient.NewClient(rpc)
 	}
@@ -137,11 +166,19 @@ func (n node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 
 func (n node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
 	n.log.Debugw("eth.Client#EthSubscribe", "mode", "websocket")
+	if n.ws == nil {
+		return nil, ErrSubscriptionsNotSupported
+	}
 	return n.ws.rpc.EthSubscribe(ctx, channel, args...)
 }
 
 func (n node) Close() {
-	n.ws.rpc.Close()
+	if n.ws != nil && n.ws.rpc != nil {
+		n.ws.rpc.Close()
+	}
+	if n.http != nil && n.http.rpc != nil {
+		n.http.rpc.Close()
+	}
 }
 
 // GethClient wrappers
@@ -267,9 +304,14 @@ func (n node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint6
 }
 
 func (n node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
-	n.log.Debugw("eth.Client#SuggestGasPrice()", "mode", "websocket")
-	price, err = n.ws.geth.SuggestGasPrice(ctx)
-	err = n.wrapWS(err)
+	n.log.Debugw("eth.Client#SuggestGasPrice()", "mode", switching(n))
+	if n.http != nil {
+		price, err = n.http.geth.SuggestGasPrice(ctx)
+		err = n.wrapHTTP(err)
+	} else {
+		price, err = n.ws.geth.SuggestGasPrice(ctx)
+		err = n.wrapWS(err)
+	}
 	return
 }
 
@@ -336,6 +378,9 @@ func (n node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types
 
 func (n node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
 	n.log.Debugw("eth.Client#SubscribeFilterLogs(...)", "q", q, "mode", "websocket")
+	if n.ws == nil {
+		return nil, ErrSubscriptionsNotSupported
+	}
 	sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
 	err = n.wrapWS(err)
 	return
@@ -356,13 +401,42 @@ func (n node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error)
 }
 
 func (n node) wrapWS(err error) error {
+	n.countRequest(err)
 	return wrap(err, fmt.Sprintf("primary websocket (%s)", n.ws.uri.String()))
 }
 
 func (n node) wrapHTTP(err error) error {
+	n.countRequest(err)
 	return wrap(err, fmt.Sprintf("primary http (%s)", n.http.uri.String()))
 }
 
+// countRequest records a request made to the node. Errors returned by the
+// node itself, such as reverts or nonce errors, mean that the node is
+// responding and are not counted as failures
+func (n node) countRequest(err error) {
+	n.counts.requests.Inc()
+	if err == nil || errors.Is(err, ethereum.NotFound) || errors.Is(err, context.Canceled) {
+		return
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return
+	}
+	n.counts.failures.Inc()
+}
+
+func (n node) RequestCounts() (requests, failures uint32) {
+	return n.counts.requests.Swap(0), n.counts.failures.Swap(0)
+}
+
+func (n node) Name() string {
+	return n.name
+}
+
+func (n node) SupportsSubscriptions() bool {
+	return n.ws != nil
+}
+
 func wrap(err error, tp string) error {
 	if err == nil {
 		return nil
@@ -381,7 +455,10 @@ func switching(n node) string {
 }
 
 func (n node) String() string {
-	s := fmt.Sprintf("(primary)%s:%s", n.name, n.ws.uri.String())
+	s := fmt.Sprintf("(primary)%s", n.name)
+	if n.ws != nil {
+		s = s + fmt.Sprintf(":%s", n.ws.uri.String())
+	}
 	if n.http != nil {
 		s = s + fmt.Sprintf(":%s", n.http.uri.String())
 	}
@@ -391,15 +468,17 @@ func (n node) String() string {
 // Verify checks that all connections to eth nodes match the given chain ID
 func (n node) Verify(ctx context.Context, expectedChainID *big.Int) (err error) {
 	var chainID *big.Int
-	if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
-		return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
-	} else if chainID.Cmp(expectedChainID) != 0 {
-		return errors.Errorf(
-			"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
-			chainID.String(),
-			expectedChainID.String(),
-			n.name,
-		)
+	if n.ws != nil {
+		if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
+			return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
+		} else if chainID.Cmp(expectedChainID) != 0 {
+			return errors.Errorf(
+				"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
+				chainID.String(),
+				expectedChainID.String(),
+				n.name,
+			)
+		}
 	}
 	if n.http != nil {
 		if chainID, err = n.http.geth.ChainID(ctx); err != nil {
@@ -39,6 +39,11 @@ func (nc *NullClient) Close() {
 	nc.lggr.Debug("Close")
 }
 
+func (nc *NullClient) NodeStates() map[string]string {
+	nc.lggr.Debug("NodeStates")
+	return nil
+}
+
 func (nc *NullClient) GetERC20Balance(address common.Address, contractAddress common.Address) (*big.Int, error) {
 	nc.lggr.Debug("GetERC20Balance")
 	return big.NewInt(0), nil
@@ -69,6 +74,11 @@ func (nc *NullClient) HeadByNumber(ctx context.Context, n *big.Int) (*Head, erro
 	return nil, nil
 }
 
+func (nc *NullClient) HeadByTag(ctx context.Context, tag BlockTag) (*Head, error) {
+	nc.lggr.Debug("HeadByTag")
+	return nil, nil
+}
+
 type nullSubscription struct {
 	lggr logger.Logger
 }
@@ -5,17 +5,84 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/atomic"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+var (
+	promEthNodeState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_state",
+		Help: "The state of each primary eth node, 0 for alive, 1 for out of sync and 2 for unreachable",
+	}, []string{"evmChainID", "nodeName"})
+	promEthNodeLatestBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_latest_block",
+		Help: "The latest block number reported by each primary eth node",
+	}, []string{"evmChainID", "nodeName"})
+	promEthNodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_node_latency",
+		Help: "How long each primary eth node took to return its latest head, in milliseconds",
+	}, []string{"evmChainID", "nodeName"})
+)
+
+// NodeState represents the health of a primary node as seen by the pool
+type NodeState int
+
+const (
+	// NodeStateAlive is a node which responds and is in sync with the pool
+	NodeStateAlive NodeState = iota
+	// NodeStateOutOfSync is a node which responds but lags too far behind the
+	// highest head seen by the pool
+	NodeStateOutOfSync
+	// NodeStateUnreachable is a node which fails to respond
+	NodeStateUnreachable
+)
+
+func (s NodeState) String() string {
+	switch s {
+	case NodeStateAlive:
+		return "Alive"
+	case NodeStateOutOfSync:
+		return "OutOfSync"
+	case NodeStateUnreachable:
+		return "Unreachable"
+	default:
+		return fmt.Sprintf("NodeState(%d)", s)
+	}
+}
+
+// The policies for choosing which of the alive nodes a request is sent to
+const (
+	NodeSelectionModeRoundRobin    = "RoundRobin"
+	NodeSelectionModeHighestHead   = "HighestHead"
+	NodeSelectionModeLowestLatency = "LowestLatency"
+	NodeSelectionModePriorityOrder = "PriorityOrder"
+)
+
+// PoolConfig is the configuration used by the pool
+type PoolConfig interface {
+	NodePollInterval() time.Duration
+	NodeSelectionMode() string
+	NodeSyncThreshold() uint32
+}
+
+// nodeHealth is the result of probing a node
+type nodeHealth struct {
+	state       NodeState
+	latestBlock int64
+	latency     time.Duration
+}
+
 // Pool represents an abstraction over one or more primary nodes
 // It is responsible for liveness checking and balancing queries across live nodes
 type Pool struct {
@@ -24,10 +91,31 @@ type Pool struct {
 	chainID         *big.Int
 	roundRobinCount atomic.Uint32
 	logger          logger.Logger
+
+	selectionMode string
+	pollInterval  time.Duration
+	syncThreshold uint32
+
+	healthMu sync.RWMutex
+	health   []nodeHealth
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
 }
 
-func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
-	return &Pool{nodes, sendonlys, chainID, atomic.Uint32{}, logger}
+func NewPool(logger logger.Logger, config PoolConfig, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
+	return &Pool{
+		nodes:         nodes,
+		sendonlys:     sendonlys,
+		chainID:       chainID,
+		logger:        logger,
+		selectionMode: config.NodeSelectionMode(),
+		pollInterval:  config.NodePollInterval(),
+		syncThreshold: config.NodeSyncThreshold(),
+		// Nodes are assumed to be alive until they are first probed
+		health: make([]nodeHealth, len(nodes)),
+		chStop: make(chan struct{}),
+	}
 }
 
 func (p *Pool) Dial(ctx context.Context) (err error) {
@@ -40,7 +128,14 @@ func (p *Pool) Dial(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	return p.verifyChainIDs(ctx)
+	if err = p.verifyChainIDs(ctx); err != nil {
+		return err
+	}
+	if p.pollInterval > 0 && len(p.nodes) > 0 {
+		p.wgDone.Add(1)
+		go p.probeLoop()
+	}
+	return nil
 }
 
 func (p *Pool) verifyChainIDs(ctx context.Context) (err error) {
@@ -57,6 +152,8 @@ func (p *Pool) verifyChainIDs(ctx context.Context) (err error) {
 }
 
 func (p *Pool) Close() {
+	close(p.chStop)
+	p.wgDone.Wait()
 	for _, n := range p.nodes {
 		n.Close()
 	}
@@ -66,25 +163,178 @@ func (p *Pool) ChainID() *big.Int {
 	return p.chainID
 }
 
-func (p *Pool) getRoundRobin() Node {
-	nNodes := len(p.nodes)
-	if nNodes == 0 {
+// NodeStates returns the state of each primary node by name
+func (p *Pool) NodeStates() map[string]string {
+	p.healthMu.RLock()
+	defer p.healthMu.RUnlock()
+	states := make(map[string]string, len(p.nodes))
+	for i, n := range p.nodes {
+		states[n.Name()] = p.health[i].state.String()
+	}
+	return states
+}
+
+func (p *Pool) probeLoop() {
+	defer p.wgDone.Done()
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.chStop:
+			return
+		case <-ticker.C:
+			p.probeNodes()
+		}
+	}
+}
+
+// probeNodes fetches the latest head from every primary node in parallel and
+// updates their states, taking nodes out of rotation or putting them back in
+func (p *Pool) probeNodes() {
+	ctx, cancel := utils.ContextFromChanWithDeadline(p.chStop, p.pollInterval)
+	defer cancel()
+
+	results := make([]nodeHealth, len(p.nodes))
+	var wg sync.WaitGroup
+	for i, n := range p.nodes {
+		wg.Add(1)
+		go func(i int, n Node) {
+			defer wg.Done()
+			results[i] = probeNode(ctx, n)
+		}(i, n)
+	}
+	wg.Wait()
+
+	p.updateHealth(results)
+}
+
+// probeNode returns the health of the node. A node is unreachable if it fails
+// to return its latest head, or if more than half of the requests made to it
+// since it was last probed failed
+func probeNode(ctx context.Context, n Node) (h nodeHealth) {
+	start := time.Now()
+	head, err := n.HeaderByNumber(ctx, nil)
+	h.latency = time.Since(start)
+	requests, failures := n.RequestCounts()
+	if err != nil || head == nil || head.Number == nil || failures*2 > requests {
+		h.state = NodeStateUnreachable
+		return h
+	}
+	h.state = NodeStateAlive
+	h.latestBlock = head.Number.Int64()
+	return h
+}
+
+func (p *Pool) updateHealth(results []nodeHealth) {
+	var highest int64
+	for _, h := range results {
+		if h.state == NodeStateAlive && h.latestBlock > highest {
+			highest = h.latestBlock
+		}
+	}
+	if p.syncThreshold > 0 {
+		for i, h := range results {
+			if h.state == NodeStateAlive && highest-h.latestBlock > int64(p.syncThreshold) {
+				results[i].state = NodeStateOutOfSync
+			}
+		}
+	}
+
+	p.healthMu.Lock()
+	previous := p.health
+	p.health = results
+	p.healthMu.Unlock()
+
+	chainID := p.chainID.String()
+	for i, n := range p.nodes {
+		h := results[i]
+		if h.state != previous[i].state {
+			if h.state == NodeStateAlive {
+				p.logger.Infow(fmt.Sprintf("Eth node %s recovered, putting it back in rotation", n.Name()), "nodeName", n.Name(), "latestBlock", h.latestBlock)
+			} else {
+				p.logger.Warnw(fmt.Sprintf("Eth node %s is %s, taking it out of rotation", n.Name(), h.state), "nodeName", n.Name(), "latestBlock", h.latestBlock, "highestBlock", highest)
+			}
+		}
+		promEthNodeState.WithLabelValues(chainID, n.Name()).Set(float64(h.state))
+		promEthNodeLatency.WithLabelValues(chainID, n.Name()).Set(float64(h.latency.Milliseconds()))
+		if h.state != NodeStateUnreachable {
+			promEthNodeLatestBlock.WithLabelValues(chainID, n.Name()).Set(float64(h.latestBlock))
+		}
+	}
+}
+
+// selectNode returns the node a request should be sent to, chosen from the
+// alive nodes according to the selection mode
+func (p *Pool) selectNode() Node {
+	n := p.selectNodeWhere(func(Node) bool { return true })
+	if n == nil {
 		return &erroringNode{errMsg: fmt.Sprintf("no nodes available for chain %s", p.chainID.String())}
 	}
+	return n
+}
 
-	// NOTE: Inc returns the number after addition, so we must -1 to get the "current" counter
-	count := p.roundRobinCount.Inc() - 1
-	idx := int(count % uint32(nNodes))
+// selectSubscriptionNode is like selectNode, but only chooses from the nodes
+// which support subscriptions. It returns nil if there are none.
+func (p *Pool) selectSubscriptionNode() Node {
+	return p.selectNodeWhere(Node.SupportsSubscriptions)
+}
+
+func (p *Pool) selectNodeWhere(eligible func(Node) bool) Node {
+	p.healthMu.RLock()
+	defer p.healthMu.RUnlock()
+
+	var candidates, alive []int
+	for i, n := range p.nodes {
+		if !eligible(n) {
+			continue
+		}
+		candidates = append(candidates, i)
+		if p.health[i].state == NodeStateAlive {
+			alive = append(alive, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	if len(alive) == 0 {
+		// Rather than failing every request, fall back to trying all nodes in
+		// turn until one of them recovers
+		alive = candidates
+	}
+
+	idx := alive[0]
+	switch p.selectionMode {
+	case NodeSelectionModeHighestHead:
+		for _, i := range alive {
+			if p.health[i].latestBlock > p.health[idx].latestBlock {
+				idx = i
+			}
+		}
+	case NodeSelectionModeLowestLatency:
+		for _, i := range alive {
+			if p.health[i].latency < p.health[idx].latency {
+				idx = i
+			}
+		}
+	case NodeSelectionModePriorityOrder:
+		// Nodes are in order of priority, so the first alive one is used
+	default:
+		// NOTE: Inc returns the number after addition, so we must -1 to get the "current" counter
+		count := p.roundRobinCount.Inc() - 1
+		idx = alive[int(count%uint32(len(alive)))]
+	}
 
 	return p.nodes[idx]
 }
 
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	return p.getRoundRobin().CallContext(ctx, result, method, args...)
+	return p.selectNode().CallContext(ctx, result, method, args...)
 }
 
 func (p *Pool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return p.getRoundRobin().BatchCallContext(ctx, b)
+	return p.selectNode().BatchCallContext(ctx, b)
 }
 
 // Wrapped Geth client methods
@@ -92,7 +342,7 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
-	main := p.getRoundRobin()
+	main := p.selectNode()
 	var all []SendOnlyNode
 	for _, n := range p.nodes {
 		all = append(all, n)
@@ -121,62 +371,70 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 }
 
 func (p *Pool) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-	return p.getRoundRobin().PendingCodeAt(ctx, account)
+	return p.selectNode().PendingCodeAt(ctx, account)
 }
 
 func (p *Pool) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-	return p.getRoundRobin().PendingNonceAt(ctx, account)
+	return p.selectNode().PendingNonceAt(ctx, account)
 }
 
 func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-	return p.getRoundRobin().NonceAt(ctx, account, blockNumber)
+	return p.selectNode().NonceAt(ctx, account, blockNumber)
 }
 
 func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
-	return p.getRoundRobin().TransactionReceipt(ctx, txHash)
+	return p.selectNode().TransactionReceipt(ctx, txHash)
 }
 
 func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	return p.getRoundRobin().BlockByNumber(ctx, number)
+	return p.selectNode().BlockByNumber(ctx, number)
 }
 
 func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	return p.getRoundRobin().BalanceAt(ctx, account, blockNumber)
+	return p.selectNode().BalanceAt(ctx, account, blockNumber)
 }
 
 func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	return p.getRoundRobin().FilterLogs(ctx, q)
+	return p.selectNode().FilterLogs(ctx, q)
 }
 
 func (p *Pool) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-	return p.getRoundRobin().SubscribeFilterLogs(ctx, q, ch)
+	n := p.selectSubscriptionNode()
+	if n == nil {
+		return nil, ErrSubscriptionsNotSupported
+	}
+	return n.SubscribeFilterLogs(ctx, q, ch)
 }
 
 func (p *Pool) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
-	return p.getRoundRobin().EstimateGas(ctx, call)
+	return p.selectNode().EstimateGas(ctx, call)
 }
 
 func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	return p.getRoundRobin().SuggestGasPrice(ctx)
+	return p.selectNode().SuggestGasPrice(ctx)
 }
 
 func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return p.getRoundRobin().CallContract(ctx, msg, blockNumber)
+	return p.selectNode().CallContract(ctx, msg, blockNumber)
 }
 
 func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return p.getRoundRobin().CodeAt(ctx, account, blockNumber)
+	return p.selectNode().CodeAt(ctx, account, blockNumber)
 }
 
 // bind.ContractBackend methods
 func (p *Pool) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
-	return p.getRoundRobin().HeaderByNumber(ctx, n)
+	return p.selectNode().HeaderByNumber(ctx, n)
 }
 
 func (p *Pool) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	return p.getRoundRobin().SuggestGasTipCap(ctx)
+	return p.selectNode().SuggestGasTipCap(ctx)
 }
 
 func (p *Pool) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
-	return p.getRoundRobin().EthSubscribe(ctx, channel, args...)
+	n := p.selectSubscriptionNode()
+	if n == nil {
+		return nil, ErrSubscriptionsNotSupported
+	}
+	return n.EthSubscribe(ctx, channel, args...)
 }
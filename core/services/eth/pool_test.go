@@ -0,0 +1,173 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type fakeNode struct {
+	*erroringNode
+	name        string
+	latestBlock int64
+	headErr     error
+	requests    uint32
+	failures    uint32
+	httpOnly    bool
+}
+
+func newFakeNode(name string, latestBlock int64) *fakeNode {
+	return &fakeNode{erroringNode: &erroringNode{errMsg: "not implemented"}, name: name, latestBlock: latestBlock}
+}
+
+func (n *fakeNode) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	if n.headErr != nil {
+		return nil, n.headErr
+	}
+	return &types.Header{Number: big.NewInt(n.latestBlock)}, nil
+}
+
+func (n *fakeNode) Name() string { return n.name }
+
+func (n *fakeNode) SupportsSubscriptions() bool { return !n.httpOnly }
+
+func (n *fakeNode) RequestCounts() (requests, failures uint32) {
+	requests, failures = n.requests+1, n.failures
+	n.requests, n.failures = 0, 0
+	return requests, failures
+}
+
+func newTestPool(t *testing.T, mode string, syncThreshold uint32, nodes ...Node) *Pool {
+	cfg := poolConfig{selectionMode: mode, syncThreshold: syncThreshold}
+	return NewPool(logger.TestLogger(t), cfg, nodes, nil, big.NewInt(1))
+}
+
+func TestPool_ProbeNodes(t *testing.T) {
+	t.Parallel()
+
+	a := newFakeNode("a", 100)
+	b := newFakeNode("b", 90)
+	c := newFakeNode("c", 100)
+	p := newTestPool(t, NodeSelectionModeRoundRobin, 5, a, b, c)
+
+	assert.Equal(t, map[string]string{"a": "Alive", "b": "Alive", "c": "Alive"}, p.NodeStates())
+
+	c.headErr = errors.New("connection refused")
+	p.probeNodes()
+	assert.Equal(t, map[string]string{"a": "Alive", "b": "OutOfSync", "c": "Unreachable"}, p.NodeStates())
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, a, p.selectNode())
+	}
+
+	t.Run("puts nodes back in rotation once they recover", func(t *testing.T) {
+		b.latestBlock = 98
+		c.headErr = nil
+		p.probeNodes()
+		assert.Equal(t, map[string]string{"a": "Alive", "b": "Alive", "c": "Alive"}, p.NodeStates())
+	})
+
+	t.Run("takes nodes with a high failure rate out of rotation", func(t *testing.T) {
+		a.requests, a.failures = 5, 4
+		p.probeNodes()
+		assert.Equal(t, map[string]string{"a": "Unreachable", "b": "Alive", "c": "Alive"}, p.NodeStates())
+	})
+
+	t.Run("falls back to every node when none are alive", func(t *testing.T) {
+		for _, n := range []*fakeNode{a, b, c} {
+			n.headErr = errors.New("connection refused")
+		}
+		p.probeNodes()
+		assert.Equal(t, map[string]string{"a": "Unreachable", "b": "Unreachable", "c": "Unreachable"}, p.NodeStates())
+
+		seen := map[Node]bool{}
+		for i := 0; i < 3; i++ {
+			seen[p.selectNode()] = true
+		}
+		assert.Len(t, seen, 3)
+	})
+}
+
+func TestPool_SelectNode(t *testing.T) {
+	t.Parallel()
+
+	nodes := func() (a, b, c *fakeNode) {
+		return newFakeNode("a", 100), newFakeNode("b", 102), newFakeNode("c", 101)
+	}
+
+	t.Run("RoundRobin", func(t *testing.T) {
+		a, b, c := nodes()
+		p := newTestPool(t, NodeSelectionModeRoundRobin, 0, a, b, c)
+		p.probeNodes()
+		assert.Equal(t, a, p.selectNode())
+		assert.Equal(t, b, p.selectNode())
+		assert.Equal(t, c, p.selectNode())
+		assert.Equal(t, a, p.selectNode())
+	})
+
+	t.Run("HighestHead", func(t *testing.T) {
+		a, b, c := nodes()
+		p := newTestPool(t, NodeSelectionModeHighestHead, 0, a, b, c)
+		p.probeNodes()
+		assert.Equal(t, b, p.selectNode())
+
+		b.headErr = errors.New("connection refused")
+		p.probeNodes()
+		assert.Equal(t, c, p.selectNode())
+	})
+
+	t.Run("LowestLatency", func(t *testing.T) {
+		a, b, c := nodes()
+		p := newTestPool(t, NodeSelectionModeLowestLatency, 0, a, b, c)
+		p.updateHealth([]nodeHealth{
+			{state: NodeStateAlive, latestBlock: 100, latency: 30 * time.Millisecond},
+			{state: NodeStateUnreachable, latency: time.Millisecond},
+			{state: NodeStateAlive, latestBlock: 101, latency: 20 * time.Millisecond},
+		})
+		assert.Equal(t, c, p.selectNode())
+	})
+
+	t.Run("PriorityOrder", func(t *testing.T) {
+		a, b, c := nodes()
+		p := newTestPool(t, NodeSelectionModePriorityOrder, 0, a, b, c)
+		p.probeNodes()
+		assert.Equal(t, a, p.selectNode())
+		assert.Equal(t, a, p.selectNode())
+
+		a.headErr = errors.New("connection refused")
+		p.probeNodes()
+		assert.Equal(t, b, p.selectNode())
+	})
+
+	t.Run("no nodes", func(t *testing.T) {
+		p := newTestPool(t, NodeSelectionModeRoundRobin, 0)
+		_, err := p.selectNode().HeaderByNumber(context.Background(), nil)
+		require.EqualError(t, err, "no nodes available for chain 1")
+	})
+}
+
+func TestPool_SelectSubscriptionNode(t *testing.T) {
+	t.Parallel()
+
+	a := newFakeNode("a", 100)
+	a.httpOnly = true
+	b := newFakeNode("b", 100)
+	p := newTestPool(t, NodeSelectionModePriorityOrder, 0, a, b)
+	p.probeNodes()
+
+	assert.Equal(t, a, p.selectNode())
+	assert.Equal(t, b, p.selectSubscriptionNode())
+
+	t.Run("returns an error when only HTTP nodes are available", func(t *testing.T) {
+		p := newTestPool(t, NodeSelectionModeRoundRobin, 0, a)
+		_, err := p.EthSubscribe(context.Background(), make(chan *Head), "newHeads")
+		require.Equal(t, ErrSubscriptionsNotSupported, err)
+	})
+}
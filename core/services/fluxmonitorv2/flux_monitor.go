@@ -349,6 +349,11 @@ func (fm *FluxMonitor) consume() {
 		)
 	}
 
+	var confirmationTag eth.BlockTag
+	if fm.jobSpec.FluxMonitorSpec != nil {
+		confirmationTag = fm.jobSpec.FluxMonitorSpec.ConfirmationTag
+	}
+
 	// Subscribe to contract logs
 	unsubscribe := fm.logBroadcaster.Register(fm, log.ListenerOpts{
 		Contract: fm.fluxAggregator.Address(),
@@ -358,6 +363,7 @@ func (fm *FluxMonitor) consume() {
 			flux_aggregator_wrapper.FluxAggregatorAnswerUpdated{}.Topic(): nil,
 		},
 		NumConfirmations: 0,
+		ConfirmationTag:  confirmationTag,
 	})
 	defer unsubscribe()
 
@@ -370,6 +376,7 @@ func (fm *FluxMonitor) consume() {
 				flags_wrapper.FlagsFlagRaised{}.Topic():  nil,
 			},
 			NumConfirmations: 0,
+			ConfirmationTag:  confirmationTag,
 		})
 		defer unsubscribe()
 	}
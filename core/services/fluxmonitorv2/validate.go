@@ -48,6 +48,7 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 			DrumbeatRandomDelay: specIntThreshold.DrumbeatRandomDelay,
 			DrumbeatEnabled:     specIntThreshold.DrumbeatEnabled,
 			MinPayment:          specIntThreshold.MinPayment,
+			ConfirmationTag:     specIntThreshold.ConfirmationTag,
 			EVMChainID:          specIntThreshold.EVMChainID,
 		}
 	}
@@ -56,6 +57,9 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 	if jb.Type != job.FluxMonitor {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if err = job.ValidateConfirmationTag(spec.ConfirmationTag); err != nil {
+		return jb, err
+	}
 
 	// Find the smallest of all the timeouts
 	// and ensure the polling period is greater than that.
@@ -0,0 +1,239 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ Estimator = &arbitrumEstimator{}
+
+// ArbGasInfoAddress is the address of the ArbGasInfo precompile
+var ArbGasInfoAddress = common.HexToAddress("0x000000000000000000000000000000000000006C")
+
+// ArbGasInfoABI is the ABI of the getPricesInWei function of the ArbGasInfo
+// precompile, which returns the current prices in wei
+const ArbGasInfoABI = `[{"inputs":[],"name":"getPricesInWei","outputs":[{"internalType":"uint256","name":"perL2Tx","type":"uint256"},{"internalType":"uint256","name":"perL1CalldataByte","type":"uint256"},{"internalType":"uint256","name":"perStorageAllocation","type":"uint256"},{"internalType":"uint256","name":"perArbGasBase","type":"uint256"},{"internalType":"uint256","name":"perArbGasCongestion","type":"uint256"},{"internalType":"uint256","name":"perArbGasTotal","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+var arbGasInfoABI = eth.MustGetABI(ArbGasInfoABI)
+
+//go:generate mockery --name arbitrumRPCClient --output ./mocks/ --case=underscore --structname ArbitrumRPCClient
+type arbitrumRPCClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// ArbitrumPrices are the prices returned by ArbGasInfo.getPricesInWei
+type ArbitrumPrices struct {
+	// PerL2Tx is the fixed L1 cost of each transaction
+	PerL2Tx *big.Int
+	// PerL1CalldataByte is the L1 cost of each byte of calldata
+	PerL1CalldataByte *big.Int
+	// PerArbGasTotal is the L2 gas price
+	PerArbGasTotal *big.Int
+}
+
+type arbitrumEstimator struct {
+	utils.StartStopOnce
+
+	config     Config
+	client     arbitrumRPCClient
+	pollPeriod time.Duration
+	logger     logger.Logger
+
+	pricesMu sync.RWMutex
+	prices   *ArbitrumPrices
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         chan struct{}
+	chDone         chan struct{}
+}
+
+// NewArbitrumEstimator returns a new arbitrum estimator, which prices
+// transactions with the ArbGasInfo precompile
+func NewArbitrumEstimator(lggr logger.Logger, config Config, client arbitrumRPCClient) Estimator {
+	return &arbitrumEstimator{
+		config:         config,
+		client:         client,
+		pollPeriod:     10 * time.Second,
+		logger:         lggr.Named("arbitrum_estimator"),
+		chForceRefetch: make(chan (chan struct{})),
+		chInitialised:  make(chan struct{}),
+		chStop:         make(chan struct{}),
+		chDone:         make(chan struct{}),
+	}
+}
+
+func (a *arbitrumEstimator) Start() error {
+	return a.StartOnce("ArbitrumEstimator", func() error {
+		go a.run()
+		<-a.chInitialised
+		return nil
+	})
+}
+
+func (a *arbitrumEstimator) Close() error {
+	return a.StopOnce("ArbitrumEstimator", func() error {
+		close(a.chStop)
+		<-a.chDone
+		return nil
+	})
+}
+
+func (a *arbitrumEstimator) run() {
+	defer close(a.chDone)
+
+	t := a.refreshPrices()
+	close(a.chInitialised)
+
+	for {
+		select {
+		case <-a.chStop:
+			return
+		case ch := <-a.chForceRefetch:
+			t.Stop()
+			t = a.refreshPrices()
+			close(ch)
+		case <-t.C:
+			t = a.refreshPrices()
+		}
+	}
+}
+
+func (a *arbitrumEstimator) refreshPrices() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(a.pollPeriod))
+
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+
+	data, err := arbGasInfoABI.Pack("getPricesInWei")
+	if err != nil {
+		a.logger.Errorw("ArbitrumEstimator: Failed to encode getPricesInWei", "err", err)
+		return
+	}
+	res, err := a.client.CallContract(ctx, ethereum.CallMsg{To: &ArbGasInfoAddress, Data: data}, nil)
+	if err != nil {
+		a.logger.Warnf("ArbitrumEstimator: Failed to refresh prices, got error: %s", err)
+		return
+	}
+	prices, err := ParseArbitrumPrices(res)
+	if err != nil {
+		a.logger.Warnf("ArbitrumEstimator: Failed to refresh prices, got error: %s", err)
+		return
+	}
+
+	a.logger.Debugw("ArbitrumEstimator#refreshPrices", "perL2Tx", prices.PerL2Tx, "perL1CalldataByte", prices.PerL1CalldataByte, "perArbGasTotal", prices.PerArbGasTotal)
+
+	a.pricesMu.Lock()
+	defer a.pricesMu.Unlock()
+	a.prices = prices
+	return
+}
+
+// ParseArbitrumPrices decodes the return data of ArbGasInfo.getPricesInWei
+func ParseArbitrumPrices(data []byte) (*ArbitrumPrices, error) {
+	values, err := arbGasInfoABI.Unpack("getPricesInWei", data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode getPricesInWei")
+	}
+	if len(values) != 6 {
+		return nil, errors.Errorf("expected 6 values from getPricesInWei, got %d", len(values))
+	}
+	prices := &ArbitrumPrices{}
+	var ok [3]bool
+	prices.PerL2Tx, ok[0] = values[0].(*big.Int)
+	prices.PerL1CalldataByte, ok[1] = values[1].(*big.Int)
+	prices.PerArbGasTotal, ok[2] = values[5].(*big.Int)
+	if !ok[0] || !ok[1] || !ok[2] {
+		return nil, errors.New("unexpected types returned from getPricesInWei")
+	}
+	return prices, nil
+}
+
+func (a *arbitrumEstimator) getPrices() *ArbitrumPrices {
+	a.pricesMu.RLock()
+	defer a.pricesMu.RUnlock()
+	return a.prices
+}
+
+func (a *arbitrumEstimator) OnNewLongestChain(_ context.Context, _ eth.Head) {}
+
+// GetLegacyGas returns the L2 gas price, and a gas limit which covers the L1
+// cost of the calldata on top of the L2 gas limit. Arbitrum charges for L1
+// calldata in L2 gas, so without it the transaction would run out of gas.
+func (a *arbitrumEstimator) GetLegacyGas(calldata []byte, l2GasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	ok := a.IfStarted(func() {
+		var forceRefetch bool
+		for _, opt := range opts {
+			if opt == OptForceRefetch {
+				forceRefetch = true
+			}
+		}
+		if forceRefetch {
+			ch := make(chan struct{})
+			a.chForceRefetch <- ch
+			select {
+			case <-ch:
+			case <-a.chStop:
+				err = errors.New("estimator stopped")
+				return
+			}
+		}
+		gasPrice, chainSpecificGasLimit, err = a.calcGas(calldata, l2GasLimit)
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	}
+	return
+}
+
+func (a *arbitrumEstimator) calcGas(calldata []byte, l2GasLimit uint64) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	prices := a.getPrices()
+	if prices == nil {
+		return nil, 0, errors.New("failed to estimate arbitrum gas; prices not set")
+	}
+	gasPrice = prices.PerArbGasTotal
+	if gasPrice.Sign() <= 0 {
+		return nil, 0, errors.Errorf("failed to estimate arbitrum gas; invalid gas price %s", gasPrice.String())
+	}
+	if max := a.config.EvmMaxGasPriceWei(); gasPrice.Cmp(max) > 0 {
+		a.logger.Warnw("ArbitrumEstimator: L2 gas price exceeds ETH_MAX_GAS_PRICE_WEI, using the maximum instead", "gasPriceWei", gasPrice, "maxGasPriceWei", max)
+		gasPrice = max
+	}
+
+	// The L1 cost in wei, converted to L2 gas at the L2 gas price (rounding up)
+	l1Cost := new(big.Int).Mul(prices.PerL1CalldataByte, big.NewInt(int64(len(calldata))))
+	l1Cost.Add(l1Cost, prices.PerL2Tx)
+	l1Gas := new(big.Int).Add(l1Cost, new(big.Int).Sub(gasPrice, big.NewInt(1)))
+	l1Gas.Div(l1Gas, gasPrice)
+	if !l1Gas.IsUint64() {
+		return nil, 0, errors.New("gas limit overflows uint64")
+	}
+
+	chainSpecificGasLimit = applyMultiplier(l2GasLimit, a.config.EvmGasLimitMultiplier()) + l1Gas.Uint64()
+	a.logger.Debugw("ArbitrumEstimator#EstimateGas", "gasPrice", gasPrice, "l2GasLimit", l2GasLimit, "l1Gas", l1Gas, "chainSpecificGasLimit", chainSpecificGasLimit)
+	return gasPrice, chainSpecificGasLimit, nil
+}
+
+func (a *arbitrumEstimator) BumpLegacyGas(_ *big.Int, _ uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	return nil, 0, errors.New("bump gas is not supported for arbitrum")
+}
+
+func (*arbitrumEstimator) GetDynamicFee(_ uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not implemented for Arbitrum")
+	return
+}
+
+func (*arbitrumEstimator) BumpDynamicFee(_ DynamicFee, _ uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not implemented for Arbitrum")
+	return
+}
@@ -0,0 +1,104 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+)
+
+func encodeArbitrumPrices(t *testing.T, perL2Tx, perL1CalldataByte, perArbGasTotal *big.Int) []byte {
+	abi := eth.MustGetABI(gas.ArbGasInfoABI)
+	b, err := abi.Methods["getPricesInWei"].Outputs.Pack(perL2Tx, perL1CalldataByte, big.NewInt(0), perArbGasTotal, big.NewInt(0), perArbGasTotal)
+	require.NoError(t, err)
+	return b
+}
+
+func Test_ArbitrumEstimator(t *testing.T) {
+	t.Parallel()
+
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	var gasLimit uint64 = 80000
+	isGetPrices := mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.To != nil && *msg.To == gas.ArbGasInfoAddress
+	})
+
+	newConfig := func(t *testing.T) *mocks.Config {
+		config := new(mocks.Config)
+		config.Test(t)
+		config.On("EvmMaxGasPriceWei").Return(assets.GWei(1000)).Maybe()
+		config.On("EvmGasLimitMultiplier").Return(float32(1)).Maybe()
+		return config
+	}
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		o := gas.NewArbitrumEstimator(logger.TestLogger(t), newConfig(t), new(mocks.ArbitrumRPCClient))
+		_, _, err := o.GetLegacyGas(calldata, gasLimit)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("calling GetLegacyGas on started estimator adds the L1 cost to the gas limit", func(t *testing.T) {
+		client := new(mocks.ArbitrumRPCClient)
+		// 100,000 gas per transaction and 10 gas per byte of calldata at 1 gwei
+		prices := encodeArbitrumPrices(t, big.NewInt(1e14), big.NewInt(1e10), assets.GWei(1))
+		client.On("CallContract", mock.Anything, isGetPrices, (*big.Int)(nil)).Return(prices, nil)
+
+		o := gas.NewArbitrumEstimator(logger.TestLogger(t), newConfig(t), client)
+		require.NoError(t, o.Start())
+		t.Cleanup(func() { require.NoError(t, o.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(calldata, gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(1), gasPrice)
+		assert.Equal(t, 80000+100000+50, int(chainSpecificGasLimit))
+	})
+
+	t.Run("caps the gas price at the max gas price", func(t *testing.T) {
+		client := new(mocks.ArbitrumRPCClient)
+		prices := encodeArbitrumPrices(t, big.NewInt(0), big.NewInt(0), assets.GWei(2000))
+		client.On("CallContract", mock.Anything, isGetPrices, (*big.Int)(nil)).Return(prices, nil)
+
+		o := gas.NewArbitrumEstimator(logger.TestLogger(t), newConfig(t), client)
+		require.NoError(t, o.Start())
+		t.Cleanup(func() { require.NoError(t, o.Close()) })
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(calldata, gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(1000), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("calling BumpLegacyGas always returns error", func(t *testing.T) {
+		o := gas.NewArbitrumEstimator(logger.TestLogger(t), newConfig(t), new(mocks.ArbitrumRPCClient))
+		_, _, err := o.BumpLegacyGas(big.NewInt(42), gasLimit)
+		assert.EqualError(t, err, "bump gas is not supported for arbitrum")
+	})
+
+	t.Run("calling GetLegacyGas on started estimator if initial call failed returns error", func(t *testing.T) {
+		client := new(mocks.ArbitrumRPCClient)
+		client.On("CallContract", mock.Anything, isGetPrices, (*big.Int)(nil)).Return(nil, errors.New("kaboom"))
+
+		o := gas.NewArbitrumEstimator(logger.TestLogger(t), newConfig(t), client)
+		require.NoError(t, o.Start())
+		t.Cleanup(func() { require.NoError(t, o.Close()) })
+
+		_, _, err := o.GetLegacyGas(calldata, gasLimit)
+		assert.EqualError(t, err, "failed to estimate arbitrum gas; prices not set")
+	})
+
+	t.Run("calling GetDynamicFee always returns error", func(t *testing.T) {
+		o := gas.NewArbitrumEstimator(logger.TestLogger(t), newConfig(t), new(mocks.ArbitrumRPCClient))
+		_, _, err := o.GetDynamicFee(gasLimit)
+		assert.EqualError(t, err, "dynamic fees are not implemented for Arbitrum")
+	})
+}
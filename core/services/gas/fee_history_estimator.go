@@ -0,0 +1,305 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promFeeHistoryEstimatorBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_fee_history_base_fee",
+		Help: "Base fee of the next block according to eth_feeHistory (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+
+	promFeeHistoryEstimatorSetTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_fee_history_set_tip_cap",
+		Help: "Fee history estimator set gas tip cap (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+)
+
+var _ Estimator = &FeeHistoryEstimator{}
+
+type (
+	// FeeHistoryEstimator estimates fees from eth_feeHistory, which returns
+	// the base fees and a percentile of the priority fees paid in recent
+	// blocks. Unlike the BlockHistoryEstimator, it does not download full
+	// blocks.
+	FeeHistoryEstimator struct {
+		utils.StartStopOnce
+		ethClient eth.Client
+		chainID   big.Int
+		config    Config
+		mb        *utils.Mailbox
+		wg        *sync.WaitGroup
+		ctx       context.Context
+		ctxCancel context.CancelFunc
+
+		// baseFee is the base fee of the next block
+		baseFee *big.Int
+		tipCap  *big.Int
+		mu      sync.RWMutex
+
+		logger logger.Logger
+	}
+
+	// FeeHistory is the response to eth_feeHistory
+	FeeHistory struct {
+		OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+		BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+		GasUsedRatio  []float64        `json:"gasUsedRatio"`
+		Reward        [][]*hexutil.Big `json:"reward"`
+	}
+)
+
+// NewFeeHistoryEstimator returns a new FeeHistoryEstimator that calls
+// eth_feeHistory on every new head
+func NewFeeHistoryEstimator(lggr logger.Logger, ethClient eth.Client, config Config, chainID big.Int) Estimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FeeHistoryEstimator{
+		ethClient: ethClient,
+		chainID:   chainID,
+		config:    config,
+		mb:        utils.NewMailbox(1),
+		wg:        new(sync.WaitGroup),
+		ctx:       ctx,
+		ctxCancel: cancel,
+		logger:    lggr.Named("fee_history_estimator"),
+	}
+}
+
+// OnNewLongestChain recalculates the fees if a new head comes in and we are
+// not currently fetching
+func (f *FeeHistoryEstimator) OnNewLongestChain(_ context.Context, head eth.Head) {
+	f.mb.Deliver(head)
+}
+
+func (f *FeeHistoryEstimator) Start() error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		ctx, cancel := context.WithTimeout(f.ctx, maxStartTime)
+		defer cancel()
+		if err := f.FetchAndRecalculate(ctx); err != nil {
+			f.logger.Warnw("FeeHistoryEstimator: initial fee history fetch failed", "err", err)
+		}
+		f.wg.Add(1)
+		go f.runLoop()
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.ctxCancel()
+		f.wg.Wait()
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			if _, exists := f.mb.Retrieve(); !exists {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(f.ctx, maxEthNodeRequestTime)
+			if err := f.FetchAndRecalculate(ctx); err != nil {
+				f.logger.Warnw("FeeHistoryEstimator: error fetching fee history", "err", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// FetchAndRecalculate calls eth_feeHistory and sets the base fee and tip cap
+// from the result. The tip cap is the median, across the non-empty blocks, of
+// the configured percentile of the priority fees paid in each block.
+func (f *FeeHistoryEstimator) FetchAndRecalculate(ctx context.Context) error {
+	blockCount := f.config.FeeHistoryEstimatorBlockCount()
+	percentile := f.config.FeeHistoryEstimatorRewardPercentile()
+
+	var history FeeHistory
+	err := f.ethClient.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint(blockCount), "latest", []float64{float64(percentile)})
+	if err != nil {
+		return errors.Wrap(err, "eth_feeHistory failed")
+	}
+	if len(history.BaseFeePerGas) == 0 {
+		return errors.New("eth_feeHistory returned no base fees")
+	}
+	// The last base fee is that of the block after the newest one returned
+	baseFee := history.BaseFeePerGas[len(history.BaseFeePerGas)-1].ToInt()
+
+	var rewards []*big.Int
+	for i, reward := range history.Reward {
+		// Empty blocks report a reward of zero, which says nothing about the
+		// tip needed for inclusion
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if len(reward) > 0 && reward[0] != nil {
+			rewards = append(rewards, reward[0].ToInt())
+		}
+	}
+
+	f.setBaseFee(baseFee)
+	promFeeHistoryEstimatorBaseFee.WithLabelValues(f.chainID.String()).Set(float64(baseFee.Int64()))
+
+	if len(rewards) == 0 {
+		f.logger.Debugw("FeeHistoryEstimator: no non-empty blocks in fee history, keeping the previous tip cap", "baseFeeWei", baseFee)
+		return nil
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	tipCap := rewards[len(rewards)/2]
+
+	f.logger.Debugw(fmt.Sprintf("FeeHistoryEstimator: setting new base fee: %s Wei, tip cap: %s Wei", baseFee.String(), tipCap.String()),
+		"baseFeeWei", baseFee, "tipCapWei", tipCap, "oldestBlock", history.OldestBlock, "blocks", len(history.GasUsedRatio))
+	f.setTipCap(tipCap)
+	promFeeHistoryEstimatorSetTipCap.WithLabelValues(fmt.Sprintf("%v%%", percentile), f.chainID.String()).Set(float64(f.getTipCap().Int64()))
+	return nil
+}
+
+func (f *FeeHistoryEstimator) setBaseFee(baseFee *big.Int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.baseFee = baseFee
+}
+
+func (f *FeeHistoryEstimator) setTipCap(tipCap *big.Int) {
+	min := f.config.EvmGasTipCapMinimum()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if tipCap.Cmp(min) < 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas tip cap of %s Wei falls below EVM_GAS_TIP_CAP_MINIMUM=%[2]s, setting gas tip cap to the minimum allowed value of %[2]s Wei instead", tipCap.String(), min.String()), "tipCapWei", tipCap, "minTipCapWei", min)
+		f.tipCap = min
+	} else {
+		f.tipCap = tipCap
+	}
+}
+
+func (f *FeeHistoryEstimator) getBaseFee() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.baseFee
+}
+
+func (f *FeeHistoryEstimator) getTipCap() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.tipCap == nil && f.baseFee != nil {
+		// Every block in the history was empty, so any tip will do
+		return f.config.EvmGasTipCapDefault()
+	}
+	return f.tipCap
+}
+
+// getGasPrice returns the price a legacy transaction needs to pay to be
+// included in the next block
+func (f *FeeHistoryEstimator) getGasPrice() *big.Int {
+	baseFee, tipCap := f.getBaseFee(), f.getTipCap()
+	if baseFee == nil || tipCap == nil {
+		return nil
+	}
+	gasPrice := new(big.Int).Add(baseFee, tipCap)
+	if max := f.config.EvmMaxGasPriceWei(); gasPrice.Cmp(max) > 0 {
+		return max
+	} else if min := f.config.EvmMinGasPriceWei(); gasPrice.Cmp(min) < 0 {
+		return min
+	}
+	return gasPrice
+}
+
+// feeCap allows for the base fee rising by the maximum 12.5% in each of the
+// next FeeHistoryEstimatorBaseFeeBufferBlocks blocks, on top of the tip cap
+func (f *FeeHistoryEstimator) feeCap(baseFee, tipCap *big.Int) *big.Int {
+	feeCap := new(big.Int).Set(baseFee)
+	for i := uint16(0); i < f.config.FeeHistoryEstimatorBaseFeeBufferBlocks(); i++ {
+		feeCap.Mul(feeCap, big.NewInt(9))
+		feeCap.Div(feeCap, big.NewInt(8))
+	}
+	feeCap.Add(feeCap, tipCap)
+	if max := f.config.EvmMaxGasPriceWei(); feeCap.Cmp(max) > 0 {
+		return max
+	}
+	return feeCap
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint64, _ ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		gasPrice = f.getGasPrice()
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	return BumpLegacyGasPriceOnly(f.config, f.getGasPrice(), originalGasPrice, gasLimit)
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+	var baseFee, tipCap *big.Int
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		baseFee, tipCap = f.getBaseFee(), f.getTipCap()
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if baseFee == nil || tipCap == nil {
+		return fee, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	fee.FeeCap = f.feeCap(baseFee, tipCap)
+	fee.TipCap = tipCap
+	return
+}
+
+// BumpDynamicFee bumps the tip cap as usual, raising it to the tip paid in
+// recent blocks if the transaction has fallen behind. Rather than jumping
+// straight to the max gas price, the fee cap is bumped by the same percentage
+// as the tip cap, or raised to the current estimate if that is higher.
+func (f *FeeHistoryEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	bumped, chainSpecificGasLimit, err = BumpDynamicFeeOnly(f.config, f.getTipCap(), originalFee, originalGasLimit)
+	if err != nil {
+		return bumped, 0, err
+	}
+
+	feeCap := new(big.Int).Mul(originalFee.FeeCap, big.NewInt(int64(100+f.config.EvmGasBumpPercent())))
+	feeCap.Div(feeCap, big.NewInt(100))
+	if baseFee := f.getBaseFee(); baseFee != nil {
+		feeCap = max(feeCap, f.feeCap(baseFee, bumped.TipCap))
+	}
+	// The fee cap can never be lower than the tip cap
+	feeCap = max(feeCap, bumped.TipCap)
+	if maxGasPrice := f.config.EvmMaxGasPriceWei(); feeCap.Cmp(maxGasPrice) > 0 {
+		feeCap = maxGasPrice
+	}
+	bumped.FeeCap = feeCap
+	return bumped, chainSpecificGasLimit, nil
+}
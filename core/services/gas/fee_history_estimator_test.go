@@ -0,0 +1,144 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	gumocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+)
+
+func newFeeHistoryConfig(t *testing.T) *gumocks.Config {
+	config := newConfigWithEIP1559DynamicFeesEnabled(t)
+	config.On("FeeHistoryEstimatorBlockCount").Return(uint16(4))
+	config.On("FeeHistoryEstimatorRewardPercentile").Return(uint16(60))
+	config.On("FeeHistoryEstimatorBaseFeeBufferBlocks").Return(uint16(2))
+	config.On("EvmGasTipCapMinimum").Return(big.NewInt(0))
+	config.On("EvmGasTipCapDefault").Return(assets.GWei(1))
+	config.On("EvmGasLimitMultiplier").Return(float32(1))
+	config.On("EvmMaxGasPriceWei").Return(assets.GWei(5000))
+	config.On("EvmMinGasPriceWei").Return(assets.GWei(1))
+	config.On("EvmGasBumpPercent").Return(uint16(20))
+	config.On("EvmGasBumpWei").Return(assets.GWei(1))
+	return config
+}
+
+func mockFeeHistory(ethClient *mocks.Client, history string) *mock.Call {
+	return ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*gas.FeeHistory"), "eth_feeHistory", hexutil.Uint(4), "latest", []float64{60}).
+		Run(func(args mock.Arguments) {
+			res := args.Get(1).(*gas.FeeHistory)
+			if err := json.Unmarshal([]byte(history), res); err != nil {
+				panic(err)
+			}
+		}).Return(nil)
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	gwei := func(n float64) *big.Int {
+		wei, _ := new(big.Float).Mul(big.NewFloat(n), big.NewFloat(1e9)).Int(nil)
+		return wei
+	}
+
+	// Base fees of 10, 11, 12 and 14 gwei, with 16 gwei for the next block.
+	// The second block is empty.
+	const history = `{
+		"oldestBlock": "0x10",
+		"baseFeePerGas": ["0x2540be400", "0x28fa6ae00", "0x2cb417800", "0x342770c00", "0x3b9aca000"],
+		"gasUsedRatio": [0.5, 0, 0.9, 0.7],
+		"reward": [["0x77359400"], ["0x0"], ["0xee6b2800"], ["0xb2d05e00"]]
+	}`
+
+	t.Run("estimates fees from the fee history", func(t *testing.T) {
+		config := newFeeHistoryConfig(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		mockFeeHistory(ethClient, history).Once()
+
+		estimator := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, config, cltest.FixtureChainID)
+		require.NoError(t, estimator.Start())
+		defer estimator.Close()
+
+		// The tip cap is the median of 2, 4 and 3 gwei, ignoring the empty
+		// block. The fee cap allows for two blocks of 12.5% base fee growth.
+		fee, gasLimit, err := estimator.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100000), gasLimit)
+		assert.Equal(t, gwei(3), fee.TipCap)
+		assert.Equal(t, gwei(16*1.125*1.125+3), fee.FeeCap)
+
+		gasPrice, _, err := estimator.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, gwei(19), gasPrice)
+
+		// The fee cap is bumped by the same percentage as the tip cap
+		bumped, _, err := estimator.BumpDynamicFee(fee, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, gwei(4), bumped.TipCap)
+		assert.Equal(t, new(big.Int).Div(new(big.Int).Mul(fee.FeeCap, big.NewInt(120)), big.NewInt(100)), bumped.FeeCap)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("raises the bumped fee cap to the current estimate", func(t *testing.T) {
+		config := newFeeHistoryConfig(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		mockFeeHistory(ethClient, history).Once()
+
+		estimator := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, config, cltest.FixtureChainID)
+		require.NoError(t, estimator.Start())
+		defer estimator.Close()
+
+		bumped, _, err := estimator.BumpDynamicFee(gas.DynamicFee{TipCap: gwei(1), FeeCap: gwei(5)}, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, gwei(3), bumped.TipCap)
+		assert.Equal(t, gwei(16*1.125*1.125+3), bumped.FeeCap)
+	})
+
+	t.Run("uses the default tip cap if every block was empty", func(t *testing.T) {
+		config := newFeeHistoryConfig(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		mockFeeHistory(ethClient, `{
+			"oldestBlock": "0x10",
+			"baseFeePerGas": ["0x3b9aca00", "0x3b9aca00"],
+			"gasUsedRatio": [0],
+			"reward": [["0x0"]]
+		}`).Once()
+
+		estimator := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, config, cltest.FixtureChainID)
+		require.NoError(t, estimator.Start())
+		defer estimator.Close()
+
+		fee, _, err := estimator.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, gwei(1), fee.TipCap)
+	})
+
+	t.Run("returns an error until the fee history has been fetched", func(t *testing.T) {
+		config := newFeeHistoryConfig(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+		estimator := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, config, cltest.FixtureChainID)
+		_, _, err := estimator.GetDynamicFee(100000)
+		require.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("method not found")).Once()
+		require.NoError(t, estimator.Start())
+		defer estimator.Close()
+
+		_, _, err = estimator.GetDynamicFee(100000)
+		require.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	})
+}
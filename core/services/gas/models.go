@@ -30,8 +30,12 @@ func IsBumpErr(err error) bool {
 func NewEstimator(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
 	s := config.GasEstimatorMode()
 	switch s {
+	case "Arbitrum":
+		return NewArbitrumEstimator(lggr, config, ethClient)
 	case "BlockHistory":
 		return NewBlockHistoryEstimator(lggr, ethClient, config, *ethClient.ChainID())
+	case "FeeHistory":
+		return NewFeeHistoryEstimator(lggr, ethClient, config, *ethClient.ChainID())
 	case "FixedPrice":
 		return NewFixedPriceEstimator(config)
 	case "Optimism":
@@ -93,6 +97,9 @@ type Config interface {
 	EvmGasTipCapMinimum() *big.Int
 	EvmMaxGasPriceWei() *big.Int
 	EvmMinGasPriceWei() *big.Int
+	FeeHistoryEstimatorBaseFeeBufferBlocks() uint16
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	GasEstimatorMode() string
 }
 
@@ -0,0 +1,88 @@
+package headtracker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promTaggedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_tagged_head",
+		Help: "The number of the latest block with the given tag, i.e. finalized or safe",
+	}, []string{"evmChainID", "tag"})
+
+	trackedBlockTags = []eth.BlockTag{eth.BlockTagFinalized, eth.BlockTagSafe}
+)
+
+// LatestTaggedHead returns the latest head with the given tag, or nil if the
+// chain does not have finality tags enabled or the head was not fetched yet
+func (ht *HeadTracker) LatestTaggedHead(tag eth.BlockTag) *eth.Head {
+	ht.taggedHeadsMu.RLock()
+	defer ht.taggedHeadsMu.RUnlock()
+	return ht.taggedHeads[tag]
+}
+
+// tagFetcher fetches the latest tagged heads whenever a new head arrives, so
+// that handling heads never waits on the node for them
+func (ht *HeadTracker) tagFetcher() {
+	defer ht.wgDone.Done()
+	for {
+		select {
+		case <-ht.chStop:
+			return
+		case <-ht.tagsMB.Notify():
+			if _, exists := ht.tagsMB.Retrieve(); exists {
+				ht.fetchTaggedHeads()
+			}
+		}
+	}
+}
+
+// fetchTaggedHeads fetches the latest finalized and safe heads. Tagged heads
+// never go backwards, so on error, or if a node returns an older head, the
+// last known tagged head is kept.
+func (ht *HeadTracker) fetchTaggedHeads() {
+	ctx, cancel := utils.ContextFromChan(ht.chStop)
+	defer cancel()
+	for _, tag := range trackedBlockTags {
+		ctxTag, cancelTag := eth.DefaultQueryCtx(ctx)
+		tagged, err := ht.ethClient.HeadByTag(ctxTag, tag)
+		cancelTag()
+		if ctx.Err() != nil {
+			return
+		} else if err != nil {
+			ht.log.Warnw("Failed to fetch tagged head", "tag", tag, "err", err)
+		} else if tagged != nil {
+			ht.setTaggedHead(tag, tagged)
+		}
+	}
+}
+
+func (ht *HeadTracker) setTaggedHead(tag eth.BlockTag, tagged *eth.Head) {
+	ht.taggedHeadsMu.Lock()
+	defer ht.taggedHeadsMu.Unlock()
+	if prev := ht.taggedHeads[tag]; prev == nil || tagged.Number > prev.Number {
+		ht.taggedHeads[tag] = tagged
+		promTaggedHead.WithLabelValues(ht.chainID.String(), string(tag)).Set(float64(tagged.Number))
+	}
+}
+
+// setTagNumbers sets the numbers of the last fetched finalized and safe heads
+// on the given head
+func (ht *HeadTracker) setTagNumbers(head *eth.Head) {
+	ht.taggedHeadsMu.RLock()
+	defer ht.taggedHeadsMu.RUnlock()
+	head.FinalizedNumber = ht.taggedNumber(eth.BlockTagFinalized)
+	head.SafeNumber = ht.taggedNumber(eth.BlockTagSafe)
+}
+
+func (ht *HeadTracker) taggedNumber(tag eth.BlockTag) null.Int64 {
+	if tagged := ht.taggedHeads[tag]; tagged != nil {
+		return null.Int64From(tagged.Number)
+	}
+	return null.Int64{}
+}
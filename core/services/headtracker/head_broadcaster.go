@@ -16,6 +16,10 @@ import (
 
 const callbackTimeout = 2 * time.Second
 
+// reorgMailboxCapacity is the number of re-orgs kept for delivery when the
+// subscribers are slow, re-orgs older than that are dropped
+const reorgMailboxCapacity = 10
+
 type callbackID [256]byte
 
 type callbackSet map[callbackID]httypes.HeadTrackable
@@ -31,25 +35,29 @@ func (set callbackSet) clone() callbackSet {
 // NewHeadBroadcaster creates a new HeadBroadcaster
 func NewHeadBroadcaster(logger logger.Logger) httypes.HeadBroadcaster {
 	return &headBroadcaster{
-		logger:        logger.Named("HeadBroadcaster"),
-		callbacks:     make(callbackSet),
-		mailbox:       utils.NewMailbox(1),
-		mutex:         &sync.Mutex{},
-		chClose:       make(chan struct{}),
-		wgDone:        sync.WaitGroup{},
-		StartStopOnce: utils.StartStopOnce{},
+		logger:         logger.Named("HeadBroadcaster"),
+		callbacks:      make(callbackSet),
+		reorgCallbacks: make(map[callbackID]httypes.ReorgTrackable),
+		mailbox:        utils.NewMailbox(1),
+		reorgMailbox:   utils.NewMailbox(reorgMailboxCapacity),
+		mutex:          &sync.Mutex{},
+		chClose:        make(chan struct{}),
+		wgDone:         sync.WaitGroup{},
+		StartStopOnce:  utils.StartStopOnce{},
 	}
 }
 
 // headBroadcaster relays heads from the head tracker to subscribed jobs, it is less robust against
 // congestion than the head tracker, and missed heads should be expected by consuming jobs
 type headBroadcaster struct {
-	logger    logger.Logger
-	callbacks callbackSet
-	mailbox   *utils.Mailbox
-	mutex     *sync.Mutex
-	chClose   chan struct{}
-	wgDone    sync.WaitGroup
+	logger         logger.Logger
+	callbacks      callbackSet
+	reorgCallbacks map[callbackID]httypes.ReorgTrackable
+	mailbox        *utils.Mailbox
+	reorgMailbox   *utils.Mailbox
+	mutex          *sync.Mutex
+	chClose        chan struct{}
+	wgDone         sync.WaitGroup
 	utils.StartStopOnce
 	latest *eth.Head
 }
@@ -69,6 +77,7 @@ func (hb *headBroadcaster) Close() error {
 		hb.mutex.Lock()
 		// clear all callbacks
 		hb.callbacks = make(callbackSet)
+		hb.reorgCallbacks = make(map[callbackID]httypes.ReorgTrackable)
 		hb.mutex.Unlock()
 
 		close(hb.chClose)
@@ -104,6 +113,31 @@ func (hb *headBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLon
 	return
 }
 
+func (hb *headBroadcaster) OnReorg(ctx context.Context, reorg httypes.Reorg) {
+	hb.reorgMailbox.Deliver(reorg)
+}
+
+// SubscribeToReorgs - Subscribes to OnReorg until HeadBroadcaster is closed,
+// or unsubscribe callback is called explicitly
+func (hb *headBroadcaster) SubscribeToReorgs(callback httypes.ReorgTrackable) (unsubscribe func()) {
+	if callback == nil {
+		panic("callback must be non-nil func")
+	}
+	hb.mutex.Lock()
+	defer hb.mutex.Unlock()
+	id, err := newID()
+	if err != nil {
+		hb.logger.Errorf("Unable to create ID for reorg callback: %v", err)
+		return func() {}
+	}
+	hb.reorgCallbacks[id] = callback
+	return func() {
+		hb.mutex.Lock()
+		defer hb.mutex.Unlock()
+		delete(hb.reorgCallbacks, id)
+	}
+}
+
 func (hb *headBroadcaster) run() {
 	defer hb.wgDone.Done()
 	for {
@@ -112,6 +146,8 @@ func (hb *headBroadcaster) run() {
 			return
 		case <-hb.mailbox.Notify():
 			hb.executeCallbacks()
+		case <-hb.reorgMailbox.Notify():
+			hb.executeReorgCallbacks()
 		}
 	}
 }
@@ -159,6 +195,47 @@ func (hb *headBroadcaster) executeCallbacks() {
 	wg.Wait()
 }
 
+// executeReorgCallbacks delivers the pending re-orgs, oldest first. Re-orgs
+// are detected once the new longest chain has been backfilled, so they
+// usually arrive after the head of the new longest chain.
+func (hb *headBroadcaster) executeReorgCallbacks() {
+	for {
+		item, exists := hb.reorgMailbox.Retrieve()
+		if !exists {
+			return
+		}
+		reorg, ok := item.(httypes.Reorg)
+		if !ok {
+			hb.logger.Errorf("Expected `httypes.Reorg`, got %T", item)
+			continue
+		}
+		hb.mutex.Lock()
+		callbacks := make([]httypes.ReorgTrackable, 0, len(hb.reorgCallbacks))
+		for _, callback := range hb.reorgCallbacks {
+			callbacks = append(callbacks, callback)
+		}
+		hb.mutex.Unlock()
+
+		hb.logger.Debugw("Initiating reorg callbacks",
+			"depth", reorg.Depth,
+			"newHeadNum", reorg.NewHeadNumber,
+			"numCallbacks", len(callbacks),
+		)
+
+		wg := sync.WaitGroup{}
+		wg.Add(len(callbacks))
+		for _, callback := range callbacks {
+			go func(trackable httypes.ReorgTrackable) {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(context.Background(), callbackTimeout)
+				defer cancel()
+				trackable.OnReorg(ctx, reorg)
+			}(callback)
+		}
+		wg.Wait()
+	}
+}
+
 func newID() (id callbackID, _ error) {
 	randBytes := make([]byte, 256)
 	_, err := rand.Read(randBytes)
@@ -177,5 +254,9 @@ func (*NullBroadcaster) OnNewLongestChain(ctx context.Context, head eth.Head) {}
 func (*NullBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLongestChain *eth.Head, unsubscribe func()) {
 	return nil, func() {}
 }
+func (*NullBroadcaster) OnReorg(ctx context.Context, reorg httypes.Reorg) {}
+func (*NullBroadcaster) SubscribeToReorgs(callback httypes.ReorgTrackable) (unsubscribe func()) {
+	return func() {}
+}
 func (n *NullBroadcaster) Healthy() error { return nil }
 func (n *NullBroadcaster) Ready() error   { return nil }
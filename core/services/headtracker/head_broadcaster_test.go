@@ -1,6 +1,7 @@
 package headtracker_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	htmocks "github.com/smartcontractkit/chainlink/core/services/headtracker/mocks"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -76,3 +79,30 @@ func TestHeadBroadcaster_Subscribe(t *testing.T) {
 
 	require.NoError(t, ht.Stop())
 }
+
+func TestHeadBroadcaster_SubscribeToReorgs(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	hb := headtracker.NewHeadBroadcaster(logger.TestLogger(t))
+	require.NoError(t, hb.Start())
+	defer hb.Close()
+
+	reorg := httypes.Reorg{Depth: 2, NewHeadHash: utils.NewHash(), NewHeadNumber: 13}
+	chReorgs := make(chan httypes.Reorg, 2)
+	trackable := new(htmocks.ReorgTrackable)
+	trackable.Test(t)
+	trackable.On("OnReorg", mock.Anything, reorg).Run(func(args mock.Arguments) {
+		chReorgs <- args.Get(1).(httypes.Reorg)
+	}).Once()
+
+	unsubscribe := hb.SubscribeToReorgs(trackable)
+	hb.OnReorg(context.Background(), reorg)
+	g.Eventually(chReorgs).Should(gomega.Receive(gomega.Equal(reorg)))
+
+	unsubscribe()
+	hb.OnReorg(context.Background(), reorg)
+	g.Consistently(chReorgs, 100*time.Millisecond).ShouldNot(gomega.Receive())
+
+	trackable.AssertExpectations(t)
+}
@@ -32,9 +32,11 @@ var (
 type Config interface {
 	BlockEmissionIdleWarningThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagsEnabled() bool
 	EvmHeadTrackerHistoryDepth() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmPollInterval() time.Duration
 }
 
 type HeadListener struct {
@@ -186,6 +188,10 @@ func (hl *HeadListener) subscribeToHead() error {
 	hl.headers = make(chan *eth.Head)
 
 	sub, err := hl.ethClient.SubscribeNewHead(context.Background(), hl.headers)
+	if errors.Is(err, eth.ErrSubscriptionsNotSupported) {
+		hl.log.Debugf("No websocket node available on chain %s, polling for heads every %s", hl.chainID.String(), hl.config.EvmPollInterval())
+		sub, err = newHeadPoller(hl.log, hl.ethClient, hl.config.EvmPollInterval(), hl.headers), nil
+	}
 	if err != nil {
 		return errors.Wrap(err, "EthClient#SubscribeNewHead")
 	}
@@ -0,0 +1,56 @@
+package headtracker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	htmocks "github.com/smartcontractkit/chainlink/core/services/headtracker/mocks"
+)
+
+func TestHeadListener_PollsWithoutSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	config := new(htmocks.Config)
+	config.Test(t)
+	config.On("BlockEmissionIdleWarningThreshold").Return(time.Hour)
+	config.On("EvmPollInterval").Return(10 * time.Millisecond)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(nil, eth.ErrSubscriptionsNotSupported)
+
+	h1 := cltest.Head(1)
+	h2 := cltest.Head(2)
+	// The same head is polled several times before the next one arrives
+	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(h1, nil).Times(3)
+	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(h2, nil)
+
+	chStop := make(chan struct{})
+	hl := headtracker.NewHeadListener(logger.TestLogger(t), ethClient, config, chStop, cltest.NeverSleeper{})
+
+	chHeads := make(chan eth.Head, 10)
+	chDone := make(chan struct{})
+	go hl.ListenForNewHeads(func(_ context.Context, head eth.Head) error {
+		chHeads <- head
+		return nil
+	}, func() { close(chDone) })
+
+	g := gomega.NewWithT(t)
+	g.Eventually(func() int { return len(chHeads) }).Should(gomega.Equal(2))
+	g.Consistently(func() int { return len(chHeads) }, 100*time.Millisecond).Should(gomega.Equal(2))
+	assert.True(t, hl.Connected())
+
+	close(chStop)
+	<-chDone
+
+	assert.Equal(t, h1.Hash, (<-chHeads).Hash)
+	assert.Equal(t, h2.Hash, (<-chHeads).Hash)
+}
@@ -0,0 +1,93 @@
+package headtracker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+// headPoller stands in for a newHeads subscription when no node with a
+// websocket url is available. It polls for the latest head at a fixed interval
+// and sends it on the channel whenever it has changed.
+type headPoller struct {
+	ethClient eth.Client
+	interval  time.Duration
+	ch        chan<- *eth.Head
+	lggr      logger.Logger
+
+	chErr    chan error
+	chStop   chan struct{}
+	wgDone   sync.WaitGroup
+	stopOnce sync.Once
+}
+
+var _ ethereum.Subscription = (*headPoller)(nil)
+
+func newHeadPoller(lggr logger.Logger, ethClient eth.Client, interval time.Duration, ch chan<- *eth.Head) *headPoller {
+	p := &headPoller{
+		ethClient: ethClient,
+		interval:  interval,
+		ch:        ch,
+		lggr:      lggr.Named("poller"),
+		chErr:     make(chan error),
+		chStop:    make(chan struct{}),
+	}
+	p.wgDone.Add(1)
+	go p.run()
+	return p
+}
+
+func (p *headPoller) run() {
+	defer p.wgDone.Done()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	var latest *eth.Head
+	for {
+		head := p.poll()
+		if head != nil && (latest == nil || head.Hash != latest.Hash) {
+			select {
+			case p.ch <- head:
+				latest = head
+			case <-p.chStop:
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-p.chStop:
+			return
+		}
+	}
+}
+
+func (p *headPoller) poll() *eth.Head {
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+	head, err := p.ethClient.HeadByNumber(ctx, nil)
+	if err != nil {
+		p.lggr.Warnw("Failed to poll for the latest head", "err", err)
+		return nil
+	}
+	return head
+}
+
+// Unsubscribe stops polling, and only returns once nothing more will be sent
+// on the channel, so it is safe for the caller to close it afterwards
+func (p *headPoller) Unsubscribe() {
+	p.stopOnce.Do(func() {
+		close(p.chStop)
+		p.wgDone.Wait()
+	})
+}
+
+// Err returns a channel which is never written to, as polling errors are
+// retried on the next tick
+func (p *headPoller) Err() <-chan error {
+	return p.chErr
+}
@@ -48,8 +48,16 @@ type HeadTracker struct {
 	callbackMB   utils.Mailbox
 	headListener *HeadListener
 	headSaver    *HeadSaver
-	chStop       chan struct{}
-	wgDone       sync.WaitGroup
+	// reorgCheckHead is the longest chain last checked for re-orgs, it is
+	// only accessed by the backfiller
+	reorgCheckHead *eth.Head
+	// taggedHeads are the latest finalized and safe heads, if the chain has
+	// finality tags enabled
+	taggedHeads   map[eth.BlockTag]*eth.Head
+	taggedHeadsMu sync.RWMutex
+	tagsMB        utils.Mailbox
+	chStop        chan struct{}
+	wgDone        sync.WaitGroup
 	utils.StartStopOnce
 }
 
@@ -77,6 +85,8 @@ func NewHeadTracker(
 		chStop:          chStop,
 		headListener:    NewHeadListener(l, ethClient, config, chStop, sleepers...),
 		headSaver:       NewHeadSaver(l, orm, config),
+		taggedHeads:     make(map[eth.BlockTag]*eth.Head),
+		tagsMB:          *utils.NewMailbox(1),
 	}
 }
 
@@ -116,6 +126,9 @@ func (ht *HeadTracker) Start() error {
 		if err != nil {
 			return err
 		} else if initialHead != nil {
+			if ht.config.EvmFinalityTagsEnabled() {
+				ht.fetchTaggedHeads()
+			}
 			if err := ht.handleNewHead(ctx, *initialHead); err != nil {
 				return errors.Wrap(err, "error handling initial head")
 			}
@@ -123,10 +136,11 @@ func (ht *HeadTracker) Start() error {
 			ht.log.Debug("Got nil initial head")
 		}
 
-		ht.wgDone.Add(3)
+		ht.wgDone.Add(4)
 		go ht.headListener.ListenForNewHeads(ht.handleNewHead, ht.wgDone.Done)
 		go ht.backfiller()
 		go ht.headCallbackLoop()
+		go ht.tagFetcher()
 
 		return nil
 	})
@@ -251,6 +265,8 @@ func (ht *HeadTracker) backfiller() {
 					} else if ctx.Err() != nil {
 						cancel()
 						break
+					} else {
+						ht.checkForReorg(ctx, h)
 					}
 					cancel()
 				}
@@ -363,7 +379,12 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head eth.Head) error {
 		}
 
 		ht.backfillMB.Deliver(*headWithChain)
-		ht.callbackMB.Deliver(*headWithChain)
+		callbackHead := *headWithChain
+		if ht.config.EvmFinalityTagsEnabled() {
+			ht.setTagNumbers(&callbackHead)
+			ht.tagsMB.Deliver(struct{}{})
+		}
+		ht.callbackMB.Deliver(callbackHead)
 		return nil
 	}
 	if head.Number == prevHead.Number {
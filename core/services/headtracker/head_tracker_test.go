@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/headtracker"
 	htmocks "github.com/smartcontractkit/chainlink/core/services/headtracker/mocks"
@@ -211,6 +212,76 @@ func TestHeadTracker_CallsHeadTrackableCallbacks(t *testing.T) {
 	assert.Equal(t, int32(1), checker.OnNewLongestChainCount())
 }
 
+func TestHeadTracker_TracksFinalityTags(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	gcfg := cltest.NewTestGeneralConfig(t)
+	gcfg.Overrides.GlobalEvmFinalityTagsEnabled = null.BoolFrom(true)
+	config := evmtest.NewChainScopedConfig(t, gcfg)
+	orm := headtracker.NewORM(db, cltest.FixtureChainID)
+
+	ethClient, sub := cltest.NewEthClientAndSubMockWithDefaultChain(t)
+
+	chchHeaders := make(chan chan<- *eth.Head, 1)
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			chchHeaders <- args.Get(1).(chan<- *eth.Head)
+		}).
+		Return(sub, nil)
+	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(cltest.Head(0), nil)
+	ethClient.On("HeadByTag", mock.Anything, eth.BlockTagFinalized).Return(cltest.Head(0), nil).Once()
+	ethClient.On("HeadByTag", mock.Anything, eth.BlockTagSafe).Return(cltest.Head(0), nil).Once()
+	// A failed or older tagged head does not move the tagged head backwards
+	ethClient.On("HeadByTag", mock.Anything, eth.BlockTagFinalized).Return(nil, errors.New("method not found"))
+	ethClient.On("HeadByTag", mock.Anything, eth.BlockTagSafe).Return(cltest.Head(1), nil)
+
+	sub.On("Unsubscribe").Return()
+	sub.On("Err").Return(nil)
+
+	chHeads := make(chan eth.Head, 2)
+	checker := new(htmocks.HeadTrackable)
+	checker.On("OnNewLongestChain", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { chHeads <- args.Get(1).(eth.Head) }).
+		Return()
+	ht := createHeadTrackerWithChecker(t, ethClient, config, orm, checker)
+
+	awaitHead := func() eth.Head {
+		select {
+		case head := <-chHeads:
+			return head
+		case <-time.After(cltest.DefaultWaitTimeout):
+			t.Fatal("timed out waiting for head")
+		}
+		return eth.Head{}
+	}
+
+	require.NoError(t, ht.Start())
+	t.Cleanup(func() { require.NoError(t, ht.Stop()) })
+
+	head := awaitHead()
+	assert.Equal(t, int64(0), head.Number)
+	assert.Equal(t, clnull.Int64From(0), head.FinalizedNumber)
+	assert.Equal(t, clnull.Int64From(0), head.SafeNumber)
+
+	// Tagged heads are fetched again after each head, outside of head handling
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int64 {
+		if tagged := ht.headTracker.LatestTaggedHead(eth.BlockTagSafe); tagged != nil {
+			return tagged.Number
+		}
+		return -1
+	}, cltest.DefaultWaitTimeout).Should(gomega.Equal(int64(1)))
+
+	headers := <-chchHeaders
+	headers <- &eth.Head{Number: 1, Hash: utils.NewHash()}
+
+	head = awaitHead()
+	assert.Equal(t, int64(1), head.Number)
+	assert.Equal(t, clnull.Int64From(0), head.FinalizedNumber)
+	assert.Equal(t, clnull.Int64From(1), head.SafeNumber)
+}
+
 func TestHeadTracker_ReconnectOnError(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewGomegaWithT(t)
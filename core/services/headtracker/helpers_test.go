@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 )
 
 func GetHeadListenerConnectedMutex(hl *HeadListener) *sync.RWMutex {
@@ -24,3 +25,7 @@ func Heads(hs *HeadSaver) []*eth.Head {
 	defer hs.mu.RUnlock()
 	return hs.heads
 }
+
+func FindReorg(prevHead, newHead *eth.Head) *httypes.Reorg {
+	return findReorg(prevHead, newHead)
+}
@@ -41,6 +41,20 @@ func (_m *Config) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagsEnabled provides a mock function with given fields:
+func (_m *Config) EvmFinalityTagsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *Config) EvmHeadTrackerHistoryDepth() uint32 {
 	ret := _m.Called()
@@ -82,3 +96,17 @@ func (_m *Config) EvmHeadTrackerSamplingInterval() time.Duration {
 
 	return r0
 }
+
+// EvmPollInterval provides a mock function with given fields:
+func (_m *Config) EvmPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
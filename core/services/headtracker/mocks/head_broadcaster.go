@@ -49,6 +49,11 @@ func (_m *HeadBroadcaster) OnNewLongestChain(ctx context.Context, head eth.Head)
 	_m.Called(ctx, head)
 }
 
+// OnReorg provides a mock function with given fields: ctx, reorg
+func (_m *HeadBroadcaster) OnReorg(ctx context.Context, reorg types.Reorg) {
+	_m.Called(ctx, reorg)
+}
+
 // Ready provides a mock function with given fields:
 func (_m *HeadBroadcaster) Ready() error {
 	ret := _m.Called()
@@ -101,3 +106,19 @@ func (_m *HeadBroadcaster) Subscribe(callback types.HeadTrackable) (*eth.Head, f
 
 	return r0, r1
 }
+
+// SubscribeToReorgs provides a mock function with given fields: callback
+func (_m *HeadBroadcaster) SubscribeToReorgs(callback types.ReorgTrackable) func() {
+	ret := _m.Called(callback)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(types.ReorgTrackable) func()); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
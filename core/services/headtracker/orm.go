@@ -5,8 +5,11 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -89,3 +92,57 @@ func (orm *ORM) HeadByHash(ctx context.Context, hash common.Hash) (*eth.Head, er
 	}
 	return head, err
 }
+
+// InsertReorg saves a re-org, and deletes the re-orgs of the chain older than
+// the latest n
+func (orm *ORM) InsertReorg(ctx context.Context, reorg *httypes.Reorg, n uint) error {
+	return orm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(`
+INSERT INTO evm_reorgs (evm_chain_id, depth, orphaned_hashes, common_ancestor_hash, common_ancestor_number, previous_head_hash, previous_head_number, new_head_hash, new_head_number, created_at)
+VALUES (?,?,?,?,?,?,?,?,?,?)
+RETURNING id`, orm.chainID, reorg.Depth, pq.ByteaArray(hashesToBytes(reorg.OrphanedHashes)), reorg.CommonAncestorHash, reorg.CommonAncestorNumber,
+			reorg.PreviousHeadHash, reorg.PreviousHeadNumber, reorg.NewHeadHash, reorg.NewHeadNumber, reorg.CreatedAt).Scan(&reorg.ID).Error
+		if err != nil {
+			return errors.Wrap(err, "InsertReorg failed to insert re-org")
+		}
+		err = tx.Exec(`
+	DELETE FROM evm_reorgs
+	WHERE evm_chain_id = ? AND id NOT IN (
+		SELECT id FROM evm_reorgs
+		WHERE evm_chain_id = ?
+		ORDER BY id DESC
+		LIMIT ?
+	)`, orm.chainID, orm.chainID, n).Error
+		return errors.Wrap(err, "InsertReorg failed to delete old re-orgs")
+	})
+}
+
+// Reorgs returns the re-orgs of the chain, latest first, and the total count
+func (orm *ORM) Reorgs(ctx context.Context, offset, limit int) (reorgs []httypes.Reorg, count int, err error) {
+	db := orm.db.WithContext(ctx)
+	if err = db.Raw(`SELECT count(*) FROM evm_reorgs WHERE evm_chain_id = ?`, orm.chainID).Scan(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "Reorgs failed to count re-orgs")
+	}
+	rows, err := db.Raw(`
+SELECT id, evm_chain_id, depth, orphaned_hashes, common_ancestor_hash, common_ancestor_number, previous_head_hash, previous_head_number, new_head_hash, new_head_number, created_at
+FROM evm_reorgs WHERE evm_chain_id = ?
+ORDER BY id DESC OFFSET ? LIMIT ?`, orm.chainID, offset, limit).Rows()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Reorgs failed to load re-orgs")
+	}
+	defer logger.ErrorIfClosing(rows, "evm_reorgs rows")
+	for rows.Next() {
+		var reorg httypes.Reorg
+		var orphanedHashes [][]byte
+		err = rows.Scan(&reorg.ID, &reorg.EVMChainID, &reorg.Depth, (*pq.ByteaArray)(&orphanedHashes), &reorg.CommonAncestorHash, &reorg.CommonAncestorNumber,
+			&reorg.PreviousHeadHash, &reorg.PreviousHeadNumber, &reorg.NewHeadHash, &reorg.NewHeadNumber, &reorg.CreatedAt)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "Reorgs failed to scan re-org")
+		}
+		for _, hash := range orphanedHashes {
+			reorg.OrphanedHashes = append(reorg.OrphanedHashes, common.BytesToHash(hash))
+		}
+		reorgs = append(reorgs, reorg)
+	}
+	return reorgs, count, errors.Wrap(rows.Err(), "Reorgs failed to load re-orgs")
+}
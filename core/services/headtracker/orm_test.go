@@ -3,10 +3,15 @@ package headtracker_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -34,3 +39,61 @@ func TestORM_Heads_IdempotentInsertHead(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, head.Hash, foundHead.Hash)
 }
+
+func TestORM_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	orm := headtracker.NewORM(db, cltest.FixtureChainID)
+
+	newReorg := func(newHeadNumber int64) *httypes.Reorg {
+		ancestor := utils.NewHash()
+		return &httypes.Reorg{
+			Depth:                2,
+			OrphanedHashes:       []common.Hash{utils.NewHash(), utils.NewHash()},
+			CommonAncestorHash:   &ancestor,
+			CommonAncestorNumber: null.Int64From(newHeadNumber - 3),
+			PreviousHeadHash:     utils.NewHash(),
+			PreviousHeadNumber:   newHeadNumber - 1,
+			NewHeadHash:          utils.NewHash(),
+			NewHeadNumber:        newHeadNumber,
+			CreatedAt:            time.Now(),
+		}
+	}
+
+	reorgs := []*httypes.Reorg{newReorg(10), newReorg(20), newReorg(30)}
+	for _, reorg := range reorgs {
+		require.NoError(t, orm.InsertReorg(context.TODO(), reorg, 2))
+	}
+	// The reorg without a common ancestor is kept, and the oldest is deleted
+	noAncestor := newReorg(40)
+	noAncestor.CommonAncestorHash = nil
+	noAncestor.CommonAncestorNumber = null.Int64{}
+	require.NoError(t, orm.InsertReorg(context.TODO(), noAncestor, 3))
+
+	found, count, err := orm.Reorgs(context.TODO(), 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, found, 3)
+	assert.Equal(t, noAncestor.ID, found[0].ID)
+	assert.Nil(t, found[0].CommonAncestorHash)
+	assert.False(t, found[0].CommonAncestorNumber.Valid)
+
+	expected := reorgs[2]
+	assert.Equal(t, expected.ID, found[1].ID)
+	assert.Equal(t, cltest.FixtureChainID.String(), found[1].EVMChainID.String())
+	assert.Equal(t, expected.Depth, found[1].Depth)
+	assert.Equal(t, expected.OrphanedHashes, found[1].OrphanedHashes)
+	assert.Equal(t, expected.CommonAncestorHash, found[1].CommonAncestorHash)
+	assert.Equal(t, expected.CommonAncestorNumber, found[1].CommonAncestorNumber)
+	assert.Equal(t, expected.PreviousHeadHash, found[1].PreviousHeadHash)
+	assert.Equal(t, expected.NewHeadHash, found[1].NewHeadHash)
+	assert.Equal(t, expected.NewHeadNumber, found[1].NewHeadNumber)
+	assert.Equal(t, reorgs[1].ID, found[2].ID)
+
+	found, count, err = orm.Reorgs(context.TODO(), 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, found, 1)
+	assert.Equal(t, expected.ID, found[0].ID)
+}
@@ -0,0 +1,122 @@
+package headtracker
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// reorgHistoryDepth is the number of re-orgs kept in the database per chain
+const reorgHistoryDepth = 1000
+
+var (
+	promReorgs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_reorgs",
+		Help: "The number of re-orgs detected",
+	}, []string{"evmChainID"})
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of blocks orphaned by each re-org",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100},
+	}, []string{"evmChainID"})
+)
+
+// findReorg returns the re-org from the previous longest chain to the new
+// longest chain, or nil if the new chain contains the previous head. It also
+// returns nil if the new chain is too short to tell.
+func findReorg(prevHead, newHead *eth.Head) *httypes.Reorg {
+	n := newHead
+	for n != nil && n.Number > prevHead.Number {
+		n = n.Parent
+	}
+	if n == nil || n.Hash == prevHead.Hash {
+		return nil
+	}
+
+	reorg := &httypes.Reorg{
+		PreviousHeadHash:   prevHead.Hash,
+		PreviousHeadNumber: prevHead.Number,
+		NewHeadHash:        newHead.Hash,
+		NewHeadNumber:      newHead.Number,
+	}
+	o := prevHead
+	for o != nil && n != nil {
+		if o.Hash == n.Hash {
+			hash := o.Hash
+			reorg.CommonAncestorHash = &hash
+			reorg.CommonAncestorNumber = null.Int64From(o.Number)
+			break
+		}
+		if n.Number >= o.Number {
+			n = n.Parent
+		}
+		if n == nil || o.Number > n.Number {
+			reorg.OrphanedHashes = append(reorg.OrphanedHashes, o.Hash)
+			o = o.Parent
+		}
+	}
+	if reorg.CommonAncestorNumber.Valid {
+		reorg.Depth = prevHead.Number - reorg.CommonAncestorNumber.Int64
+	} else {
+		reorg.Depth = int64(len(reorg.OrphanedHashes))
+	}
+	return reorg
+}
+
+// checkForReorg compares the new longest chain to the previous one, once the
+// new chain has been backfilled. Any re-org is logged, persisted and sent to
+// the subscribers of the head broadcaster.
+func (ht *HeadTracker) checkForReorg(ctx context.Context, head eth.Head) {
+	prevHead := ht.reorgCheckHead
+	ht.reorgCheckHead = &head
+	if prevHead == nil {
+		return
+	}
+	newChain := ht.headSaver.Chain(head.Hash)
+	if newChain == nil {
+		return
+	}
+	if prevChain := ht.headSaver.Chain(prevHead.Hash); prevChain != nil {
+		prevHead = prevChain
+	}
+
+	reorg := findReorg(prevHead, newChain)
+	if reorg == nil {
+		return
+	}
+	reorg.EVMChainID = utils.Big(ht.chainID)
+	reorg.CreatedAt = time.Now()
+
+	promReorgs.WithLabelValues(ht.chainID.String()).Inc()
+	promReorgDepth.WithLabelValues(ht.chainID.String()).Observe(float64(reorg.Depth))
+	ht.log.Warnw("Detected re-org",
+		"depth", reorg.Depth,
+		"orphanedHashes", reorg.OrphanedHashes,
+		"commonAncestorHash", reorg.CommonAncestorHash,
+		"commonAncestorNumber", reorg.CommonAncestorNumber,
+		"previousHeadHash", reorg.PreviousHeadHash,
+		"newHeadHash", reorg.NewHeadHash,
+		"newHeadNumber", reorg.NewHeadNumber,
+	)
+
+	if err := ht.headSaver.orm.InsertReorg(ctx, reorg, reorgHistoryDepth); err != nil {
+		ht.log.Errorw("Failed to save re-org", "err", err)
+	}
+	ht.headBroadcaster.OnReorg(ctx, *reorg)
+}
+
+func hashesToBytes(hashes []common.Hash) [][]byte {
+	bs := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		bs[i] = hash.Bytes()
+	}
+	return bs
+}
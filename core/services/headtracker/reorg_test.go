@@ -0,0 +1,79 @@
+package headtracker_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// chainOf links the heads into a chain, from the lowest to the highest, and
+// returns the highest
+func chainOf(heads ...*eth.Head) *eth.Head {
+	for i := 1; i < len(heads); i++ {
+		heads[i].Parent = heads[i-1]
+		heads[i].ParentHash = heads[i-1].Hash
+	}
+	return heads[len(heads)-1]
+}
+
+func newHead(n int64) *eth.Head {
+	return &eth.Head{Number: n, Hash: utils.NewHash()}
+}
+
+func TestFindReorg(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns nil if the new chain contains the previous head", func(t *testing.T) {
+		prev := chainOf(newHead(10), newHead(11))
+		next := chainOf(prev, newHead(12))
+
+		assert.Nil(t, headtracker.FindReorg(prev, next))
+	})
+
+	t.Run("returns nil if the new chain is too short to tell", func(t *testing.T) {
+		prev := chainOf(newHead(10), newHead(11))
+
+		assert.Nil(t, headtracker.FindReorg(prev, newHead(13)))
+	})
+
+	t.Run("returns the orphaned blocks and the common ancestor", func(t *testing.T) {
+		a := newHead(10)
+		b, c := newHead(11), newHead(12)
+		prev := chainOf(a, b, c)
+		b2, c2, d2 := newHead(11), newHead(12), newHead(13)
+		a2 := *a
+		a2.Parent = nil
+		next := chainOf(&a2, b2, c2, d2)
+
+		reorg := headtracker.FindReorg(prev, next)
+		require.NotNil(t, reorg)
+		assert.Equal(t, int64(2), reorg.Depth)
+		assert.Equal(t, []common.Hash{c.Hash, b.Hash}, reorg.OrphanedHashes)
+		require.NotNil(t, reorg.CommonAncestorHash)
+		assert.Equal(t, a.Hash, *reorg.CommonAncestorHash)
+		assert.Equal(t, int64(10), reorg.CommonAncestorNumber.Int64)
+		assert.Equal(t, c.Hash, reorg.PreviousHeadHash)
+		assert.Equal(t, int64(12), reorg.PreviousHeadNumber)
+		assert.Equal(t, d2.Hash, reorg.NewHeadHash)
+		assert.Equal(t, int64(13), reorg.NewHeadNumber)
+	})
+
+	t.Run("returns the orphaned blocks without a common ancestor if the chains diverge too far back", func(t *testing.T) {
+		b, c := newHead(11), newHead(12)
+		prev := chainOf(b, c)
+		next := chainOf(newHead(11), newHead(12), newHead(13))
+
+		reorg := headtracker.FindReorg(prev, next)
+		require.NotNil(t, reorg)
+		assert.Equal(t, int64(2), reorg.Depth)
+		assert.Equal(t, []common.Hash{c.Hash, b.Hash}, reorg.OrphanedHashes)
+		assert.Nil(t, reorg.CommonAncestorHash)
+		assert.False(t, reorg.CommonAncestorNumber.Valid)
+	})
+}
@@ -2,9 +2,13 @@ package types
 
 import (
 	"context"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/service"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"go.uber.org/zap/zapcore"
 )
 
@@ -24,6 +28,35 @@ type HeadTrackable interface {
 	OnNewLongestChain(ctx context.Context, head eth.Head)
 }
 
+// Reorg is a re-org of the chain, where the new longest chain does not contain
+// the head of the previous longest chain
+type Reorg struct {
+	ID         int64
+	EVMChainID utils.Big
+	// Depth is the number of blocks of the previous longest chain which were
+	// orphaned
+	Depth int64
+	// OrphanedHashes are the hashes of the orphaned blocks, highest first
+	OrphanedHashes []common.Hash
+	// CommonAncestorHash and CommonAncestorNumber identify the highest block
+	// in both chains. They are not set if the chains diverged further back
+	// than the heads kept in memory.
+	CommonAncestorHash   *common.Hash
+	CommonAncestorNumber null.Int64
+	PreviousHeadHash     common.Hash
+	PreviousHeadNumber   int64
+	NewHeadHash          common.Hash
+	NewHeadNumber        int64
+	CreatedAt            time.Time
+}
+
+// ReorgTrackable represents any object that wishes to be notified of re-orgs,
+// after being subscribed to HeadBroadcaster
+//go:generate mockery --name ReorgTrackable --output ../mocks/ --case=underscore
+type ReorgTrackable interface {
+	OnReorg(ctx context.Context, reorg Reorg)
+}
+
 type SubscribeFunc func(callback HeadTrackable) (unsubscribe func())
 
 type HeadBroadcasterRegistry interface {
@@ -35,5 +68,9 @@ type HeadBroadcasterRegistry interface {
 type HeadBroadcaster interface {
 	service.Service
 	HeadTrackable
+	ReorgTrackable
 	Subscribe(callback HeadTrackable) (currentLongestChain *eth.Head, unsubscribe func())
+	// SubscribeToReorgs subscribes to OnReorg until HeadBroadcaster is
+	// closed, or unsubscribe is called
+	SubscribeToReorgs(callback ReorgTrackable) (unsubscribe func())
 }
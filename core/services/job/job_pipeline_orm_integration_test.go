@@ -124,14 +124,15 @@ func TestPipelineORM_Integration(t *testing.T) {
 		clearJobsDb(t, gdb)
 		orm := pipeline.NewORM(db, logger.TestLogger(t))
 		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{Client: cltest.NewEthClientMockWithDefaultChain(t), DB: gdb, GeneralConfig: config})
-		runner := pipeline.NewRunner(orm, config, cc, nil, nil, lggr)
+		runner, err := pipeline.NewRunner(orm, config, cc, nil, nil, lggr)
+		require.NoError(t, err)
 		defer runner.Close()
 		jobORM := job.NewTestORM(t, db, cc, orm, keyStore)
 
 		dbSpec := makeVoterTurnoutOCRJobSpec(t, gdb, transmitterAddress, bridge.Name.String(), bridge2.Name.String())
 
 		// Need a job in order to create a run
-		err := jobORM.CreateJob(dbSpec)
+		err = jobORM.CreateJob(dbSpec)
 		require.NoError(t, err)
 
 		var pipelineSpecs []pipeline.Spec
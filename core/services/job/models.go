@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -269,6 +270,7 @@ type DirectRequestSpec struct {
 	ContractAddress             ethkey.EIP55Address      `toml:"contractAddress"`
 	MinIncomingConfirmations    clnull.Uint32            `toml:"minIncomingConfirmations"`
 	MinIncomingConfirmationsEnv bool                     `toml:"minIncomingConfirmationsEnv" gorm:"-"`
+	ConfirmationTag             eth.BlockTag             `toml:"confirmationTag"`
 	Requesters                  models.AddressCollection `toml:"requesters"`
 	MinContractPayment          *assets.Link             `toml:"minContractPaymentLinkJuels"`
 	EVMChainID                  *utils.Big               `toml:"evmChainID" gorm:"column:evm_chain_id" db:"evm_chain_id"`
@@ -332,7 +334,8 @@ type FluxMonitorSpecIntThreshold struct {
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
 	MinPayment          *assets.Link
-	EVMChainID          *utils.Big `toml:"evmChainID"`
+	ConfirmationTag     eth.BlockTag `toml:"confirmationTag"`
+	EVMChainID          *utils.Big   `toml:"evmChainID"`
 }
 
 type FluxMonitorSpec struct {
@@ -351,9 +354,10 @@ type FluxMonitorSpec struct {
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
 	MinPayment          *assets.Link
-	EVMChainID          *utils.Big `toml:"evmChainID" gorm:"column:evm_chain_id" db:"evm_chain_id"`
-	CreatedAt           time.Time  `toml:"-"`
-	UpdatedAt           time.Time  `toml:"-"`
+	ConfirmationTag     eth.BlockTag `toml:"confirmationTag"`
+	EVMChainID          *utils.Big   `toml:"evmChainID" gorm:"column:evm_chain_id" db:"evm_chain_id"`
+	CreatedAt           time.Time    `toml:"-"`
+	UpdatedAt           time.Time    `toml:"-"`
 }
 
 type KeeperSpec struct {
@@ -371,6 +375,7 @@ type VRFSpec struct {
 	PublicKey          secp256k1.PublicKey  `toml:"publicKey"`
 	Confirmations      uint32               `toml:"confirmations"`
 	ConfirmationsEnv   bool                 `toml:"-" gorm:"-"`
+	ConfirmationTag    eth.BlockTag         `toml:"confirmationTag"`
 	EVMChainID         *utils.Big           `toml:"evmChainID" gorm:"column:evm_chain_id" db:"evm_chain_id"`
 	FromAddress        *ethkey.EIP55Address `toml:"fromAddress"`
 	PollPeriod         time.Duration        `toml:"pollPeriod"` // For v2 jobs
@@ -57,7 +57,8 @@ func TestRunner(t *testing.T) {
 
 	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t))
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: gdb, Client: ethClient, GeneralConfig: config})
-	runner := pipeline.NewRunner(pipelineORM, config, cc, nil, nil, logger.TestLogger(t))
+	runner, err := pipeline.NewRunner(pipelineORM, config, cc, nil, nil, logger.TestLogger(t))
+	require.NoError(t, err)
 	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore)
 
 	runner.Start()
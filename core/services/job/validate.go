@@ -5,6 +5,9 @@ import (
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 var (
@@ -56,6 +59,9 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
+	if err = pipeline.ValidateTasks(&jb.Pipeline, string(jb.Type)); err != nil {
+		return "", err
+	}
 
 	if strings.Contains(ts, "<{}>") {
 		return "", errors.Errorf("'<{}>' syntax is not supported. Please use \"{}\" instead")
@@ -63,3 +69,12 @@ func ValidateSpec(ts string) (Type, error) {
 
 	return jb.Type, nil
 }
+
+// ValidateConfirmationTag checks that the given confirmationTag, if any, is a
+// block tag tracked by the head tracker
+func ValidateConfirmationTag(tag eth.BlockTag) error {
+	if tag != "" && !tag.IsValid() {
+		return errors.Errorf("unsupported confirmationTag %q, must be %q or %q", tag, eth.BlockTagFinalized, eth.BlockTagSafe)
+	}
+	return nil
+}
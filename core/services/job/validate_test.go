@@ -1,10 +1,14 @@
 package job
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 func TestValidate(t *testing.T) {
@@ -113,3 +117,54 @@ ds [type=http]
 		})
 	}
 }
+
+type webhookOnlyTask struct {
+	pipeline.BaseTask `mapstructure:",squash"`
+}
+
+func (t *webhookOnlyTask) Type() pipeline.TaskType { return "webhookonly" }
+
+func (t *webhookOnlyTask) Run(context.Context, logger.Logger, pipeline.Vars, []pipeline.Result) (pipeline.Result, pipeline.RunInfo) {
+	return pipeline.Result{}, pipeline.RunInfo{}
+}
+
+func TestValidate_RegisteredTaskType(t *testing.T) {
+	pipeline.RegisterTaskType("webhookonly",
+		func(base pipeline.BaseTask) pipeline.Task { return &webhookOnlyTask{BaseTask: base} },
+		pipeline.WithTaskValidator(func(task pipeline.Task, jobType string) error {
+			if jobType != string(Webhook) {
+				return errors.New("only supported by webhook jobs")
+			}
+			return nil
+		}),
+	)
+
+	_, err := ValidateSpec(`
+type="webhook"
+schemaVersion=1
+observationSource="""
+ds [type=webhookonly]
+"""
+`)
+	require.NoError(t, err)
+
+	_, err = ValidateSpec(`
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * 0 0 1 1 *"
+observationSource="""
+ds [type=webhookonly]
+"""
+`)
+	require.EqualError(t, err, "task ds: only supported by webhook jobs")
+
+	_, err = ValidateSpec(`
+type="cron"
+schemaVersion=1
+schedule="CRON_TZ=UTC * 0 0 1 1 *"
+observationSource="""
+ds [type=foreach input="$(foo)" dag="item_ds [type=webhookonly]"]
+"""
+`)
+	require.EqualError(t, err, "task ds: dag: task item_ds: only supported by webhook jobs")
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// UpkeepAction is what the node would do with an upkeep at a block
+type UpkeepAction string
+
+const (
+	// UpkeepActionPerform means that the node would perform the upkeep
+	UpkeepActionPerform UpkeepAction = "perform"
+	// UpkeepActionSkip means that the node would not perform the upkeep,
+	// either because it is not eligible or because checkUpkeep fails
+	UpkeepActionSkip UpkeepAction = "skip"
+)
+
+// UpkeepEligibility explains which keeper is responsible for an upkeep at a
+// block, and what the node would do with it
+type UpkeepEligibility struct {
+	JobID           int32
+	UpkeepID        int64
+	RegistryAddress ethkey.EIP55Address
+	BlockNumber     int64
+
+	// The turn-taking of the registry's keepers at the block
+	BlockCountPerTurn      int32
+	NumKeepers             int32
+	TurnStartBlock         int64
+	ResponsibleKeeperIndex int32
+	KeeperIndex            int32
+	IsOurTurn              bool
+
+	LastRunBlockHeight int64
+	GracePeriod        int64
+
+	// Eligible is whether the node would check the upkeep at the block, and
+	// Reason explains why
+	Eligible bool
+	Reason   string
+
+	// The result of simulating checkUpkeep at the block, only when eligible
+	CheckSimulated bool
+	CheckSucceeded bool
+	CheckError     string
+
+	Action UpkeepAction
+
+	// The result of the last time the node checked the upkeep
+	LastCheck UpkeepRegistration
+}
+
+// ExplainUpkeepEligibility explains whether the node would perform the upkeep
+// of the keeper job at the block, or at the latest block if blockNumber is
+// nil. The conditions are the same as those of ORM.EligibleUpkeepsForRegistry,
+// and checkUpkeep is simulated with an eth_call when the upkeep is eligible.
+func ExplainUpkeepEligibility(
+	ctx context.Context,
+	orm ORM,
+	ethClient eth.Client,
+	config Config,
+	jobID int32,
+	upkeepID int64,
+	blockNumber *int64,
+) (UpkeepEligibility, error) {
+	upkeep, err := orm.UpkeepForJob(ctx, jobID, upkeepID)
+	if err != nil {
+		return UpkeepEligibility{}, errors.Wrapf(err, "could not find upkeep %d of job %d", upkeepID, jobID)
+	}
+	registry := upkeep.Registry
+
+	var block int64
+	if blockNumber != nil {
+		block = *blockNumber
+	} else {
+		head, err := ethClient.HeadByNumber(ctx, nil)
+		if err != nil {
+			return UpkeepEligibility{}, errors.Wrap(err, "could not fetch the latest head")
+		} else if head == nil {
+			return UpkeepEligibility{}, errors.New("could not fetch the latest head")
+		}
+		block = head.Number
+	}
+
+	e := UpkeepEligibility{
+		JobID:              jobID,
+		UpkeepID:           upkeepID,
+		RegistryAddress:    registry.ContractAddress,
+		BlockNumber:        block,
+		BlockCountPerTurn:  registry.BlockCountPerTurn,
+		NumKeepers:         registry.NumKeepers,
+		KeeperIndex:        registry.KeeperIndex,
+		LastRunBlockHeight: upkeep.LastRunBlockHeight,
+		GracePeriod:        config.KeeperMaximumGracePeriod(),
+		Action:             UpkeepActionSkip,
+		LastCheck:          upkeep,
+	}
+
+	switch {
+	case registry.NumKeepers == 0:
+		e.Reason = "the registry has no keepers"
+		return e, nil
+	case registry.BlockCountPerTurn <= 0:
+		e.Reason = "the registry has not been synced yet"
+		return e, nil
+	}
+
+	turn, err := orm.UpkeepTurnForJob(ctx, jobID, upkeepID, block, e.GracePeriod)
+	if err != nil {
+		return UpkeepEligibility{}, errors.Wrapf(err, "could not find the turn of upkeep %d of job %d", upkeepID, jobID)
+	}
+	e.TurnStartBlock = turn.TurnStartBlock
+	e.ResponsibleKeeperIndex = turn.ResponsibleKeeperIndex
+	e.IsOurTurn = e.ResponsibleKeeperIndex == registry.KeeperIndex
+
+	switch {
+	case !e.IsOurTurn:
+		e.Reason = "it is the turn of another keeper"
+		return e, nil
+	case turn.PerformedWithinGracePeriod:
+		e.Reason = "the upkeep was performed within the grace period"
+		return e, nil
+	case turn.PerformedThisTurn:
+		e.Reason = "the upkeep was already performed during this turn"
+		return e, nil
+	}
+	e.Eligible = true
+	e.Reason = "it is our turn to perform the upkeep"
+
+	checkPayload, err := RegistryABI.Pack(
+		"checkUpkeep",
+		big.NewInt(upkeepID),
+		registry.FromAddress.Address(),
+	)
+	if err != nil {
+		return UpkeepEligibility{}, errors.Wrap(err, "unable to construct checkUpkeep data")
+	}
+	contract := registry.ContractAddress.Address()
+	msg := ethereum.CallMsg{
+		To:   &contract,
+		Gas:  config.KeeperRegistryCheckGasOverhead() + uint64(registry.CheckGas) + config.KeeperRegistryPerformGasOverhead() + upkeep.ExecuteGas,
+		Data: checkPayload,
+	}
+	e.CheckSimulated = true
+	if _, err = ethClient.CallContract(ctx, msg, big.NewInt(block)); err != nil {
+		if reason, rerr := eth.ExtractRevertReasonFromRPCError(err); rerr == nil {
+			err = errors.Wrap(err, reason)
+		}
+		e.CheckError = err.Error()
+		return e, nil
+	}
+	e.CheckSucceeded = true
+	e.Action = UpkeepActionPerform
+	return e, nil
+}
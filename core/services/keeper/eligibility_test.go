@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+func TestExplainUpkeepEligibility(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, ethKeyStore)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	checkUpkeepCall := func(block int64) interface{} {
+		return mock.MatchedBy(func(b *big.Int) bool { return b.Cmp(big.NewInt(block)) == 0 })
+	}
+	toRegistry := mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return *msg.To == registry.ContractAddress.Address()
+	})
+
+	t.Run("simulates checkUpkeep when the upkeep is eligible", func(t *testing.T) {
+		ethClient.On("CallContract", mock.Anything, toRegistry, checkUpkeepCall(20)).Return([]byte{}, nil).Once()
+
+		block := int64(20)
+		e, err := keeper.ExplainUpkeepEligibility(context.Background(), orm, ethClient, config, j.ID, upkeep.UpkeepID, &block)
+		require.NoError(t, err)
+		assert.Equal(t, int64(20), e.TurnStartBlock)
+		assert.True(t, e.IsOurTurn)
+		assert.True(t, e.Eligible)
+		assert.True(t, e.CheckSimulated)
+		assert.True(t, e.CheckSucceeded)
+		assert.Equal(t, keeper.UpkeepActionPerform, e.Action)
+	})
+
+	t.Run("reports the checkUpkeep error at the latest block", func(t *testing.T) {
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(40), nil).Once()
+		ethClient.On("CallContract", mock.Anything, toRegistry, checkUpkeepCall(40)).Return(nil, errors.New("execution reverted")).Once()
+
+		e, err := keeper.ExplainUpkeepEligibility(context.Background(), orm, ethClient, config, j.ID, upkeep.UpkeepID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(40), e.BlockNumber)
+		assert.True(t, e.Eligible)
+		assert.False(t, e.CheckSucceeded)
+		assert.Contains(t, e.CheckError, "execution reverted")
+		assert.Equal(t, keeper.UpkeepActionSkip, e.Action)
+	})
+
+	t.Run("is not eligible within the grace period", func(t *testing.T) {
+		require.NoError(t, orm.SetLastRunHeightForUpkeepOnJob(context.Background(), j.ID, upkeep.UpkeepID, 40))
+
+		block := int64(60)
+		e, err := keeper.ExplainUpkeepEligibility(context.Background(), orm, ethClient, config, j.ID, upkeep.UpkeepID, &block)
+		require.NoError(t, err)
+		assert.Equal(t, int64(40), e.LastRunBlockHeight)
+		assert.False(t, e.Eligible)
+		assert.False(t, e.CheckSimulated)
+		assert.Contains(t, e.Reason, "grace period")
+		assert.Equal(t, keeper.UpkeepActionSkip, e.Action)
+	})
+
+	t.Run("is not eligible during the turn of another keeper", func(t *testing.T) {
+		registry.NumKeepers = 2
+		require.NoError(t, db.Save(&registry).Error)
+		turn, err := orm.UpkeepTurnForJob(context.Background(), j.ID, upkeep.UpkeepID, 1000, config.KeeperMaximumGracePeriod())
+		require.NoError(t, err)
+		registry.KeeperIndex = 1 - turn.ResponsibleKeeperIndex
+		require.NoError(t, db.Save(&registry).Error)
+
+		block := int64(1000)
+		e, err := keeper.ExplainUpkeepEligibility(context.Background(), orm, ethClient, config, j.ID, upkeep.UpkeepID, &block)
+		require.NoError(t, err)
+		assert.False(t, e.IsOurTurn)
+		assert.NotEqual(t, e.KeeperIndex, e.ResponsibleKeeperIndex)
+		assert.False(t, e.Eligible)
+		assert.Equal(t, keeper.UpkeepActionSkip, e.Action)
+	})
+
+	t.Run("errors for unknown upkeeps", func(t *testing.T) {
+		block := int64(20)
+		_, err := keeper.ExplainUpkeepEligibility(context.Background(), orm, ethClient, config, j.ID, upkeep.UpkeepID+1, &block)
+		require.Error(t, err)
+	})
+
+	ethClient.AssertExpectations(t)
+}
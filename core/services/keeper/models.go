@@ -1,6 +1,32 @@
 package keeper
 
-import "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+import (
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// UpkeepCheckOutcome is the outcome of checking, and trying to perform, an
+// upkeep
+type UpkeepCheckOutcome string
+
+const (
+	// UpkeepCheckPerformed means that checkUpkeep succeeded and the
+	// performUpkeep transaction was created
+	UpkeepCheckPerformed UpkeepCheckOutcome = "performed"
+	// UpkeepCheckFailed means that the checkUpkeep call reverted or failed,
+	// e.g. because the upkeep was not needed
+	UpkeepCheckFailed UpkeepCheckOutcome = "check_failed"
+	// UpkeepCheckPerformFailed means that checkUpkeep succeeded but the
+	// performUpkeep transaction could not be created
+	UpkeepCheckPerformFailed UpkeepCheckOutcome = "perform_failed"
+	// UpkeepCheckGasEstimationFailed means that the gas price could not be
+	// estimated, so the upkeep was not checked
+	UpkeepCheckGasEstimationFailed UpkeepCheckOutcome = "gas_estimation_failed"
+	// UpkeepCheckRunFailed means that the pipeline run could not be executed
+	UpkeepCheckRunFailed UpkeepCheckOutcome = "run_failed"
+)
 
 type Registry struct {
 	ID                int32 `gorm:"primary_key"`
@@ -26,4 +52,36 @@ type UpkeepRegistration struct {
 	Registry            Registry
 	UpkeepID            int64
 	PositioningConstant int32
+
+	// The result of the last time the node checked the upkeep
+	LastCheckBlockNumber null.Int
+	LastCheckOutcome     null.String
+	LastCheckError       null.String
+	LastCheckRevertData  []byte
+	LastCheckAt          null.Time
+	LastPerformEthTxID   null.Int
+}
+
+// UpkeepCheckResult is the result of checking, and trying to perform, an
+// upkeep at a block
+type UpkeepCheckResult struct {
+	BlockNumber int64
+	Outcome     UpkeepCheckOutcome
+	// Error is the reason the upkeep was not performed, e.g. the revert
+	// reason of checkUpkeep
+	Error null.String
+	// RevertData is the raw data checkUpkeep reverted with, if it did
+	RevertData []byte
+	// PerformTaskRunID is the ID of the task run which created the
+	// performUpkeep transaction, used to find the transaction
+	PerformTaskRunID uuid.NullUUID
+}
+
+// UpkeepTurn is how the turn-taking and grace period rules of
+// ORM.EligibleUpkeepsForRegistry apply to an upkeep at a block
+type UpkeepTurn struct {
+	TurnStartBlock             int64
+	ResponsibleKeeperIndex     int32
+	PerformedWithinGracePeriod bool
+	PerformedThisTurn          bool
 }
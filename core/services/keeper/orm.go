@@ -83,6 +83,22 @@ func (korm ORM) BatchDeleteUpkeepsForJob(ctx context.Context, jobID int32, upkee
 	return exec.RowsAffected, exec.Error
 }
 
+// The turn-taking and grace period rules of upkeeps, used both to select the
+// eligible upkeeps and to explain why an upkeep is eligible or not, so that
+// the two always agree. @blockNumber and @gracePeriod are named arguments.
+const (
+	// turnStartBlockSQL is the first block of the turn @blockNumber is in
+	turnStartBlockSQL = `(@blockNumber - (@blockNumber % keeper_registries.block_count_per_turn))`
+	// responsibleKeeperIndexSQL is the index of the keeper whose turn it is to
+	// perform the upkeep
+	responsibleKeeperIndexSQL = `((upkeep_registrations.positioning_constant + ` + turnStartBlockSQL + ` / keeper_registries.block_count_per_turn) % keeper_registries.num_keepers)`
+	// performedWithinGracePeriodSQL is true if the upkeep was performed
+	// @gracePeriod blocks ago or less
+	performedWithinGracePeriodSQL = `(upkeep_registrations.last_run_block_height <> 0 AND upkeep_registrations.last_run_block_height + @gracePeriod >= @blockNumber)`
+	// performedThisTurnSQL is true if the upkeep was performed during the turn
+	performedThisTurnSQL = `(upkeep_registrations.last_run_block_height <> 0 AND upkeep_registrations.last_run_block_height >= ` + turnStartBlockSQL + `)`
+)
+
 func (korm ORM) EligibleUpkeepsForRegistry(
 	ctx context.Context,
 	registryAddress ethkey.EIP55Address,
@@ -94,24 +110,46 @@ func (korm ORM) EligibleUpkeepsForRegistry(
 		Order("upkeep_registrations.id ASC, upkeep_registrations.upkeep_id ASC").
 		Joins("INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id").
 		Where(`
-			keeper_registries.contract_address = ? AND
+			keeper_registries.contract_address = @registryAddress AND
 			keeper_registries.num_keepers > 0 AND
-			(
-				upkeep_registrations.last_run_block_height = 0 OR (
-					upkeep_registrations.last_run_block_height + ? < ? AND
-					upkeep_registrations.last_run_block_height < (? - (? % keeper_registries.block_count_per_turn))
-				)
-			) AND
-			keeper_registries.keeper_index = (
-				upkeep_registrations.positioning_constant + ((? - (? % keeper_registries.block_count_per_turn)) / keeper_registries.block_count_per_turn)
-			) % keeper_registries.num_keepers
-		`, registryAddress, gracePeriod, blockNumber, blockNumber, blockNumber, blockNumber, blockNumber).
+			NOT `+performedWithinGracePeriodSQL+` AND
+			NOT `+performedThisTurnSQL+` AND
+			keeper_registries.keeper_index = `+responsibleKeeperIndexSQL+`
+		`, map[string]interface{}{
+			"registryAddress": registryAddress,
+			"blockNumber":     blockNumber,
+			"gracePeriod":     gracePeriod,
+		}).
 		Find(&upkeeps).
 		Error
 
 	return upkeeps, err
 }
 
+// UpkeepTurnForJob returns how the rules of EligibleUpkeepsForRegistry apply
+// to the upkeep with the given ID of the job's registry at the block
+func (korm ORM) UpkeepTurnForJob(ctx context.Context, jobID int32, upkeepID, blockNumber, gracePeriod int64) (UpkeepTurn, error) {
+	var turn UpkeepTurn
+	err := korm.getDB(ctx).
+		Raw(`SELECT
+			`+turnStartBlockSQL+` AS turn_start_block,
+			`+responsibleKeeperIndexSQL+` AS responsible_keeper_index,
+			`+performedWithinGracePeriodSQL+` AS performed_within_grace_period,
+			`+performedThisTurnSQL+` AS performed_this_turn
+		FROM upkeep_registrations
+		INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id
+		WHERE keeper_registries.job_id = @jobID AND upkeep_registrations.upkeep_id = @upkeepID
+		`, map[string]interface{}{
+			"jobID":       jobID,
+			"upkeepID":    upkeepID,
+			"blockNumber": blockNumber,
+			"gracePeriod": gracePeriod,
+		}).
+		Scan(&turn).
+		Error
+	return turn, err
+}
+
 // LowestUnsyncedID returns the largest upkeepID + 1, indicating the expected next upkeepID
 // to sync from the contract
 func (korm ORM) LowestUnsyncedID(ctx context.Context, regID int32) (int64, error) {
@@ -139,6 +177,47 @@ func (korm ORM) SetLastRunHeightForUpkeepOnJob(ctx context.Context, jobID int32,
 		).Error
 }
 
+// SetLastCheckResultForUpkeepOnJob records the result of checking, and trying
+// to perform, the upkeep. The performUpkeep transaction is found by the task
+// run which created it, and the last one is kept if none was created this
+// time.
+func (korm ORM) SetLastCheckResultForUpkeepOnJob(ctx context.Context, jobID int32, upkeepID int64, result UpkeepCheckResult) error {
+	return korm.getDB(ctx).
+		Exec(`UPDATE upkeep_registrations
+		SET last_check_block_number = ?,
+		last_check_outcome = ?,
+		last_check_error = ?,
+		last_check_revert_data = ?,
+		last_check_at = NOW(),
+		last_perform_eth_tx_id = COALESCE((
+			SELECT id FROM eth_txes WHERE pipeline_task_run_id = ?
+		), last_perform_eth_tx_id)
+		FROM keeper_registries
+		WHERE upkeep_registrations.registry_id = keeper_registries.id AND
+		keeper_registries.job_id = ? AND
+		upkeep_registrations.upkeep_id = ?;`,
+			result.BlockNumber,
+			string(result.Outcome),
+			result.Error,
+			result.RevertData,
+			result.PerformTaskRunID,
+			jobID,
+			upkeepID,
+		).Error
+}
+
+// UpkeepForJob returns the upkeep with the given ID of the job's registry
+func (korm ORM) UpkeepForJob(ctx context.Context, jobID int32, upkeepID int64) (UpkeepRegistration, error) {
+	var upkeep UpkeepRegistration
+	err := korm.getDB(ctx).
+		Preload("Registry").
+		Joins("INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id").
+		Where("keeper_registries.job_id = ? AND upkeep_registrations.upkeep_id = ?", jobID, upkeepID).
+		First(&upkeep).
+		Error
+	return upkeep, err
+}
+
 func (korm ORM) getDB(ctx context.Context) *gorm.DB {
 	return korm.DB.WithContext(ctx)
 }
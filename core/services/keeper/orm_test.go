@@ -7,8 +7,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 
 	evmconfig "github.com/smartcontractkit/chainlink/core/chains/evm/config"
@@ -217,6 +220,40 @@ func TestKeeperDB_EligibleUpkeeps_GracePeriod(t *testing.T) {
 	assert.Equal(t, int64(1), eligibleUpkeeps[1].UpkeepID)
 }
 
+func TestKeeperDB_UpkeepTurnForJob(t *testing.T) {
+	t.Parallel()
+	db, _, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+
+	gracePeriod := int64(10)
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, ethKeyStore)
+	registry.NumKeepers = 3
+	require.NoError(t, db.Save(&registry).Error)
+	upkeep := newUpkeep(registry, 0)
+	upkeep.PositioningConstant = 1
+	require.NoError(t, orm.UpsertUpkeep(context.Background(), &upkeep))
+
+	turn, err := orm.UpkeepTurnForJob(context.Background(), j.ID, upkeep.UpkeepID, 59, gracePeriod)
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), turn.TurnStartBlock)
+	assert.Equal(t, int32(0), turn.ResponsibleKeeperIndex)
+
+	// The turn explains exactly which blocks the upkeep is eligible at
+	for _, lastRun := range []int64{0, 41, 59, 61} {
+		require.NoError(t, orm.SetLastRunHeightForUpkeepOnJob(context.Background(), j.ID, upkeep.UpkeepID, lastRun))
+		for block := int64(0); block < 120; block++ {
+			turn, err := orm.UpkeepTurnForJob(context.Background(), j.ID, upkeep.UpkeepID, block, gracePeriod)
+			require.NoError(t, err)
+			eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, block, gracePeriod)
+			require.NoError(t, err)
+
+			explained := turn.ResponsibleKeeperIndex == registry.KeeperIndex && !turn.PerformedWithinGracePeriod && !turn.PerformedThisTurn
+			assert.Equal(t, explained, len(eligibleUpkeeps) == 1, "last run %d, block %d", lastRun, block)
+		}
+	}
+}
+
 func TestKeeperDB_EligibleUpkeeps_KeepersRotate(t *testing.T) {
 	t.Parallel()
 	db, config, orm := setupKeeperDB(t)
@@ -344,3 +381,54 @@ func TestKeeperDB_SetLastRunHeightForUpkeepOnJob(t *testing.T) {
 	orm.SetLastRunHeightForUpkeepOnJob(context.Background(), j.ID, upkeep.UpkeepID, 0)
 	assertLastRunHeight(t, db, upkeep, 0)
 }
+
+func TestKeeperDB_SetLastCheckResultForUpkeepOnJob(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, ethKeyStore)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	etx := cltest.NewEthTx(t, registry.FromAddress.Address())
+	etx.ToAddress = registry.ContractAddress.Address()
+	etx.PipelineTaskRunID = uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
+	require.NoError(t, db.Save(&etx).Error)
+
+	err := orm.SetLastCheckResultForUpkeepOnJob(context.Background(), j.ID, upkeep.UpkeepID, keeper.UpkeepCheckResult{
+		BlockNumber:      100,
+		Outcome:          keeper.UpkeepCheckPerformed,
+		PerformTaskRunID: etx.PipelineTaskRunID,
+	})
+	require.NoError(t, err)
+
+	upkeep, err = orm.UpkeepForJob(context.Background(), j.ID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.Equal(t, registry.ContractAddress, upkeep.Registry.ContractAddress)
+	assert.Equal(t, int64(100), upkeep.LastCheckBlockNumber.Int64)
+	assert.Equal(t, string(keeper.UpkeepCheckPerformed), upkeep.LastCheckOutcome.String)
+	assert.False(t, upkeep.LastCheckError.Valid)
+	assert.Empty(t, upkeep.LastCheckRevertData)
+	assert.True(t, upkeep.LastCheckAt.Valid)
+	assert.Equal(t, etx.ID, upkeep.LastPerformEthTxID.Int64)
+
+	// The last perform transaction is kept when the next check fails
+	err = orm.SetLastCheckResultForUpkeepOnJob(context.Background(), j.ID, upkeep.UpkeepID, keeper.UpkeepCheckResult{
+		BlockNumber: 120,
+		Outcome:     keeper.UpkeepCheckFailed,
+		Error:       null.StringFrom("execution reverted: upkeep not needed"),
+		RevertData:  []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+
+	upkeep, err = orm.UpkeepForJob(context.Background(), j.ID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), upkeep.LastCheckBlockNumber.Int64)
+	assert.Equal(t, string(keeper.UpkeepCheckFailed), upkeep.LastCheckOutcome.String)
+	assert.Equal(t, "execution reverted: upkeep not needed", upkeep.LastCheckError.String)
+	assert.Equal(t, []byte{1, 2, 3}, upkeep.LastCheckRevertData)
+	assert.Equal(t, etx.ID, upkeep.LastPerformEthTxID.Int64)
+
+	_, err = orm.UpkeepForJob(context.Background(), j.ID+1, upkeep.UpkeepID)
+	assert.Equal(t, gorm.ErrRecordNotFound, errors.Cause(err))
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -188,6 +190,11 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 	gasPrice, fee, err := ex.estimateGasPrice(upkeep)
 	if err != nil {
 		svcLogger.Error(errors.Wrap(err, "estimating gas price"))
+		ex.setLastCheckResult(ctxService, upkeep, UpkeepCheckResult{
+			BlockNumber: headNumber,
+			Outcome:     UpkeepCheckGasEstimationFailed,
+			Error:       null.StringFrom(err.Error()),
+		})
 		return
 	}
 
@@ -209,8 +216,14 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 	run := pipeline.NewRun(*ex.job.PipelineSpec, vars)
 	if _, err := ex.pr.Run(ctxService, &run, ex.logger, true, nil); err != nil {
 		ex.logger.With("error", err).Errorw("failed executing run")
+		ex.setLastCheckResult(ctxService, upkeep, UpkeepCheckResult{
+			BlockNumber: headNumber,
+			Outcome:     UpkeepCheckRunFailed,
+			Error:       null.StringFrom(err.Error()),
+		})
 		return
 	}
+	ex.setLastCheckResult(ctxService, upkeep, newUpkeepCheckResult(run, headNumber))
 
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
@@ -226,6 +239,46 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 	}
 }
 
+// setLastCheckResult records the outcome of checking the upkeep, so that it
+// can be diagnosed why it was performed or not
+func (ex *UpkeepExecuter) setLastCheckResult(ctx context.Context, upkeep UpkeepRegistration, result UpkeepCheckResult) {
+	err := ex.orm.SetLastCheckResultForUpkeepOnJob(ctx, ex.job.ID, upkeep.UpkeepID, result)
+	if err != nil {
+		ex.logger.With("error", err).Errorw("failed to set last check result for upkeep")
+	}
+}
+
+// newUpkeepCheckResult returns the outcome of the pipeline run which checked,
+// and possibly performed, the upkeep
+func newUpkeepCheckResult(run pipeline.Run, headNumber int64) UpkeepCheckResult {
+	result := UpkeepCheckResult{BlockNumber: headNumber}
+	if run.State == pipeline.RunStatusCompleted {
+		result.Outcome = UpkeepCheckPerformed
+		// The ethtx task saves its task run ID on the transaction
+		if tr := run.ByDotID("perform_upkeep_tx"); tr != nil {
+			result.PerformTaskRunID = uuid.NullUUID{UUID: tr.ID, Valid: true}
+		}
+		return result
+	}
+
+	// The revert reason and revert data of checkUpkeep are in the error of
+	// the eth call
+	if tr := run.ByDotID("check_upkeep_tx"); tr != nil && tr.Error.Valid {
+		result.Outcome = UpkeepCheckFailed
+		result.Error = tr.Error
+		result.RevertData = pipeline.RevertDataFromError(tr.Error.String)
+		return result
+	}
+	result.Outcome = UpkeepCheckPerformFailed
+	for _, tr := range run.PipelineTaskRuns {
+		if tr.Error.Valid {
+			result.Error = tr.Error
+			break
+		}
+	}
+	return result
+}
+
 func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
 	var performTxData []byte
 	performTxData, err = RegistryABI.Pack(
@@ -133,6 +133,9 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 		assert.False(t, runs[0].HasErrors())
 		assert.False(t, runs[0].HasFatalErrors())
 		waitLastRunHeight(t, db, upkeep, 20)
+		require.NoError(t, db.Find(&upkeep).Error)
+		assert.Equal(t, string(keeper.UpkeepCheckPerformed), upkeep.LastCheckOutcome.String)
+		assert.Equal(t, int64(20), upkeep.LastCheckBlockNumber.Int64)
 
 		ethMock.AssertExpectations(t)
 		txm.AssertExpectations(t)
@@ -199,7 +202,7 @@ func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewGomegaWithT(t)
 
-	db, _, ethMock, executer, registry, _, _, _, _ := setup(t)
+	db, _, ethMock, executer, registry, upkeep, _, _, _ := setup(t)
 
 	wasCalled := atomic.NewBool(false)
 	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
@@ -212,5 +215,11 @@ func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 
 	g.Eventually(wasCalled.Load).Should(gomega.Equal(true))
 	cltest.AssertCountStays(t, db, bulletprooftxmanager.EthTx{}, 0)
+	g.Eventually(func() string {
+		require.NoError(t, db.Find(&upkeep).Error)
+		return upkeep.LastCheckOutcome.String
+	}).Should(gomega.Equal(string(keeper.UpkeepCheckFailed)))
+	assert.Equal(t, int64(20), upkeep.LastCheckBlockNumber.Int64)
+	assert.True(t, upkeep.LastCheckError.Valid)
 	ethMock.AssertExpectations(t)
 }
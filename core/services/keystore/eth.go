@@ -1,6 +1,8 @@
 package keystore
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -42,10 +44,13 @@ type Eth interface {
 	GetStatesForChain(chainID *big.Int) ([]ethkey.State, error)
 
 	GetV1KeysAsV2(chainID *big.Int) ([]ethkey.KeyV2, []ethkey.State, error)
+
+	AddRemoteSigner(signer RemoteSigner, chainID *big.Int) error
 }
 
 type eth struct {
 	*keyManager
+	remoteSigners map[common.Address]RemoteSigner
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
 }
@@ -55,6 +60,7 @@ var _ Eth = &eth{}
 func newEthKeyStore(km *keyManager) *eth {
 	return &eth{
 		keyManager:    km,
+		remoteSigners: make(map[common.Address]RemoteSigner),
 		subscribers:   make([](chan struct{}), 0),
 		subscribersMu: new(sync.RWMutex),
 	}
@@ -238,6 +244,12 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 }
 
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if signer, isRemote := ks.getRemoteSigner(address); isRemote {
+		ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+		defer cancel()
+		return signer.SignTx(ctx, address, tx, chainID)
+	}
+
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
@@ -276,30 +288,30 @@ func (ks *eth) GetRoundRobinAddress(whitelist ...common.Address) (common.Address
 		return common.Address{}, ErrLocked
 	}
 
-	var keys []ethkey.KeyV2
+	var addresses []common.Address
 	if len(whitelist) == 0 {
-		keys = ks.sendingKeys()
+		addresses = ks.sendingAddresses()
 	} else if len(whitelist) > 0 {
-		for _, k := range ks.sendingKeys() {
+		for _, address := range ks.sendingAddresses() {
 			for _, addr := range whitelist {
-				if addr == k.Address.Address() {
-					keys = append(keys, k)
+				if addr == address {
+					addresses = append(addresses, address)
 				}
 			}
 		}
 	}
 
-	if len(keys) == 0 {
+	if len(addresses) == 0 {
 		return common.Address{}, errors.New("no keys available")
 	}
 
-	sort.SliceStable(keys, func(i, j int) bool {
-		return ks.keyStates.Eth[keys[i].ID()].LastUsed().Before(ks.keyStates.Eth[keys[j].ID()].LastUsed())
+	sort.SliceStable(addresses, func(i, j int) bool {
+		return ks.keyStates.Eth[addresses[i].Hex()].LastUsed().Before(ks.keyStates.Eth[addresses[j].Hex()].LastUsed())
 	})
 
-	leastRecentlyUsed := keys[0]
-	ks.keyStates.Eth[leastRecentlyUsed.ID()].WasUsed()
-	return leastRecentlyUsed.Address.Address(), nil
+	leastRecentlyUsed := addresses[0]
+	ks.keyStates.Eth[leastRecentlyUsed.Hex()].WasUsed()
+	return leastRecentlyUsed, nil
 }
 
 func (ks *eth) GetState(id string) (ethkey.State, error) {
@@ -351,6 +363,9 @@ func (ks *eth) GetStatesForChain(chainID *big.Int) (states []ethkey.State, err e
 		return nil, ErrLocked
 	}
 	for _, s := range ks.keyStates.Eth {
+		if !ks.isUsable(*s) {
+			continue
+		}
 		if s.EVMChainID.Equal(utils.NewBig(chainID)) {
 			states = append(states, *s)
 		}
@@ -358,6 +373,40 @@ func (ks *eth) GetStatesForChain(chainID *big.Int) (states []ethkey.State, err e
 	return
 }
 
+// AddRemoteSigner tracks the state of the addresses of the remote signer on
+// the given chain, and delegates the signing of their transactions to it. The
+// keys of these addresses must not be in the keystore.
+func (ks *eth) AddRemoteSigner(signer RemoteSigner, chainID *big.Int) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	for _, address := range signer.Addresses() {
+		if _, found := ks.keyRing.Eth[address.Hex()]; found {
+			return errors.Errorf("key with ID %s is in the keystore", address.Hex())
+		}
+		if _, found := ks.remoteSigners[address]; found {
+			return errors.Errorf("address %s already has a remote signer", address.Hex())
+		}
+	}
+	for _, address := range signer.Addresses() {
+		if _, found := ks.keyStates.Eth[address.Hex()]; !found {
+			state := ethkey.State{Address: ethkey.EIP55AddressFromAddress(address), EVMChainID: *utils.NewBig(chainID)}
+			sql := `INSERT INTO eth_key_states (address, next_nonce, is_funding, evm_chain_id, created_at, updated_at)
+VALUES (:address, :next_nonce, :is_funding, :evm_chain_id, NOW(), NOW())
+RETURNING *;`
+			if err := postgres.NewQ(ks.orm.db).GetNamed(sql, &state, state); err != nil {
+				return errors.Wrap(err, "failed to insert eth_key_state")
+			}
+			ks.keyStates.Eth[address.Hex()] = &state
+		}
+		ks.remoteSigners[address] = signer
+	}
+	ks.notify()
+	return nil
+}
+
 func (ks *eth) GetV1KeysAsV2(chainID *big.Int) (keys []ethkey.KeyV2, states []ethkey.State, _ error) {
 	v1Keys, err := ks.orm.GetEncryptedV1EthKeys()
 	if err != nil {
@@ -390,6 +439,26 @@ func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 	return key, nil
 }
 
+func (ks *eth) getRemoteSigner(address common.Address) (RemoteSigner, bool) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	signer, isRemote := ks.remoteSigners[address]
+	return signer, isRemote
+}
+
+// isUsable returns true if the key of the state is in the keystore, or if its
+// address has a remote signer. The states of addresses which had a remote
+// signer in the past are kept, but not used.
+//
+// caller must hold lock!
+func (ks *eth) isUsable(state ethkey.State) bool {
+	if _, found := ks.keyRing.Eth[state.KeyID()]; found {
+		return true
+	}
+	_, isRemote := ks.remoteSigners[state.Address.Address()]
+	return isRemote
+}
+
 // caller must hold lock!
 func (ks *eth) fundingKeys() (fundingKeys []ethkey.KeyV2) {
 	for _, k := range ks.keyRing.Eth {
@@ -412,6 +481,24 @@ func (ks *eth) sendingKeys() (sendingKeys []ethkey.KeyV2) {
 	return sendingKeys
 }
 
+// sendingAddresses returns the addresses of the sending keys, followed by
+// those of the remote signers
+//
+// caller must hold lock!
+func (ks *eth) sendingAddresses() (addresses []common.Address) {
+	for _, k := range ks.sendingKeys() {
+		addresses = append(addresses, k.Address.Address())
+	}
+	var remote []common.Address
+	for address := range ks.remoteSigners {
+		if !ks.keyStates.Eth[address.Hex()].IsFunding {
+			remote = append(remote, address)
+		}
+	}
+	sort.Slice(remote, func(i, j int) bool { return bytes.Compare(remote[i].Bytes(), remote[j].Bytes()) < 0 })
+	return append(addresses, remote...)
+}
+
 // caller must hold lock!
 func (ks *eth) add(key ethkey.KeyV2, chainID *big.Int) error {
 	return ks.addEthKeyWithState(key, ethkey.State{EVMChainID: *utils.NewBig(chainID)})
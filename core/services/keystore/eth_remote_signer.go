@@ -0,0 +1,144 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name RemoteSigner --output mocks/ --case=underscore
+
+// RemoteSigner signs the transactions of a fixed set of addresses, whose
+// private keys are held outside of the keystore, e.g. in an HSM
+type RemoteSigner interface {
+	// Addresses is the allow-list of addresses which the signer signs for
+	Addresses() []common.Address
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// remoteSignerTimeout bounds the time taken by a remote signer to sign a
+// transaction
+const remoteSignerTimeout = 30 * time.Second
+
+type jsonRPCSigner struct {
+	client    *rpc.Client
+	addresses []common.Address
+}
+
+var _ RemoteSigner = &jsonRPCSigner{}
+
+// NewJSONRPCSigner returns a RemoteSigner for the given addresses which
+// delegates signing to the eth_signTransaction method of a JSON-RPC server
+// over HTTP, as implemented by Clef (with its --rpc flag) or web3signer
+func NewJSONRPCSigner(url *url.URL, addresses []common.Address) (RemoteSigner, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("remote signer has no addresses")
+	}
+	client, err := rpc.DialHTTP(url.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial remote signer")
+	}
+	return &jsonRPCSigner{client, addresses}, nil
+}
+
+func (s *jsonRPCSigner) Addresses() []common.Address {
+	return s.addresses
+}
+
+// signTransactionArgs are the arguments of eth_signTransaction
+type signTransactionArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+func (s *jsonRPCSigner) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := signTransactionArgs{
+		From:    fromAddress,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	// Clef and geth return the signed transaction as {"raw": ..., "tx": ...},
+	// web3signer as the raw transaction itself
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "eth_signTransaction failed")
+	}
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var signed struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(result, &signed); err != nil {
+			return nil, errors.Wrap(err, "eth_signTransaction returned an invalid result")
+		}
+		raw = signed.Raw
+	}
+
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "eth_signTransaction returned an invalid transaction")
+	}
+	if err := checkRemotelySignedTx(fromAddress, tx, signedTx, chainID); err != nil {
+		return nil, errors.Wrap(err, "eth_signTransaction returned a different transaction")
+	}
+	return signedTx, nil
+}
+
+// checkRemotelySignedTx makes sure that a remote signer signed the transaction
+// it was given, with the key of the given address
+func checkRemotelySignedTx(fromAddress common.Address, tx, signedTx *types.Transaction, chainID *big.Int) error {
+	switch {
+	case signedTx.Type() != tx.Type():
+		return errors.Errorf("type %v is not %v", signedTx.Type(), tx.Type())
+	case signedTx.Nonce() != tx.Nonce():
+		return errors.Errorf("nonce %v is not %v", signedTx.Nonce(), tx.Nonce())
+	case (signedTx.To() == nil) != (tx.To() == nil) || (tx.To() != nil && *signedTx.To() != *tx.To()):
+		return errors.Errorf("to address %v is not %v", signedTx.To(), tx.To())
+	case signedTx.Value().Cmp(tx.Value()) != 0:
+		return errors.Errorf("value %v is not %v", signedTx.Value(), tx.Value())
+	case signedTx.Gas() != tx.Gas():
+		return errors.Errorf("gas limit %v is not %v", signedTx.Gas(), tx.Gas())
+	case signedTx.GasPrice().Cmp(tx.GasPrice()) != 0:
+		return errors.Errorf("gas price %v is not %v", signedTx.GasPrice(), tx.GasPrice())
+	case signedTx.GasTipCap().Cmp(tx.GasTipCap()) != 0:
+		return errors.Errorf("gas tip cap %v is not %v", signedTx.GasTipCap(), tx.GasTipCap())
+	case !bytes.Equal(signedTx.Data(), tx.Data()):
+		return errors.New("data differs")
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature")
+	}
+	if sender != fromAddress {
+		return errors.Errorf("signed by %v, not %v", sender.Hex(), fromAddress.Hex())
+	}
+	return nil
+}
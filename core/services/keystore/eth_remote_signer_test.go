@@ -0,0 +1,119 @@
+package keystore_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+func Test_JSONRPCSigner_SignTx(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	from := key.Address.Address()
+	chainID := big.NewInt(1337)
+	to := cltest.NewAddress()
+
+	legacyTx := types.NewTransaction(3, to, big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+	dynamicFeeTx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     3,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(2000000000),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(53),
+		Data:      []byte{1, 2, 3, 4},
+	})
+
+	for _, test := range []struct {
+		name      string
+		tx        *types.Transaction
+		gethStyle bool
+	}{
+		{"legacy transaction, web3signer style", legacyTx, false},
+		{"legacy transaction, geth style", legacyTx, true},
+		{"dynamic fee transaction, web3signer style", dynamicFeeTx, false},
+		{"dynamic fee transaction, geth style", dynamicFeeTx, true},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			remote := cltest.NewMockRemoteSigner(t, key)
+			remote.GethStyle = test.gethStyle
+			signer, err := keystore.NewJSONRPCSigner(remote.URL, []common.Address{from})
+			require.NoError(t, err)
+
+			signed, err := signer.SignTx(context.Background(), from, test.tx, chainID)
+			require.NoError(t, err)
+
+			expected, err := types.SignTx(test.tx, types.LatestSignerForChainID(chainID), key.ToEcdsaPrivKey())
+			require.NoError(t, err)
+			assert.Equal(t, expected.Hash(), signed.Hash())
+		})
+	}
+
+	t.Run("returns the error of the signer", func(t *testing.T) {
+		t.Parallel()
+
+		remote := cltest.NewMockRemoteSigner(t)
+		signer, err := keystore.NewJSONRPCSigner(remote.URL, []common.Address{from})
+		require.NoError(t, err)
+
+		_, err = signer.SignTx(context.Background(), from, legacyTx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown account")
+	})
+
+	t.Run("rejects a different transaction than the one to sign", func(t *testing.T) {
+		t.Parallel()
+
+		remote := cltest.NewMockRemoteSigner(t, key)
+		remote.Tamper = func(args *cltest.RemoteSignerArgs) {
+			args.Value = (*hexutil.Big)(big.NewInt(1000000))
+		}
+		signer, err := keystore.NewJSONRPCSigner(remote.URL, []common.Address{from})
+		require.NoError(t, err)
+
+		_, err = signer.SignTx(context.Background(), from, legacyTx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "value 1000000 is not 53")
+	})
+
+	t.Run("rejects a transaction signed by another key", func(t *testing.T) {
+		t.Parallel()
+
+		other, err := ethkey.NewV2()
+		require.NoError(t, err)
+		remote := cltest.NewMockRemoteSigner(t, key, other)
+		remote.Tamper = func(args *cltest.RemoteSignerArgs) {
+			args.From = other.Address.Address()
+		}
+		signer, err := keystore.NewJSONRPCSigner(remote.URL, []common.Address{from})
+		require.NoError(t, err)
+
+		_, err = signer.SignTx(context.Background(), from, legacyTx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "signed by "+other.Address.Hex())
+	})
+
+	t.Run("requires addresses", func(t *testing.T) {
+		t.Parallel()
+
+		remote := cltest.NewMockRemoteSigner(t, key)
+		_, err := keystore.NewJSONRPCSigner(remote.URL, nil)
+		require.Error(t, err)
+	})
+}
@@ -192,6 +192,91 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	localKey, _ := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	remoteKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	remoteAddress := remoteKey.Address.Address()
+
+	remote := cltest.NewMockRemoteSigner(t, remoteKey)
+	signer, err := keystore.NewJSONRPCSigner(remote.URL, []common.Address{remoteAddress})
+	require.NoError(t, err)
+	require.NoError(t, ethKeyStore.AddRemoteSigner(signer, &cltest.FixtureChainID))
+
+	t.Run("tracks the state of the remote addresses", func(t *testing.T) {
+		states, err := ethKeyStore.GetStatesForChain(&cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, states, 2)
+		var addresses []common.Address
+		for _, state := range states {
+			addresses = append(addresses, state.Address.Address())
+		}
+		require.Contains(t, addresses, remoteAddress)
+
+		state, err := ethKeyStore.GetState(remoteAddress.Hex())
+		require.NoError(t, err)
+		require.Equal(t, int64(0), state.NextNonce)
+		require.False(t, state.IsFunding)
+	})
+
+	t.Run("signs the transactions of remote addresses remotely", func(t *testing.T) {
+		chainID := big.NewInt(eth.NullClientChainID)
+		tx := types.NewTransaction(0, cltest.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+
+		signed, err := ethKeyStore.SignTx(remoteAddress, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		require.Equal(t, remoteAddress, sender)
+
+		signed, err = ethKeyStore.SignTx(localKey.Address.Address(), tx, chainID)
+		require.NoError(t, err)
+		sender, err = types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		require.Equal(t, localKey.Address.Address(), sender)
+	})
+
+	t.Run("rotates between local and remote sending addresses", func(t *testing.T) {
+		address1, err := ethKeyStore.GetRoundRobinAddress()
+		require.NoError(t, err)
+		address2, err := ethKeyStore.GetRoundRobinAddress()
+		require.NoError(t, err)
+		require.ElementsMatch(t, []common.Address{localKey.Address.Address(), remoteAddress}, []common.Address{address1, address2})
+
+		address, err := ethKeyStore.GetRoundRobinAddress(remoteAddress)
+		require.NoError(t, err)
+		require.Equal(t, remoteAddress, address)
+	})
+
+	t.Run("rejects addresses which already have a signer", func(t *testing.T) {
+		err := ethKeyStore.AddRemoteSigner(signer, &cltest.FixtureChainID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "already has a remote signer")
+
+		local, err := keystore.NewJSONRPCSigner(remote.URL, []common.Address{localKey.Address.Address()})
+		require.NoError(t, err)
+		err = ethKeyStore.AddRemoteSigner(local, &cltest.FixtureChainID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is in the keystore")
+	})
+
+	t.Run("ignores the state of remote addresses without a signer", func(t *testing.T) {
+		restarted := keystore.ExposedNewMaster(t, db)
+		require.NoError(t, restarted.Unlock(cltest.Password))
+
+		states, err := restarted.Eth().GetStatesForChain(&cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, states, 1)
+		require.Equal(t, localKey.Address, states[0].Address)
+	})
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
@@ -14,7 +14,9 @@ type State struct {
 	EVMChainID utils.Big `gorm:"column:evm_chain_id"`
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
-	lastUsed   time.Time
+	// BudgetTrippedAt is set while the key has exceeded its spending budget
+	BudgetTrippedAt *time.Time
+	lastUsed        time.Time
 }
 
 func (State) TableName() string {
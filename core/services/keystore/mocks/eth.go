@@ -8,6 +8,8 @@ import (
 	common "github.com/ethereum/go-ethereum/common"
 	ethkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 
+	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/ethereum/go-ethereum/core/types"
@@ -32,6 +34,20 @@ func (_m *Eth) Add(key ethkey.KeyV2, chainID *big.Int) error {
 	return r0
 }
 
+// AddRemoteSigner provides a mock function with given fields: signer, chainID
+func (_m *Eth) AddRemoteSigner(signer keystore.RemoteSigner, chainID *big.Int) error {
+	ret := _m.Called(signer, chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(keystore.RemoteSigner, *big.Int) error); ok {
+		r0 = rf(signer, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: chainID
 func (_m *Eth) Create(chainID *big.Int) (ethkey.KeyV2, error) {
 	ret := _m.Called(chainID)
@@ -2,6 +2,7 @@ package log
 
 import (
 	"context"
+	"math"
 	"math/big"
 	"sync"
 	"time"
@@ -43,11 +44,20 @@ type (
 	// then it's possible/likely that the backfill fill only have depth: 1 (from latest head)
 	//
 	// Of course, these backfilled logs + any new logs will only be sent after the NumConfirmations for given subscriber.
+	//
+	// ReplayJob replays the logs of a block range to the subscribers of a single job only, fetching them with FilterLogs.
+	//
+	// Subscribers registered with ListenerOpts.Persistent receive their logs from the log poller instead, which
+	// persists the logs of each job with a cursor of its own, so that no logs are missed however long the node is down.
 	Broadcaster interface {
 		utils.DependentAwaiter
 		service.Service
 		httypes.HeadTrackable
 		ReplayFromBlock(number int64)
+		// ReplayJob starts replaying logs to the listeners of the job only, and returns the replay to track its progress
+		ReplayJob(opts JobReplayOpts) (JobReplay, error)
+		// JobReplays returns the progress of the latest job replays, of jobID only if it is set, latest first
+		JobReplays(jobID *int32) ([]JobReplay, error)
 
 		IsConnected() bool
 		Register(listener Listener, opts ListenerOpts) (unsubscribe func())
@@ -55,6 +65,12 @@ type (
 		WasAlreadyConsumed(lb Broadcast, qopts ...postgres.QOpt) (bool, error)
 		MarkConsumed(lb Broadcast, qopts ...postgres.QOpt) error
 		// NOTE: WasAlreadyConsumed and MarkConsumed MUST be used within a single goroutine in order for WasAlreadyConsumed to be accurate
+
+		// LogsByBlockRange returns the logs of the contract's events persisted by the log poller, between fromBlock and toBlock inclusive
+		LogsByBlockRange(contract common.Address, eventSigs []common.Hash, fromBlock, toBlock int64, qopts ...postgres.QOpt) ([]types.Log, error)
+		// UnconsumedLogs returns up to limit logs persisted by the log poller for the job's filter on the contract, after the given
+		// position, which the job has not consumed yet. Pass FirstLogPosition, then the position of the last log returned, to page through them.
+		UnconsumedLogs(jobID int32, contract common.Address, after LogPosition, limit int, qopts ...postgres.QOpt) ([]types.Log, error)
 	}
 
 	BroadcasterInTest interface {
@@ -80,6 +96,8 @@ type (
 		ethSubscriber *ethSubscriber
 		registrations *registrations
 		logPool       *logPool
+		logPoller     *logPoller
+		jobReplayer   *jobReplayer
 
 		addSubscriber *utils.Mailbox
 		rmSubscriber  *utils.Mailbox
@@ -104,7 +122,9 @@ type (
 		BlockBackfillDepth() uint64
 		BlockBackfillSkip() bool
 		EvmFinalityDepth() uint32
+		EvmFinalityTagsEnabled() bool
 		EvmLogBackfillBatchSize() uint32
+		EvmPollInterval() time.Duration
 	}
 
 	ListenerOpts struct {
@@ -119,6 +139,18 @@ type (
 
 		// Minimum number of block confirmations before the log is received
 		NumConfirmations uint64
+
+		// ConfirmationTag, if set, is used instead of NumConfirmations: the log
+		// is received once its block is at or below the latest block with the
+		// tag. If the chain does not have finality tags enabled, the log is
+		// received after EvmFinalityDepth confirmations instead.
+		ConfirmationTag eth.BlockTag
+
+		// Persistent, if set, has the logs delivered by the log poller, which
+		// persists them with a cursor per job and contract. Logs the listener
+		// has not consumed are delivered again after a restart, however long
+		// the node was down.
+		Persistent bool
 	}
 
 	ParseLogFunc func(log types.Log) (generated.AbigenLog, error)
@@ -143,9 +175,11 @@ func NewBroadcaster(orm ORM, ethClient eth.Client, config Config, logger logger.
 		logger:           logger,
 		connected:        abool.New(),
 		evmChainID:       *ethClient.ChainID(),
-		ethSubscriber:    newEthSubscriber(ethClient, config, logger, chStop),
+		ethSubscriber:    newEthSubscriber(ethClient, orm, config, logger, chStop),
 		registrations:    newRegistrations(logger, *ethClient.ChainID()),
 		logPool:          newLogPool(),
+		logPoller:        newLogPoller(orm, ethClient, config, logger, chStop),
+		jobReplayer:      newJobReplayer(ethClient, orm, config, logger, chStop),
 		addSubscriber:    utils.NewMailbox(0),
 		rmSubscriber:     utils.NewMailbox(0),
 		newHeads:         utils.NewMailbox(1),
@@ -158,8 +192,13 @@ func NewBroadcaster(orm ORM, ethClient eth.Client, config Config, logger logger.
 
 func (b *broadcaster) Start() error {
 	return b.StartOnce("LogBroadcaster", func() error {
-		b.wgDone.Add(2)
+		b.jobReplayer.start()
+		b.wgDone.Add(3)
 		go b.awaitInitialSubscribers()
+		go func() {
+			defer b.wgDone.Done()
+			b.logPoller.run()
+		}()
 		return nil
 	})
 }
@@ -172,10 +211,23 @@ func (b *broadcaster) ReplayFromBlock(number int64) {
 	}
 }
 
+// ReplayJob starts replaying logs to the listeners of the job, without
+// affecting those of other jobs
+func (b *broadcaster) ReplayJob(opts JobReplayOpts) (JobReplay, error) {
+	return b.jobReplayer.replay(opts)
+}
+
+// JobReplays returns the progress of the latest job replays, of jobID only if
+// it is set, latest first
+func (b *broadcaster) JobReplays(jobID *int32) ([]JobReplay, error) {
+	return b.jobReplayer.jobReplays(jobID)
+}
+
 func (b *broadcaster) Close() error {
 	return b.StopOnce("LogBroadcaster", func() error {
 		close(b.chStop)
 		b.wgDone.Wait()
+		b.jobReplayer.stop()
 		return nil
 	})
 }
@@ -208,6 +260,23 @@ func (b *broadcaster) Register(listener Listener, opts ListenerOpts) (unsubscrib
 	if len(opts.LogsWithTopics) == 0 {
 		b.logger.Fatal("LogBroadcaster: Must supply at least 1 LogsWithTopics element to Register")
 	}
+	if opts.ConfirmationTag != "" {
+		if !opts.ConfirmationTag.IsValid() {
+			b.logger.Fatalf("LogBroadcaster: Unsupported confirmation tag %q", opts.ConfirmationTag)
+		}
+		if !b.config.EvmFinalityTagsEnabled() {
+			opts.NumConfirmations = uint64(b.config.EvmFinalityDepth())
+			opts.ConfirmationTag = ""
+		}
+	}
+	unregisterReplay := b.jobReplayer.register(listener, opts)
+	if opts.Persistent {
+		unsubscribe := b.logPoller.register(listener, opts)
+		return func() {
+			unregisterReplay()
+			unsubscribe()
+		}
+	}
 
 	reg := registration{listener, opts}
 	wasOverCapacity := b.addSubscriber.Deliver(reg)
@@ -215,6 +284,7 @@ func (b *broadcaster) Register(listener Listener, opts ListenerOpts) (unsubscrib
 		b.logger.Error("LogBroadcaster: Subscription mailbox is over capacity - dropped the oldest unprocessed subscription")
 	}
 	return func() {
+		unregisterReplay()
 		wasOverCapacity := b.rmSubscriber.Deliver(reg)
 		if wasOverCapacity {
 			b.logger.Error("LogBroadcaster: Subscription removal mailbox is over capacity - dropped the oldest unprocessed removal")
@@ -223,6 +293,7 @@ func (b *broadcaster) Register(listener Listener, opts ListenerOpts) (unsubscrib
 }
 
 func (b *broadcaster) OnNewLongestChain(ctx context.Context, head eth.Head) {
+	b.logPoller.onNewLongestChain(head)
 	wasOverCapacity := b.newHeads.Deliver(head)
 	if wasOverCapacity {
 		b.logger.Debugw("LogBroadcaster: TRACE: Dropped the older head in the mailbox, while inserting latest (which is fine)", "latestBlockNumber", head.Number)
@@ -252,11 +323,17 @@ func (b *broadcaster) startResubscribeLoop() {
 		// The backfill needs to start at an earlier block than the one last saved in DB, to account for:
 		// - keeping logs in the in-memory buffers in registration.go
 		//   (which will be lost on node restart) for MAX(NumConfirmations of subscribers)
+		//   or, for listeners waiting for a tagged block, EvmFinalityDepth as the
+		//   tagged blocks are not known until the first head arrives
 		// - HeadTracker saving the heads to DB asynchronously versus LogBroadcaster, where a head
 		//   (or more heads on fast chains) may be saved but not yet processed by LB
 		//   using BlockBackfillDepth makes sure the backfill will be dependent on the per-chain configuration
+		numConfirmations := int64(b.registrations.highestNumConfirmations)
+		if b.registrations.hasTaggedSubscribers() && int64(b.config.EvmFinalityDepth()) > numConfirmations {
+			numConfirmations = int64(b.config.EvmFinalityDepth())
+		}
 		from := b.highestSavedHead.Number -
-			int64(b.registrations.highestNumConfirmations) -
+			numConfirmations -
 			int64(b.config.BlockBackfillDepth())
 		if from < 0 {
 			from = 0
@@ -453,6 +530,11 @@ func (b *broadcaster) onNewHeads() {
 
 		latestBlockNum := latestHead.Number
 		keptDepth := latestBlockNum - int64(keptLogsDepth)
+		// logs are kept until they reach the tagged blocks, which may be
+		// further behind than the kept depth
+		if lowest, exists := b.registrations.lowestTaggedBlockNumber(*latestHead); exists && lowest < keptDepth {
+			keptDepth = lowest
+		}
 		if keptDepth < 0 {
 			keptDepth = 0
 		}
@@ -462,7 +544,7 @@ func (b *broadcaster) onNewHeads() {
 
 		// if all subscribers requested 0 confirmations, we always get and delete all logs from the pool,
 		// without comparing their block numbers to the current head's block number.
-		if b.registrations.highestNumConfirmations == 0 {
+		if b.registrations.highestNumConfirmations == 0 && !b.registrations.hasTaggedSubscribers() {
 			logs, lowest, highest := b.logPool.getAndDeleteAll()
 			if len(logs) > 0 {
 				broadcasts, err := b.orm.FindBroadcasts(lowest, highest)
@@ -506,7 +588,7 @@ func (b *broadcaster) onAddSubscribers() (needsResubscribe bool) {
 			b.logger.Errorf("expected `registration`, got %T", x)
 			continue
 		}
-		b.logger.Debugw("LogBroadcaster: Subscribing listener", "requiredBlockConfirmations", reg.opts.NumConfirmations, "address", reg.opts.Contract)
+		b.logger.Debugw("LogBroadcaster: Subscribing listener", "requiredBlockConfirmations", reg.opts.NumConfirmations, "confirmationTag", reg.opts.ConfirmationTag, "address", reg.opts.Contract)
 		needsResub := b.registrations.addSubscriber(reg)
 		if needsResub {
 			needsResubscribe = true
@@ -526,7 +608,7 @@ func (b *broadcaster) onRmSubscribers() (needsResubscribe bool) {
 			b.logger.Errorf("expected `registration`, got %T", x)
 			continue
 		}
-		b.logger.Debugw("LogBroadcaster: Unsubscribing listener", "requiredBlockConfirmations", reg.opts.NumConfirmations, "address", reg.opts.Contract)
+		b.logger.Debugw("LogBroadcaster: Unsubscribing listener", "requiredBlockConfirmations", reg.opts.NumConfirmations, "confirmationTag", reg.opts.ConfirmationTag, "address", reg.opts.Contract)
 		needsResub := b.registrations.removeSubscriber(reg)
 		if needsResub {
 			needsResubscribe = true
@@ -583,6 +665,10 @@ func (b *broadcaster) maybeWarnOnLargeBlockNumberDifference(logBlockNumber int64
 
 // WasAlreadyConsumed reports whether the given consumer had already consumed the given log
 func (b *broadcaster) WasAlreadyConsumed(lb Broadcast, qopts ...postgres.QOpt) (bool, error) {
+	// Logs replayed with JobReplayOpts.IgnoreConsumed are processed again
+	if replayed, ok := lb.(*broadcast); ok && replayed.ignoreConsumed {
+		return false, nil
+	}
 	return b.orm.WasBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().Index, lb.JobID(), qopts...)
 }
 
@@ -591,6 +677,17 @@ func (b *broadcaster) MarkConsumed(lb Broadcast, qopts ...postgres.QOpt) error {
 	return b.orm.MarkBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().BlockNumber, lb.RawLog().Index, lb.JobID(), qopts...)
 }
 
+// LogsByBlockRange returns the logs of the contract's events persisted by the log poller, between fromBlock and toBlock inclusive
+func (b *broadcaster) LogsByBlockRange(contract common.Address, eventSigs []common.Hash, fromBlock, toBlock int64, qopts ...postgres.QOpt) ([]types.Log, error) {
+	return b.orm.SelectLogPollerLogsByBlockRange(contract, eventSigs, fromBlock, toBlock, qopts...)
+}
+
+// UnconsumedLogs returns up to limit logs persisted by the log poller for the job's filter on the contract, after the given
+// position, which the job has not consumed yet
+func (b *broadcaster) UnconsumedLogs(jobID int32, contract common.Address, after LogPosition, limit int, qopts ...postgres.QOpt) ([]types.Log, error) {
+	return b.orm.SelectUnconsumedLogPollerLogs(jobID, contract, after, math.MaxInt64, limit, qopts...)
+}
+
 // test only
 func (b *broadcaster) TrackedAddressesCount() uint32 {
 	return b.trackedAddressesCount.Load()
@@ -632,6 +729,10 @@ func (n *NullBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubs
 }
 
 func (n *NullBroadcaster) ReplayFromBlock(number int64) {}
+func (n *NullBroadcaster) ReplayJob(opts JobReplayOpts) (JobReplay, error) {
+	return JobReplay{}, errors.New(n.ErrMsg)
+}
+func (n *NullBroadcaster) JobReplays(jobID *int32) ([]JobReplay, error) { return nil, nil }
 
 func (n *NullBroadcaster) BackfillBlockNumber() null.Int64 {
 	return null.NewInt64(0, false)
@@ -645,6 +746,12 @@ func (n *NullBroadcaster) WasAlreadyConsumed(lb Broadcast, qopts ...postgres.QOp
 func (n *NullBroadcaster) MarkConsumed(lb Broadcast, qopts ...postgres.QOpt) error {
 	return errors.New(n.ErrMsg)
 }
+func (n *NullBroadcaster) LogsByBlockRange(common.Address, []common.Hash, int64, int64, ...postgres.QOpt) ([]types.Log, error) {
+	return nil, errors.New(n.ErrMsg)
+}
+func (n *NullBroadcaster) UnconsumedLogs(int32, common.Address, LogPosition, int, ...postgres.QOpt) ([]types.Log, error) {
+	return nil, errors.New(n.ErrMsg)
+}
 
 func (n *NullBroadcaster) AddDependents(int) {}
 func (n *NullBroadcaster) AwaitDependents() <-chan struct{} {
@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
@@ -686,6 +687,110 @@ func TestBroadcaster_BroadcastsAtCorrectHeights(t *testing.T) {
 	helper.mockEth.assertExpectations(t)
 }
 
+func TestBroadcaster_BroadcastsAtTaggedBlocks(t *testing.T) {
+	const blockHeight int64 = 0
+	helper := newBroadcasterHelper(t, blockHeight, 1)
+	helper.globalConfig.Overrides.GlobalEvmFinalityTagsEnabled = null.BoolFrom(true)
+	helper.start()
+
+	contract1, err := flux_aggregator_wrapper.NewFluxAggregator(cltest.NewAddress(), nil)
+	require.NoError(t, err)
+
+	blocks := cltest.NewBlocks(t, 10)
+	for n, head := range blocks.Heads {
+		if n >= 5 {
+			head.FinalizedNumber = clnull.Int64From(n - 5)
+		}
+		if n >= 2 {
+			head.SafeNumber = clnull.Int64From(n - 2)
+		}
+	}
+	addr1SentLogs := []types.Log{
+		blocks.LogOnBlockNum(1, contract1.Address()),
+		blocks.LogOnBlockNum(2, contract1.Address()),
+		blocks.LogOnBlockNum(3, contract1.Address()),
+	}
+
+	listener1 := helper.newLogListenerWithJob("listener 1")
+	listener2 := helper.newLogListenerWithJob("listener 2")
+
+	helper.registerWithTag(listener1, contract1, eth.BlockTagFinalized)
+	helper.registerWithTag(listener2, contract1, eth.BlockTagSafe)
+
+	_ = cltest.SimulateIncomingHeads(t, cltest.SimulateIncomingHeadsArgs{
+		StartBlock:     0,
+		EndBlock:       9,
+		HeadTrackables: []httypes.HeadTrackable{(helper.lb).(httypes.HeadTrackable)},
+		Blocks:         blocks,
+	})
+
+	chRawLogs := <-helper.chchRawLogs
+
+	for _, log := range addr1SentLogs {
+		chRawLogs <- log
+	}
+
+	helper.requireBroadcastCount(6)
+	helper.stop()
+
+	requireEqualLogs(t, addr1SentLogs, listener1.received.getUniqueLogs())
+	requireEqualLogs(t, addr1SentLogs, listener2.received.getUniqueLogs())
+
+	// each log is received once its block is finalized
+	expectedLogsOnBlocks := []logOnBlock{
+		{logBlockNumber: 1, blockNumber: 6, blockHash: blocks.Hashes[6]},
+		{logBlockNumber: 2, blockNumber: 7, blockHash: blocks.Hashes[7]},
+		{logBlockNumber: 3, blockNumber: 8, blockHash: blocks.Hashes[8]},
+	}
+	require.Equal(t, expectedLogsOnBlocks, listener1.received.logsOnBlocks())
+
+	helper.mockEth.assertExpectations(t)
+}
+
+func TestBroadcaster_BroadcastsAtFinalityDepthWithoutFinalityTags(t *testing.T) {
+	const blockHeight int64 = 0
+	helper := newBroadcasterHelper(t, blockHeight, 1)
+	helper.globalConfig.Overrides.GlobalEvmFinalityDepth = null.IntFrom(3)
+	helper.globalConfig.Overrides.GlobalEvmFinalityTagsEnabled = null.BoolFrom(false)
+	helper.start()
+
+	contract1, err := flux_aggregator_wrapper.NewFluxAggregator(cltest.NewAddress(), nil)
+	require.NoError(t, err)
+
+	blocks := cltest.NewBlocks(t, 10)
+	addr1SentLogs := []types.Log{
+		blocks.LogOnBlockNum(1, contract1.Address()),
+		blocks.LogOnBlockNum(2, contract1.Address()),
+	}
+
+	listener1 := helper.newLogListenerWithJob("listener 1")
+	helper.registerWithTag(listener1, contract1, eth.BlockTagFinalized)
+
+	_ = cltest.SimulateIncomingHeads(t, cltest.SimulateIncomingHeadsArgs{
+		StartBlock:     0,
+		EndBlock:       9,
+		HeadTrackables: []httypes.HeadTrackable{(helper.lb).(httypes.HeadTrackable)},
+		Blocks:         blocks,
+	})
+
+	chRawLogs := <-helper.chchRawLogs
+
+	for _, log := range addr1SentLogs {
+		chRawLogs <- log
+	}
+
+	helper.requireBroadcastCount(2)
+	helper.stop()
+
+	expectedLogsOnBlocks := []logOnBlock{
+		{logBlockNumber: 1, blockNumber: 3, blockHash: blocks.Hashes[3]},
+		{logBlockNumber: 2, blockNumber: 4, blockHash: blocks.Hashes[4]},
+	}
+	require.Equal(t, expectedLogsOnBlocks, listener1.received.logsOnBlocks())
+
+	helper.mockEth.assertExpectations(t)
+}
+
 func TestBroadcaster_DeletesOldLogsAfterNumberOfHeads(t *testing.T) {
 	const blockHeight int64 = 0
 	helper := newBroadcasterHelper(t, blockHeight, 1)
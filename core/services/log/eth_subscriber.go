@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -18,15 +19,17 @@ import (
 type (
 	ethSubscriber struct {
 		ethClient eth.Client
+		orm       ORM
 		config    Config
 		logger    logger.Logger
 		chStop    chan struct{}
 	}
 )
 
-func newEthSubscriber(ethClient eth.Client, config Config, logger logger.Logger, chStop chan struct{}) *ethSubscriber {
+func newEthSubscriber(ethClient eth.Client, orm ORM, config Config, logger logger.Logger, chStop chan struct{}) *ethSubscriber {
 	return &ethSubscriber{
 		ethClient: ethClient,
+		orm:       orm,
 		config:    config,
 		logger:    logger,
 		chStop:    chStop,
@@ -197,7 +200,8 @@ func (sub *ethSubscriber) fetchLogBatch(ctxParent context.Context, query ethereu
 
 // createSubscription creates a new log subscription starting at the current block.  If previous logs
 // are needed, they must be obtained through backfilling, as subscriptions can only be started from
-// the current head.
+// the current head. Without a websocket node, logs are polled for instead, resuming from the last
+// polled block.
 func (sub *ethSubscriber) createSubscription(addresses []common.Address, topics []common.Hash) (subscr managedSubscription, abort bool) {
 	if len(addresses) == 0 {
 		return newNoopSubscription(), false
@@ -220,7 +224,16 @@ func (sub *ethSubscriber) createSubscription(addresses []common.Address, topics
 		sub.logger.Debugw("Calling SubscribeFilterLogs with params", "addresses", addresses, "topics", topics)
 
 		innerSub, err := sub.ethClient.SubscribeFilterLogs(ctx2, filterQuery, chRawLogs)
-		if err != nil {
+		if errors.Is(err, eth.ErrSubscriptionsNotSupported) {
+			sub.logger.Debugw("No websocket node available, polling for logs", "interval", sub.config.EvmPollInterval())
+			pollingSub, err := sub.newPollingSubscription(ctx2, filterQuery)
+			if err != nil {
+				sub.logger.Errorw("Log subscriber could not start polling for logs", "err", err)
+				return true
+			}
+			subscr = pollingSub
+			return false
+		} else if err != nil {
 			sub.logger.Errorw("Log subscriber could not create subscription to Ethereum node", "err", err)
 			return true
 		}
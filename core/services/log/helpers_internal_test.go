@@ -1,6 +1,9 @@
 package log
 
 import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -16,3 +19,29 @@ func NewTestBroadcaster(orm ORM, ethClient eth.Client, config Config, lggr logge
 func (b *broadcaster) ExportedAppendLogChannel(ch1, ch2 <-chan types.Log) chan types.Log {
 	return b.appendLogChannel(ch1, ch2)
 }
+
+// NewTestPollingSubscription starts polling for logs as the broadcaster does
+// when no websocket node is available.
+func NewTestPollingSubscription(orm ORM, ethClient eth.Client, config Config, lggr logger.Logger, query ethereum.FilterQuery) (managedSubscription, error) {
+	sub := newEthSubscriber(ethClient, orm, config, lggr, make(chan struct{}))
+	return sub.newPollingSubscription(context.Background(), query)
+}
+
+// NewTestLogPoller creates a log poller which only polls when
+// ExportedOnNewHead is called.
+func NewTestLogPoller(orm ORM, ethClient eth.Client, config Config, lggr logger.Logger) *logPoller {
+	return newLogPoller(orm, ethClient, config, lggr, make(chan struct{}))
+}
+
+func (lp *logPoller) ExportedRegister(listener Listener, opts ListenerOpts) (unsubscribe func()) {
+	return lp.register(listener, opts)
+}
+
+func (lp *logPoller) ExportedOnNewHead(ctx context.Context, head eth.Head) {
+	lp.onNewHead(ctx, head)
+}
+
+// ExportedAwaitJobReplays waits for the job replays in progress to finish
+func (b *broadcaster) ExportedAwaitJobReplays() {
+	b.jobReplayer.wg.Wait()
+}
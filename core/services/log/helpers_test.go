@@ -152,6 +152,20 @@ func (helper *broadcasterHelper) registerWithTopicValues(listener log.Listener,
 	helper.toUnsubscribe = append(helper.toUnsubscribe, unsubscribe)
 }
 
+func (helper *broadcasterHelper) registerWithTag(listener log.Listener, contract abigenContract, tag eth.BlockTag) {
+	unsubscribe := helper.lb.Register(listener, log.ListenerOpts{
+		Contract: contract.Address(),
+		ParseLog: contract.ParseLog,
+		LogsWithTopics: map[common.Hash][][]log.Topic{
+			flux_aggregator_wrapper.FluxAggregatorNewRound{}.Topic():      nil,
+			flux_aggregator_wrapper.FluxAggregatorAnswerUpdated{}.Topic(): nil,
+		},
+		ConfirmationTag: tag,
+	})
+
+	helper.toUnsubscribe = append(helper.toUnsubscribe, unsubscribe)
+}
+
 func (helper *broadcasterHelper) requireBroadcastCount(expectedCount int) {
 	helper.t.Helper()
 	g := gomega.NewGomegaWithT(helper.t)
@@ -0,0 +1,360 @@
+package log
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ErrNoJobListeners is returned when replaying logs to a job which is not
+// listening for any, e.g. because it does not exist or is not running
+var ErrNoJobListeners = errors.New("no log listeners for the job")
+
+// maxJobReplays is the number of job replays kept in the database per chain
+// to report their progress
+const maxJobReplays = 100
+
+const (
+	JobReplayStateInProgress JobReplayState = "in_progress"
+	JobReplayStateCompleted  JobReplayState = "completed"
+	JobReplayStateErrored    JobReplayState = "errored"
+)
+
+type (
+	// JobReplayOpts are the options of a replay of the logs of a job
+	JobReplayOpts struct {
+		JobID int32 `db:"job_id"`
+		// Contract, if set, restricts the replay to the job's listeners of
+		// the contract
+		Contract *common.Address `db:"contract"`
+		// FromBlock is the first block to replay the logs of
+		FromBlock int64 `db:"from_block"`
+		// IgnoreConsumed has WasAlreadyConsumed report the replayed logs as not
+		// consumed, so the job processes them again even if it did already
+		IgnoreConsumed bool `db:"ignore_consumed"`
+	}
+
+	JobReplayState string
+
+	// JobReplay is a replay of the logs of a job, and its progress
+	JobReplay struct {
+		ID int64 `db:"id"`
+		JobReplayOpts
+		EVMChainID utils.Big `db:"evm_chain_id"`
+		// ToBlock is the last block to replay the logs of, i.e. the latest
+		// block with enough confirmations when the replay started
+		ToBlock int64 `db:"to_block"`
+		// BlockNumber is the last block the logs were replayed for, if any
+		BlockNumber   null.Int64     `db:"block_number"`
+		LogsDelivered int64          `db:"logs_delivered"`
+		State         JobReplayState `db:"state"`
+		Error         string         `db:"error"`
+		CreatedAt     time.Time      `db:"created_at"`
+		FinishedAt    *time.Time     `db:"finished_at"`
+	}
+
+	// jobReplayer replays the logs of a block range to the listeners of a
+	// single job, unlike ReplayFromBlock which replays them to every listener
+	// on the chain. The logs are fetched with FilterLogs, independently of the
+	// log subscription. The replays and their progress are saved in the
+	// database.
+	jobReplayer struct {
+		ethClient  eth.Client
+		orm        ORM
+		config     Config
+		logger     logger.Logger
+		evmChainID big.Int
+		chStop     chan struct{}
+		wg         sync.WaitGroup
+
+		mu            sync.Mutex
+		registrations map[*registration]struct{}
+		stopped       bool
+	}
+
+	// replayTarget is a listener of the replayed job, with the last block
+	// whose logs have enough confirmations for it
+	replayTarget struct {
+		reg     *registration
+		toBlock int64
+	}
+)
+
+func newJobReplayer(ethClient eth.Client, orm ORM, config Config, lggr logger.Logger, chStop chan struct{}) *jobReplayer {
+	return &jobReplayer{
+		ethClient:     ethClient,
+		orm:           orm,
+		config:        config,
+		logger:        lggr,
+		evmChainID:    *ethClient.ChainID(),
+		chStop:        chStop,
+		registrations: make(map[*registration]struct{}),
+	}
+}
+
+// start marks the replays which were in progress when the node stopped as
+// errored, since they cannot be resumed
+func (jr *jobReplayer) start() {
+	if err := jr.orm.AbortJobReplays("replay interrupted, the node was restarted"); err != nil {
+		jr.logger.Errorw("LogBroadcaster: Failed to abort interrupted job replays", "err", err)
+	}
+}
+
+// register keeps track of the listener, so that logs can be replayed to it
+func (jr *jobReplayer) register(listener Listener, opts ListenerOpts) (unregister func()) {
+	reg := &registration{listener, opts}
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	jr.registrations[reg] = struct{}{}
+	return func() {
+		jr.mu.Lock()
+		defer jr.mu.Unlock()
+		delete(jr.registrations, reg)
+	}
+}
+
+// replay starts replaying the logs to the job's listeners, from
+// opts.FromBlock up to the latest block with enough confirmations for them
+func (jr *jobReplayer) replay(opts JobReplayOpts) (JobReplay, error) {
+	regs := jr.jobRegistrations(opts)
+	if len(regs) == 0 {
+		if opts.Contract != nil {
+			return JobReplay{}, errors.Wrapf(ErrNoJobListeners, "cannot replay logs of contract %s to job %d", opts.Contract.Hex(), opts.JobID)
+		}
+		return JobReplay{}, errors.Wrapf(ErrNoJobListeners, "cannot replay logs to job %d", opts.JobID)
+	}
+
+	ctx, cancel := utils.ContextFromChan(jr.chStop)
+	defer cancel()
+	ctxHead, cancelHead := eth.DefaultQueryCtx(ctx)
+	defer cancelHead()
+	head, err := jr.ethClient.HeadByNumber(ctxHead, nil)
+	if err != nil {
+		return JobReplay{}, errors.Wrap(err, "could not fetch the latest head")
+	} else if head == nil {
+		return JobReplay{}, errors.New("could not fetch the latest head")
+	}
+
+	var targets []replayTarget
+	toBlock := int64(-1)
+	for _, reg := range regs {
+		confirmed, err := jr.confirmedBlock(ctxHead, head, reg.opts)
+		if err != nil {
+			return JobReplay{}, err
+		}
+		targets = append(targets, replayTarget{reg, confirmed})
+		if confirmed > toBlock {
+			toBlock = confirmed
+		}
+	}
+	if opts.FromBlock > toBlock {
+		return JobReplay{}, errors.Errorf("block %d does not have enough confirmations for the job yet, the latest block with enough confirmations is %d", opts.FromBlock, toBlock)
+	}
+
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	if jr.stopped {
+		return JobReplay{}, errors.New("log broadcaster is stopped")
+	}
+	replay := &JobReplay{
+		JobReplayOpts: opts,
+		EVMChainID:    *utils.NewBig(&jr.evmChainID),
+		ToBlock:       toBlock,
+		State:         JobReplayStateInProgress,
+		CreatedAt:     time.Now(),
+	}
+	if err := jr.orm.InsertJobReplay(replay, maxJobReplays); err != nil {
+		return JobReplay{}, errors.Wrap(err, "could not save the replay")
+	}
+
+	jr.logger.Infow("LogBroadcaster: Replaying logs to job", "jobID", opts.JobID, "contract", opts.Contract,
+		"fromBlock", opts.FromBlock, "toBlock", toBlock, "ignoreConsumed", opts.IgnoreConsumed, "replayID", replay.ID)
+
+	started := *replay
+	jr.wg.Add(1)
+	go func() {
+		defer jr.wg.Done()
+		jr.run(replay, *head, targets)
+	}()
+	return started, nil
+}
+
+// jobReplays returns the latest replays, of jobID only if it is set, latest
+// first
+func (jr *jobReplayer) jobReplays(jobID *int32) ([]JobReplay, error) {
+	return jr.orm.SelectJobReplays(jobID, maxJobReplays)
+}
+
+// stop waits for the replays in progress to abort, after chStop is closed
+func (jr *jobReplayer) stop() {
+	jr.mu.Lock()
+	jr.stopped = true
+	jr.mu.Unlock()
+	jr.wg.Wait()
+}
+
+func (jr *jobReplayer) jobRegistrations(opts JobReplayOpts) []*registration {
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	var regs []*registration
+	for reg := range jr.registrations {
+		if reg.listener.JobID() != opts.JobID {
+			continue
+		}
+		if opts.Contract != nil && reg.opts.Contract != *opts.Contract {
+			continue
+		}
+		regs = append(regs, reg)
+	}
+	return regs
+}
+
+// confirmedBlock returns the latest block whose logs have NumConfirmations,
+// or which has the ConfirmationTag of the listener
+func (jr *jobReplayer) confirmedBlock(ctx context.Context, head *eth.Head, opts ListenerOpts) (int64, error) {
+	if tag := opts.ConfirmationTag; tag != "" {
+		tagged, err := jr.ethClient.HeadByTag(ctx, tag)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not fetch the latest %s head", tag)
+		} else if tagged == nil {
+			return -1, nil
+		}
+		return tagged.Number, nil
+	}
+	numConfirmations := opts.NumConfirmations
+	if numConfirmations == 0 {
+		numConfirmations = 1
+	}
+	return head.Number - int64(numConfirmations) + 1, nil
+}
+
+func (jr *jobReplayer) run(replay *JobReplay, head eth.Head, targets []replayTarget) {
+	ctx, cancel := utils.ContextFromChan(jr.chStop)
+	defer cancel()
+
+	addresses := make(map[common.Address]struct{})
+	sigs := make(map[common.Hash]struct{})
+	for _, target := range targets {
+		addresses[target.reg.opts.Contract] = struct{}{}
+		for sig := range target.reg.opts.LogsWithTopics {
+			sigs[sig] = struct{}{}
+		}
+	}
+	query := ethereum.FilterQuery{Topics: [][]common.Hash{{}}}
+	for address := range addresses {
+		query.Addresses = append(query.Addresses, address)
+	}
+	for sig := range sigs {
+		query.Topics[0] = append(query.Topics[0], sig)
+	}
+
+	batchSize := int64(jr.config.EvmLogBackfillBatchSize())
+	for from := replay.FromBlock; from <= replay.ToBlock; from += batchSize {
+		to := from + batchSize - 1
+		if to > replay.ToBlock {
+			to = replay.ToBlock
+		}
+		query.FromBlock = big.NewInt(from)
+		query.ToBlock = big.NewInt(to)
+
+		ctxLogs, cancelLogs := eth.DefaultQueryCtx(ctx)
+		logs, err := jr.ethClient.FilterLogs(ctxLogs, query)
+		cancelLogs()
+		if ctx.Err() != nil {
+			jr.finish(replay, errors.New("replay aborted, the node is shutting down"))
+			return
+		} else if err != nil {
+			jr.finish(replay, errors.Wrapf(err, "could not fetch logs from block %d to %d", from, to))
+			return
+		}
+
+		var delivered int64
+		for _, log := range logs {
+			for _, target := range targets {
+				if jr.deliver(head, target, log, replay.IgnoreConsumed) {
+					delivered++
+				}
+			}
+		}
+
+		replay.BlockNumber = null.Int64From(to)
+		replay.LogsDelivered += delivered
+		if err := jr.orm.UpdateJobReplay(*replay); err != nil {
+			jr.logger.Errorw("LogBroadcaster: Failed to save job replay progress", "err", err, "jobID", replay.JobID, "replayID", replay.ID)
+		}
+	}
+	jr.finish(replay, nil)
+}
+
+// deliver sends the log to the listener, if it is one of the listener's and
+// has enough confirmations for it
+func (jr *jobReplayer) deliver(head eth.Head, target replayTarget, log types.Log, ignoreConsumed bool) bool {
+	opts := target.reg.opts
+	if log.Removed || len(log.Topics) == 0 || log.Address != opts.Contract || int64(log.BlockNumber) > target.toBlock {
+		return false
+	}
+	filters, exists := opts.LogsWithTopics[log.Topics[0]]
+	if !exists {
+		return false
+	}
+	if len(filters) > 0 && len(log.Topics) > 1 && !filtersContainValues(log.Topics[1:], filters) {
+		return false
+	}
+
+	var decodedLog generated.AbigenLog
+	if opts.ParseLog != nil {
+		var err error
+		decodedLog, err = opts.ParseLog(log)
+		if err != nil {
+			jr.logger.Errorw("Could not parse contract log", "error", err)
+			return false
+		}
+	}
+
+	jobID := target.reg.listener.JobID()
+	jr.logger.Debugw("LogBroadcaster: Replaying log",
+		"blockNumber", log.BlockNumber, "blockHash", log.BlockHash,
+		"address", log.Address, "latestBlockNumber", head.Number, "jobID", jobID)
+
+	target.reg.listener.HandleLog(&broadcast{
+		latestBlockNumber: uint64(head.Number),
+		latestBlockHash:   head.Hash,
+		decodedLog:        decodedLog,
+		rawLog:            log,
+		jobID:             jobID,
+		evmChainID:        jr.evmChainID,
+		ignoreConsumed:    ignoreConsumed,
+	})
+	return true
+}
+
+func (jr *jobReplayer) finish(replay *JobReplay, err error) {
+	now := time.Now()
+	replay.FinishedAt = &now
+	if err != nil {
+		replay.State = JobReplayStateErrored
+		replay.Error = err.Error()
+		jr.logger.Errorw("LogBroadcaster: Failed to replay logs to job", "err", err, "jobID", replay.JobID, "replayID", replay.ID)
+	} else {
+		replay.State = JobReplayStateCompleted
+		jr.logger.Infow("LogBroadcaster: Finished replaying logs to job", "jobID", replay.JobID, "replayID", replay.ID,
+			"logsDelivered", replay.LogsDelivered)
+	}
+	// The replay is saved even if the node is shutting down, so it is not
+	// reported in progress after it restarts
+	if err := jr.orm.UpdateJobReplay(*replay); err != nil {
+		jr.logger.Errorw("LogBroadcaster: Failed to save job replay", "err", err, "jobID", replay.JobID, "replayID", replay.ID)
+	}
+}
@@ -0,0 +1,162 @@
+package log_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	logmocks "github.com/smartcontractkit/chainlink/core/services/log/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestBroadcaster_ReplayJob(t *testing.T) {
+	t.Parallel()
+
+	contract1 := cltest.NewAddress()
+	contract2 := cltest.NewAddress()
+	eventSig := utils.NewHash()
+
+	config := new(logmocks.Config)
+	config.Test(t)
+	config.On("EvmLogBackfillBatchSize").Return(uint32(5))
+	orm := new(logmocks.ORM)
+	orm.Test(t)
+	// saved has the latest state of each replay saved in the database
+	saved := make(map[int64]log.JobReplay)
+	orm.On("InsertJobReplay", mock.Anything, 100).Run(func(args mock.Arguments) {
+		replay := args.Get(0).(*log.JobReplay)
+		replay.ID = int64(len(saved) + 1)
+		saved[replay.ID] = *replay
+	}).Return(nil)
+	orm.On("UpdateJobReplay", mock.Anything).Run(func(args mock.Arguments) {
+		replay := args.Get(0).(log.JobReplay)
+		saved[replay.ID] = replay
+	}).Return(nil)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	lb := log.NewTestBroadcaster(orm, ethClient, config, logger.TestLogger(t), nil)
+
+	newListener := func(jobID int32) (*logmocks.Listener, *[]log.Broadcast) {
+		var received []log.Broadcast
+		listener := new(logmocks.Listener)
+		listener.Test(t)
+		listener.On("JobID").Return(jobID)
+		listener.On("HandleLog", mock.Anything).Run(func(args mock.Arguments) {
+			received = append(received, args.Get(0).(log.Broadcast))
+		}).Maybe()
+		return listener, &received
+	}
+	register := func(listener log.Listener, contract common.Address) {
+		lb.Register(listener, log.ListenerOpts{
+			Contract:         contract,
+			LogsWithTopics:   map[common.Hash][][]log.Topic{eventSig: nil},
+			NumConfirmations: 5,
+		})
+	}
+	listener1, received1 := newListener(1)
+	register(listener1, contract1)
+	listener1Contract2, received1Contract2 := newListener(1)
+	register(listener1Contract2, contract2)
+	listener2, received2 := newListener(2)
+	register(listener2, contract1)
+
+	newLog := func(contract common.Address, blockNumber uint64) types.Log {
+		return types.Log{Address: contract, Topics: []common.Hash{eventSig}, BlockNumber: blockNumber, BlockHash: utils.NewHash()}
+	}
+	log12 := newLog(contract1, 12)
+	log16 := newLog(contract1, 16)
+	blockRange := func(from, to int64) interface{} {
+		return mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+			return q.FromBlock.Cmp(big.NewInt(from)) == 0 && q.ToBlock.Cmp(big.NewInt(to)) == 0 &&
+				assert.ObjectsAreEqual([]common.Address{contract1}, q.Addresses)
+		})
+	}
+
+	t.Run("replays the logs to the job's listeners of the contract only", func(t *testing.T) {
+		// With 5 confirmations, the logs up to block 16 are replayed
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(20), nil).Once()
+		ethClient.On("FilterLogs", mock.Anything, blockRange(10, 14)).Return([]types.Log{log12}, nil).Once()
+		ethClient.On("FilterLogs", mock.Anything, blockRange(15, 16)).Return([]types.Log{log16}, nil).Once()
+
+		replay, err := lb.ReplayJob(log.JobReplayOpts{JobID: 1, Contract: &contract1, FromBlock: 10, IgnoreConsumed: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(16), replay.ToBlock)
+		lb.ExportedAwaitJobReplays()
+
+		require.Len(t, *received1, 2)
+		assert.Equal(t, log12, (*received1)[0].RawLog())
+		assert.Equal(t, log16, (*received1)[1].RawLog())
+		assert.Equal(t, uint64(20), (*received1)[0].LatestBlockNumber())
+		assert.Empty(t, *received1Contract2)
+		assert.Empty(t, *received2)
+
+		// The logs are processed again, without checking the consumed markers
+		consumed, err := lb.WasAlreadyConsumed((*received1)[0])
+		require.NoError(t, err)
+		assert.False(t, consumed)
+
+		require.Len(t, saved, 1)
+		assert.Equal(t, log.JobReplayStateInProgress, replay.State)
+		saved := saved[replay.ID]
+		assert.Equal(t, log.JobReplayStateCompleted, saved.State)
+		assert.Equal(t, int64(16), saved.BlockNumber.Int64)
+		assert.Equal(t, int64(2), saved.LogsDelivered)
+		assert.NotNil(t, saved.FinishedAt)
+	})
+
+	t.Run("reports errors fetching logs in the replay's progress", func(t *testing.T) {
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(20), nil).Once()
+		ethClient.On("FilterLogs", mock.Anything, blockRange(15, 16)).Return(nil, errors.New("boom")).Once()
+
+		replay, err := lb.ReplayJob(log.JobReplayOpts{JobID: 1, Contract: &contract1, FromBlock: 15})
+		require.NoError(t, err)
+		lb.ExportedAwaitJobReplays()
+
+		require.Len(t, saved, 2)
+		saved := saved[replay.ID]
+		assert.Equal(t, log.JobReplayStateErrored, saved.State)
+		assert.Contains(t, saved.Error, "boom")
+		assert.False(t, saved.BlockNumber.Valid)
+		assert.NotNil(t, saved.FinishedAt)
+	})
+
+	t.Run("rejects blocks without enough confirmations", func(t *testing.T) {
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(20), nil).Once()
+
+		_, err := lb.ReplayJob(log.JobReplayOpts{JobID: 2, FromBlock: 17})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not have enough confirmations")
+	})
+
+	t.Run("rejects jobs without listeners", func(t *testing.T) {
+		_, err := lb.ReplayJob(log.JobReplayOpts{JobID: 3, FromBlock: 10})
+		assert.Equal(t, log.ErrNoJobListeners, errors.Cause(err))
+
+		contract := cltest.NewAddress()
+		_, err = lb.ReplayJob(log.JobReplayOpts{JobID: 2, Contract: &contract, FromBlock: 10})
+		assert.Equal(t, log.ErrNoJobListeners, errors.Cause(err))
+	})
+
+	t.Run("lists the replays saved", func(t *testing.T) {
+		jobID := int32(1)
+		replays := []log.JobReplay{saved[2], saved[1]}
+		orm.On("SelectJobReplays", &jobID, 100).Return(replays, nil).Once()
+
+		listed, err := lb.JobReplays(&jobID)
+		require.NoError(t, err)
+		assert.Equal(t, replays, listed)
+	})
+
+	ethClient.AssertExpectations(t)
+	orm.AssertExpectations(t)
+}
@@ -0,0 +1,524 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// unconsumedLogsPageSize is the number of unconsumed logs loaded at a time
+// when delivering them to a listener
+const unconsumedLogsPageSize = 100
+
+// logPollerLogsRetention is how long logs are kept at most. Logs are deleted
+// once consumed, but some never are, e.g. those skipped by the topic filters
+// of a listener or those of a job which stopped consuming logs.
+const logPollerLogsRetention = 7 * 24 * time.Hour
+
+type (
+	// LogPollerFilter is a contract, and the events of it, whose logs the log
+	// poller persists for a job
+	LogPollerFilter struct {
+		ID        int64
+		JobID     int32
+		Address   common.Address
+		EventSigs []common.Hash
+		// StartBlock is the first block logs were polled for, if they were
+		StartBlock null.Int64
+		// BlockNumber is the cursor of the filter: the last block logs were
+		// polled for, if they were
+		BlockNumber null.Int64
+	}
+
+	// LogPollerBlock is a block seen by the log poller
+	LogPollerBlock struct {
+		BlockNumber int64       `db:"block_number"`
+		BlockHash   common.Hash `db:"block_hash"`
+	}
+
+	// LogPosition is the position of a log in the chain, used to page through
+	// logs
+	LogPosition struct {
+		BlockNumber int64
+		LogIndex    int64
+	}
+
+	// logPoller persists the logs for the listeners registered with
+	// ListenerOpts.Persistent, so that none are missed however long the node
+	// is down. Each job has a filter per contract, with a cursor of its own.
+	//
+	// On each new head, the log poller:
+	//  - removes the logs from blocks which are no longer on the chain, and
+	//    moves the cursors back before them
+	//  - polls the logs of all filters from the lowest cursor up to the head,
+	//    with one query per batch of blocks
+	//  - delivers the logs which listeners have not consumed yet, once they
+	//    have enough confirmations
+	//  - deletes the logs which every filter has delivered, once they are
+	//    older than the finality depth
+	logPoller struct {
+		orm        ORM
+		ethClient  eth.Client
+		config     Config
+		logger     logger.Logger
+		evmChainID big.Int
+
+		registrationsMu sync.Mutex
+		registrations   map[*pollerRegistration]struct{}
+
+		newHeads *utils.Mailbox
+		chStop   chan struct{}
+	}
+
+	pollerRegistration struct {
+		registration
+		// filter is nil until it is saved
+		filter *LogPollerFilter
+		// delivered is the position of the last log sent to the listener
+		delivered LogPosition
+	}
+)
+
+// FirstLogPosition is the position before any log
+var FirstLogPosition = LogPosition{BlockNumber: -1, LogIndex: -1}
+
+// NewLogPosition returns the position of the log
+func NewLogPosition(log types.Log) LogPosition {
+	return LogPosition{BlockNumber: int64(log.BlockNumber), LogIndex: int64(log.Index)}
+}
+
+func newLogPoller(orm ORM, ethClient eth.Client, config Config, lggr logger.Logger, chStop chan struct{}) *logPoller {
+	return &logPoller{
+		orm:           orm,
+		ethClient:     ethClient,
+		config:        config,
+		logger:        lggr,
+		evmChainID:    *ethClient.ChainID(),
+		registrations: make(map[*pollerRegistration]struct{}),
+		newHeads:      utils.NewMailbox(1),
+		chStop:        chStop,
+	}
+}
+
+func (lp *logPoller) register(listener Listener, opts ListenerOpts) (unsubscribe func()) {
+	reg := &pollerRegistration{
+		registration: registration{listener, opts},
+		delivered:    FirstLogPosition,
+	}
+	lp.logger.Debugw("LogPoller: Subscribing listener", "requiredBlockConfirmations", opts.NumConfirmations, "confirmationTag", opts.ConfirmationTag, "address", opts.Contract, "jobID", listener.JobID())
+
+	lp.registrationsMu.Lock()
+	defer lp.registrationsMu.Unlock()
+	lp.registrations[reg] = struct{}{}
+	return func() {
+		lp.logger.Debugw("LogPoller: Unsubscribing listener", "address", opts.Contract, "jobID", listener.JobID())
+		lp.registrationsMu.Lock()
+		defer lp.registrationsMu.Unlock()
+		delete(lp.registrations, reg)
+	}
+}
+
+func (lp *logPoller) pollerRegistrations() []*pollerRegistration {
+	lp.registrationsMu.Lock()
+	defer lp.registrationsMu.Unlock()
+	regs := make([]*pollerRegistration, 0, len(lp.registrations))
+	for reg := range lp.registrations {
+		regs = append(regs, reg)
+	}
+	return regs
+}
+
+func (lp *logPoller) onNewLongestChain(head eth.Head) {
+	lp.newHeads.Deliver(head)
+}
+
+func (lp *logPoller) run() {
+	ctx, cancel := utils.ContextFromChan(lp.chStop)
+	defer cancel()
+
+	// The logs of the filters of deleted jobs are no longer needed
+	if err := lp.orm.PruneLogPollerLogs(postgres.WithParentCtx(ctx)); err != nil && ctx.Err() == nil {
+		lp.logger.Errorw("LogPoller: Failed to prune logs", "err", err)
+	}
+
+	for {
+		select {
+		case <-lp.newHeads.Notify():
+			item := lp.newHeads.RetrieveLatestAndClear()
+			if item == nil {
+				continue
+			}
+			head, ok := item.(eth.Head)
+			if !ok {
+				lp.logger.Errorf("expected `eth.Head`, got %T", item)
+				continue
+			}
+			lp.onNewHead(ctx, head)
+
+		case <-lp.chStop:
+			return
+		}
+	}
+}
+
+func (lp *logPoller) onNewHead(ctx context.Context, head eth.Head) {
+	regs := lp.pollerRegistrations()
+	if len(regs) == 0 {
+		return
+	}
+
+	if err := lp.removeOrphanedLogs(ctx, head, regs); err != nil {
+		if ctx.Err() == nil {
+			lp.logger.Errorw("LogPoller: Failed to check for re-orgs, will retry on the next head", "err", err, "blockNumber", head.Number)
+		}
+		return
+	}
+	if err := lp.poll(ctx, head, regs); err != nil && ctx.Err() == nil {
+		lp.logger.Warnw("LogPoller: Failed to poll for logs, will retry on the next head", "err", err, "blockNumber", head.Number)
+	}
+	if err := lp.saveBlocks(ctx, head); err != nil && ctx.Err() == nil {
+		lp.logger.Errorw("LogPoller: Failed to save blocks", "err", err, "blockNumber", head.Number)
+	}
+	for _, reg := range regs {
+		lp.deliver(ctx, head, reg)
+	}
+	// Logs within the finality depth are kept, so that re-orgs are detected
+	// in them
+	before := head.Number - int64(lp.config.EvmFinalityDepth())
+	if err := lp.orm.DeleteConsumedLogPollerLogs(before, time.Now().Add(-logPollerLogsRetention), postgres.WithParentCtx(ctx)); err != nil && ctx.Err() == nil {
+		lp.logger.Errorw("LogPoller: Failed to delete consumed logs", "err", err, "blockNumber", head.Number)
+	}
+}
+
+// removeOrphanedLogs compares the saved blocks, and the blocks of the saved
+// logs, to the chain of the head. From the lowest block which is not on the
+// chain up, it deletes the logs and moves the cursors back.
+func (lp *logPoller) removeOrphanedLogs(ctx context.Context, head eth.Head, regs []*pollerRegistration) error {
+	canonical := make(map[int64]common.Hash)
+	for h := &head; h != nil; h = h.Parent {
+		canonical[h.Number] = h.Hash
+	}
+
+	blocks, err := lp.orm.SelectLogPollerBlocks(0, postgres.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	var rewindTo *int64
+	overlaps := false
+	for _, block := range blocks {
+		hash, exists := canonical[block.BlockNumber]
+		if !exists {
+			continue
+		}
+		overlaps = true
+		if hash != block.BlockHash {
+			blockNumber := block.BlockNumber
+			rewindTo = &blockNumber
+			break
+		}
+	}
+	if !overlaps && len(blocks) > 0 {
+		// None of the saved blocks are on the chain of the head, e.g. after
+		// the node was down for longer than the chain is deep, so they are
+		// checked against the node instead
+		rewindTo, err = lp.checkSavedBlocks(ctx, blocks, canonical)
+		if err != nil {
+			return err
+		}
+	}
+
+	earliest := head.Number
+	hashes := make([]common.Hash, 0, len(canonical))
+	for blockNumber, hash := range canonical {
+		if blockNumber < earliest {
+			earliest = blockNumber
+		}
+		hashes = append(hashes, hash)
+	}
+	orphaned, err := lp.orm.LowestOrphanedLogPollerLog(earliest, head.Number, hashes, postgres.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	if orphaned != nil && (rewindTo == nil || *orphaned < *rewindTo) {
+		rewindTo = orphaned
+	}
+	if rewindTo == nil {
+		return nil
+	}
+
+	lp.logger.Warnw("LogPoller: Detected re-org, removing the logs from orphaned blocks", "fromBlock", *rewindTo, "blockNumber", head.Number, "blockHash", head.Hash)
+	if err := lp.orm.RewindLogPoller(*rewindTo, postgres.WithParentCtx(ctx)); err != nil {
+		return err
+	}
+	for _, reg := range regs {
+		if reg.filter != nil && reg.filter.BlockNumber.Valid && reg.filter.BlockNumber.Int64 >= *rewindTo {
+			reg.filter.BlockNumber = null.Int64From(*rewindTo - 1)
+		}
+		if reg.delivered.BlockNumber >= *rewindTo {
+			reg.delivered = LogPosition{BlockNumber: *rewindTo, LogIndex: -1}
+		}
+	}
+	return nil
+}
+
+// checkSavedBlocks fetches the saved blocks from the node, from the highest
+// down, until one is still on the chain. Its ancestors are then on the chain
+// too, and are added to canonical. It returns the lowest block which is no
+// longer on the chain, if any.
+func (lp *logPoller) checkSavedBlocks(ctx context.Context, blocks []LogPollerBlock, canonical map[int64]common.Hash) (*int64, error) {
+	var rewindTo *int64
+	for i := len(blocks) - 1; i >= 0; i-- {
+		ctxHead, cancel := eth.DefaultQueryCtx(ctx)
+		onChain, err := lp.ethClient.HeadByNumber(ctxHead, big.NewInt(blocks[i].BlockNumber))
+		cancel()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not fetch block header")
+		}
+		if onChain != nil && onChain.Hash == blocks[i].BlockHash {
+			for _, block := range blocks[:i+1] {
+				canonical[block.BlockNumber] = block.BlockHash
+			}
+			return rewindTo, nil
+		}
+		blockNumber := blocks[i].BlockNumber
+		rewindTo = &blockNumber
+	}
+	return rewindTo, nil
+}
+
+// poll fetches the logs of the filters of the registrations, from the block
+// after the lowest cursor up to the head, in batches of
+// EvmLogBackfillBatchSize blocks. Each batch is fetched with a single query
+// for the filters whose cursors are before its end, and the logs are saved
+// and their cursors moved after it.
+func (lp *logPoller) poll(ctx context.Context, head eth.Head, regs []*pollerRegistration) error {
+	// nextBlocks is the first block which logs have not been polled for, by
+	// registration
+	nextBlocks := make(map[*pollerRegistration]int64)
+	from := head.Number
+	for _, reg := range regs {
+		if reg.filter == nil {
+			filter, err := lp.orm.UpsertLogPollerFilter(reg.listener.JobID(), reg.opts.Contract, eventSigs(reg.opts), postgres.WithParentCtx(ctx))
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				lp.logger.Warnw("LogPoller: Failed to save filter, will retry on the next head", "err", err,
+					"address", reg.opts.Contract, "jobID", reg.listener.JobID())
+				continue
+			}
+			reg.filter = &filter
+		}
+		// Like a subscription, a new filter starts from the current head
+		next := head.Number
+		if reg.filter.BlockNumber.Valid {
+			next = reg.filter.BlockNumber.Int64 + 1
+		}
+		nextBlocks[reg] = next
+		if next < from {
+			from = next
+		}
+	}
+
+	batchSize := int64(lp.config.EvmLogBackfillBatchSize())
+	for ; from <= head.Number; from += batchSize {
+		to := from + batchSize - 1
+		if to > head.Number {
+			to = head.Number
+		}
+
+		// fromBlocks is the first block polled in this batch, by filter ID
+		fromBlocks := make(map[int64]int64)
+		wanted := make(map[common.Address]map[common.Hash]int64)
+		var polled []*pollerRegistration
+		for reg, next := range nextBlocks {
+			if next > to {
+				continue
+			}
+			if next < from {
+				next = from
+			}
+			polled = append(polled, reg)
+			fromBlocks[reg.filter.ID] = next
+			if wanted[reg.filter.Address] == nil {
+				wanted[reg.filter.Address] = make(map[common.Hash]int64)
+			}
+			for _, sig := range reg.filter.EventSigs {
+				if prev, exists := wanted[reg.filter.Address][sig]; !exists || next < prev {
+					wanted[reg.filter.Address][sig] = next
+				}
+			}
+		}
+		if len(polled) == 0 {
+			continue
+		}
+
+		ctxLogs, cancel := eth.DefaultQueryCtx(ctx)
+		logs, err := lp.ethClient.FilterLogs(ctxLogs, filterQuery(wanted, from, to))
+		cancel()
+		if err != nil {
+			return errors.Wrap(err, "could not fetch logs")
+		}
+
+		// The query matches the events of each contract with those of every
+		// other, and blocks before the cursors of some filters, so only the
+		// logs some filter wants are saved
+		var saved []types.Log
+		for _, log := range logs {
+			if len(log.Topics) == 0 {
+				continue
+			}
+			if next, exists := wanted[log.Address][log.Topics[0]]; exists && int64(log.BlockNumber) >= next {
+				saved = append(saved, log)
+			}
+		}
+		if err := lp.orm.InsertLogPollerLogs(saved, fromBlocks, to, postgres.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+		for _, reg := range polled {
+			if !reg.filter.StartBlock.Valid {
+				reg.filter.StartBlock = null.Int64From(fromBlocks[reg.filter.ID])
+			}
+			reg.filter.BlockNumber = null.Int64From(to)
+			nextBlocks[reg] = to + 1
+		}
+	}
+	return nil
+}
+
+// filterQuery returns a query for the events of the contracts between
+// fromBlock and toBlock, with the contracts and events in a stable order
+func filterQuery(wanted map[common.Address]map[common.Hash]int64, fromBlock, toBlock int64) ethereum.FilterQuery {
+	addresses := make([]common.Address, 0, len(wanted))
+	sigSet := make(map[common.Hash]struct{})
+	for address, sigs := range wanted {
+		addresses = append(addresses, address)
+		for sig := range sigs {
+			sigSet[sig] = struct{}{}
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+	sigs := make([]common.Hash, 0, len(sigSet))
+	for sig := range sigSet {
+		sigs = append(sigs, sig)
+	}
+	sort.Slice(sigs, func(i, j int) bool {
+		return bytes.Compare(sigs[i][:], sigs[j][:]) < 0
+	})
+	return ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromBlock),
+		ToBlock:   big.NewInt(toBlock),
+		Addresses: addresses,
+		Topics:    [][]common.Hash{sigs},
+	}
+}
+
+// saveBlocks saves the blocks of the chain of the head, to detect re-orgs on
+// the next heads
+func (lp *logPoller) saveBlocks(ctx context.Context, head eth.Head) error {
+	var blocks []LogPollerBlock
+	earliest := head.Number
+	for h := &head; h != nil; h = h.Parent {
+		blocks = append(blocks, LogPollerBlock{BlockNumber: h.Number, BlockHash: h.Hash})
+		earliest = h.Number
+	}
+	return lp.orm.InsertLogPollerBlocks(blocks, earliest, postgres.WithParentCtx(ctx))
+}
+
+// deliver sends the listener the logs it has not consumed yet, once they have
+// NumConfirmations, or are at or below the block with its ConfirmationTag
+func (lp *logPoller) deliver(ctx context.Context, head eth.Head, reg *pollerRegistration) {
+	if reg.filter == nil || !reg.filter.BlockNumber.Valid {
+		return
+	}
+
+	numConfirmations := reg.opts.NumConfirmations
+	if numConfirmations == 0 {
+		numConfirmations = 1
+	}
+	toBlock := head.Number - int64(numConfirmations) + 1
+	if tag := reg.opts.ConfirmationTag; tag != "" {
+		tagNumber := head.TagNumber(tag)
+		if !tagNumber.Valid {
+			return
+		}
+		toBlock = tagNumber.Int64
+	}
+	if toBlock > reg.filter.BlockNumber.Int64 {
+		toBlock = reg.filter.BlockNumber.Int64
+	}
+
+	jobID := reg.listener.JobID()
+	for {
+		logs, err := lp.orm.SelectUnconsumedLogPollerLogs(jobID, reg.opts.Contract, reg.delivered, toBlock, unconsumedLogsPageSize, postgres.WithParentCtx(ctx))
+		if err != nil {
+			if ctx.Err() == nil {
+				lp.logger.Errorw("LogPoller: Failed to load unconsumed logs", "err", err, "address", reg.opts.Contract, "jobID", jobID)
+			}
+			return
+		}
+		for _, log := range logs {
+			reg.delivered = NewLogPosition(log)
+
+			if filters := reg.opts.LogsWithTopics[log.Topics[0]]; len(filters) > 0 && len(log.Topics) > 1 {
+				if !filtersContainValues(log.Topics[1:], filters) {
+					continue
+				}
+			}
+
+			var decodedLog generated.AbigenLog
+			if reg.opts.ParseLog != nil {
+				decodedLog, err = reg.opts.ParseLog(log)
+				if err != nil {
+					lp.logger.Errorw("Could not parse contract log", "error", err)
+					continue
+				}
+			}
+
+			lp.logger.Debugw("LogPoller: Sending out log",
+				"blockNumber", log.BlockNumber, "blockHash", log.BlockHash,
+				"address", log.Address, "latestBlockNumber", head.Number, "jobID", jobID)
+
+			reg.listener.HandleLog(&broadcast{
+				latestBlockNumber: uint64(head.Number),
+				latestBlockHash:   head.Hash,
+				decodedLog:        decodedLog,
+				rawLog:            log,
+				jobID:             jobID,
+				evmChainID:        lp.evmChainID,
+			})
+		}
+		if len(logs) < unconsumedLogsPageSize {
+			break
+		}
+	}
+}
+
+// eventSigs returns the event signatures of the options, in a stable order
+func eventSigs(opts ListenerOpts) []common.Hash {
+	sigs := make([]common.Hash, 0, len(opts.LogsWithTopics))
+	for sig := range opts.LogsWithTopics {
+		sigs = append(sigs, sig)
+	}
+	sort.Slice(sigs, func(i, j int) bool {
+		return bytes.Compare(sigs[i][:], sigs[j][:]) < 0
+	})
+	return sigs
+}
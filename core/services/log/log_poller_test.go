@@ -0,0 +1,295 @@
+package log_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	logmocks "github.com/smartcontractkit/chainlink/core/services/log/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestLogPoller_PollsPersistsAndDelivers(t *testing.T) {
+	t.Parallel()
+
+	const jobID = int32(42)
+	contract := cltest.NewAddress()
+	eventSig := utils.NewHash()
+	wantedValue := utils.NewHash()
+
+	config := new(logmocks.Config)
+	config.Test(t)
+	config.On("EvmLogBackfillBatchSize").Return(uint32(2))
+	config.On("EvmFinalityDepth").Return(uint32(10))
+	orm := new(logmocks.ORM)
+	orm.Test(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	blockRange := func(from, to int64) interface{} {
+		return filterQuery(from, to, []common.Address{contract}, []common.Hash{eventSig})
+	}
+	newLog := func(head *eth.Head, index uint, value common.Hash) types.Log {
+		return types.Log{Address: contract, Topics: []common.Hash{eventSig, value}, BlockNumber: uint64(head.Number), BlockHash: head.Hash, Index: index}
+	}
+
+	var received []log.Broadcast
+	listener := new(logmocks.Listener)
+	listener.Test(t)
+	listener.On("JobID").Return(jobID)
+	listener.On("HandleLog", mock.Anything).Run(func(args mock.Arguments) {
+		received = append(received, args.Get(0).(log.Broadcast))
+	})
+
+	lp := log.NewTestLogPoller(orm, ethClient, config, logger.TestLogger(t))
+	lp.ExportedRegister(listener, log.ListenerOpts{
+		Contract:         contract,
+		LogsWithTopics:   map[common.Hash][][]log.Topic{eventSig: {{log.Topic(wantedValue)}}},
+		NumConfirmations: 2,
+		Persistent:       true,
+	})
+
+	// The filter was polled up to block 9 before a restart
+	head12 := chain(nil, 10, 12)
+	block11 := head12.Parent
+	log11 := newLog(block11, 1, wantedValue)
+	otherLog11 := newLog(block11, 2, utils.NewHash())
+	log12 := newLog(head12, 1, wantedValue)
+
+	orm.On("SelectLogPollerBlocks", int64(0), mock.Anything).Return(nil, nil).Once()
+	orm.On("LowestOrphanedLogPollerLog", int64(10), int64(12), mock.Anything, mock.Anything).Return(nil, nil).Once()
+	orm.On("UpsertLogPollerFilter", jobID, contract, []common.Hash{eventSig}, mock.Anything).Return(log.LogPollerFilter{
+		ID:          1,
+		JobID:       jobID,
+		Address:     contract,
+		EventSigs:   []common.Hash{eventSig},
+		StartBlock:  null.Int64From(5),
+		BlockNumber: null.Int64From(9),
+	}, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, blockRange(10, 11)).Return([]types.Log{log11, otherLog11}, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, blockRange(12, 12)).Return([]types.Log{log12}, nil).Once()
+	orm.On("InsertLogPollerLogs", []types.Log{log11, otherLog11}, map[int64]int64{1: 10}, int64(11), mock.Anything).Return(nil).Once()
+	orm.On("InsertLogPollerLogs", []types.Log{log12}, map[int64]int64{1: 12}, int64(12), mock.Anything).Return(nil).Once()
+	orm.On("InsertLogPollerBlocks", mock.Anything, int64(10), mock.Anything).Return(nil).Once()
+	// With 2 confirmations, the logs up to block 11 are delivered
+	orm.On("SelectUnconsumedLogPollerLogs", jobID, contract, log.FirstLogPosition, int64(11), mock.Anything, mock.Anything).
+		Return([]types.Log{log11, otherLog11}, nil).Once()
+	// Consumed logs are kept for the finality depth, and the others for the
+	// retention period
+	savedBefore := mock.MatchedBy(func(savedBefore time.Time) bool {
+		return time.Since(savedBefore) > 6*24*time.Hour
+	})
+	orm.On("DeleteConsumedLogPollerLogs", int64(2), savedBefore, mock.Anything).Return(nil).Once()
+
+	lp.ExportedOnNewHead(context.Background(), *head12)
+
+	require.Len(t, received, 1, "expected the log not matching the topic filter to be skipped")
+	assert.Equal(t, log11, received[0].RawLog())
+	assert.Equal(t, jobID, received[0].JobID())
+	assert.Equal(t, uint64(12), received[0].LatestBlockNumber())
+
+	// Block 12 is re-orged out by a new chain
+	head13 := chain(block11, 12, 13)
+	newLog12 := newLog(head13.Parent, 1, wantedValue)
+
+	orm.On("SelectLogPollerBlocks", int64(0), mock.Anything).Return([]log.LogPollerBlock{
+		{BlockNumber: 10, BlockHash: block11.Parent.Hash},
+		{BlockNumber: 11, BlockHash: block11.Hash},
+		{BlockNumber: 12, BlockHash: head12.Hash},
+	}, nil).Once()
+	orm.On("LowestOrphanedLogPollerLog", int64(10), int64(13), mock.Anything, mock.Anything).Return(nil, nil).Once()
+	orm.On("RewindLogPoller", int64(12), mock.Anything).Return(nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, blockRange(12, 13)).Return([]types.Log{newLog12}, nil).Once()
+	orm.On("InsertLogPollerLogs", []types.Log{newLog12}, map[int64]int64{1: 12}, int64(13), mock.Anything).Return(nil).Once()
+	orm.On("InsertLogPollerBlocks", mock.Anything, int64(10), mock.Anything).Return(nil).Once()
+	orm.On("SelectUnconsumedLogPollerLogs", jobID, contract, log.NewLogPosition(otherLog11), int64(12), mock.Anything, mock.Anything).
+		Return([]types.Log{newLog12}, nil).Once()
+	orm.On("DeleteConsumedLogPollerLogs", int64(3), mock.Anything, mock.Anything).Return(nil).Once()
+
+	lp.ExportedOnNewHead(context.Background(), *head13)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, newLog12, received[1].RawLog())
+
+	orm.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+	listener.AssertExpectations(t)
+}
+
+func TestLogPoller_PollsFiltersTogether(t *testing.T) {
+	t.Parallel()
+
+	contractA, contractB := cltest.NewAddress(), cltest.NewAddress()
+	sigA, sigB := utils.NewHash(), utils.NewHash()
+
+	config := new(logmocks.Config)
+	config.Test(t)
+	config.On("EvmLogBackfillBatchSize").Return(uint32(2))
+	config.On("EvmFinalityDepth").Return(uint32(10))
+	orm := new(logmocks.ORM)
+	orm.Test(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	lp := log.NewTestLogPoller(orm, ethClient, config, logger.TestLogger(t))
+	register := func(jobID int32, contract common.Address, sig common.Hash) {
+		listener := new(logmocks.Listener)
+		listener.Test(t)
+		listener.On("JobID").Return(jobID)
+		lp.ExportedRegister(listener, log.ListenerOpts{
+			Contract:       contract,
+			LogsWithTopics: map[common.Hash][][]log.Topic{sig: nil},
+			Persistent:     true,
+		})
+	}
+	register(1, contractA, sigA)
+	register(2, contractB, sigB)
+
+	head := chain(nil, 10, 12)
+	logA := types.Log{Address: contractA, Topics: []common.Hash{sigA}, BlockNumber: 12, BlockHash: head.Hash, Index: 1}
+	logB := types.Log{Address: contractB, Topics: []common.Hash{sigB}, BlockNumber: 12, BlockHash: head.Hash, Index: 2}
+	unwanted := types.Log{Address: contractB, Topics: []common.Hash{sigA}, BlockNumber: 12, BlockHash: head.Hash, Index: 3}
+
+	orm.On("SelectLogPollerBlocks", int64(0), mock.Anything).Return(nil, nil).Once()
+	orm.On("LowestOrphanedLogPollerLog", int64(10), int64(12), mock.Anything, mock.Anything).Return(nil, nil).Once()
+	// The filter of job 1 was polled up to block 9, job 2 is new
+	orm.On("UpsertLogPollerFilter", int32(1), contractA, []common.Hash{sigA}, mock.Anything).Return(log.LogPollerFilter{
+		ID: 1, JobID: 1, Address: contractA, EventSigs: []common.Hash{sigA}, StartBlock: null.Int64From(5), BlockNumber: null.Int64From(9),
+	}, nil).Once()
+	orm.On("UpsertLogPollerFilter", int32(2), contractB, []common.Hash{sigB}, mock.Anything).Return(log.LogPollerFilter{
+		ID: 2, JobID: 2, Address: contractB, EventSigs: []common.Hash{sigB},
+	}, nil).Once()
+	// Only job 1 is behind before the head, then both filters are polled with
+	// one query
+	ethClient.On("FilterLogs", mock.Anything, filterQuery(10, 11, []common.Address{contractA}, []common.Hash{sigA})).Return(nil, nil).Once()
+	orm.On("InsertLogPollerLogs", []types.Log(nil), map[int64]int64{1: 10}, int64(11), mock.Anything).Return(nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, filterQuery(12, 12, []common.Address{contractA, contractB}, []common.Hash{sigA, sigB})).
+		Return([]types.Log{logA, logB, unwanted}, nil).Once()
+	orm.On("InsertLogPollerLogs", []types.Log{logA, logB}, map[int64]int64{1: 12, 2: 12}, int64(12), mock.Anything).Return(nil).Once()
+	orm.On("InsertLogPollerBlocks", mock.Anything, int64(10), mock.Anything).Return(nil).Once()
+	orm.On("SelectUnconsumedLogPollerLogs", mock.Anything, mock.Anything, log.FirstLogPosition, int64(12), mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	orm.On("DeleteConsumedLogPollerLogs", int64(2), mock.Anything, mock.Anything).Return(nil).Once()
+
+	lp.ExportedOnNewHead(context.Background(), *head)
+
+	orm.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+}
+
+func TestLogPoller_ChecksSavedBlocksAgainstTheNode(t *testing.T) {
+	t.Parallel()
+
+	const jobID = int32(42)
+	contract := cltest.NewAddress()
+	eventSig := utils.NewHash()
+
+	config := new(logmocks.Config)
+	config.Test(t)
+	config.On("EvmLogBackfillBatchSize").Return(uint32(20))
+	config.On("EvmFinalityDepth").Return(uint32(10))
+	orm := new(logmocks.ORM)
+	orm.Test(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	listener := new(logmocks.Listener)
+	listener.Test(t)
+	listener.On("JobID").Return(jobID)
+	lp := log.NewTestLogPoller(orm, ethClient, config, logger.TestLogger(t))
+	lp.ExportedRegister(listener, log.ListenerOpts{
+		Contract:       contract,
+		LogsWithTopics: map[common.Hash][][]log.Topic{eventSig: nil},
+		Persistent:     true,
+	})
+
+	// The node was down for longer than the chain of the head is deep, and
+	// block 12 was re-orged out meanwhile
+	saved := chain(nil, 10, 12)
+	head := chain(nil, 20, 22)
+	orm.On("SelectLogPollerBlocks", int64(0), mock.Anything).Return([]log.LogPollerBlock{
+		{BlockNumber: 10, BlockHash: saved.Parent.Parent.Hash},
+		{BlockNumber: 11, BlockHash: saved.Parent.Hash},
+		{BlockNumber: 12, BlockHash: saved.Hash},
+	}, nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(12)).Return(cltest.Head(12), nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(11)).Return(saved.Parent, nil).Once()
+	orm.On("LowestOrphanedLogPollerLog", int64(10), int64(22), mock.Anything, mock.Anything).Return(nil, nil).Once()
+	orm.On("RewindLogPoller", int64(12), mock.Anything).Return(nil).Once()
+	orm.On("UpsertLogPollerFilter", jobID, contract, []common.Hash{eventSig}, mock.Anything).Return(log.LogPollerFilter{
+		ID: 1, JobID: jobID, Address: contract, EventSigs: []common.Hash{eventSig}, StartBlock: null.Int64From(5), BlockNumber: null.Int64From(11),
+	}, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, filterQuery(12, 22, []common.Address{contract}, []common.Hash{eventSig})).Return(nil, nil).Once()
+	orm.On("InsertLogPollerLogs", []types.Log(nil), map[int64]int64{1: 12}, int64(22), mock.Anything).Return(nil).Once()
+	orm.On("InsertLogPollerBlocks", mock.Anything, int64(20), mock.Anything).Return(nil).Once()
+	orm.On("SelectUnconsumedLogPollerLogs", jobID, contract, log.FirstLogPosition, int64(22), mock.Anything, mock.Anything).Return(nil, nil).Once()
+	orm.On("DeleteConsumedLogPollerLogs", int64(12), mock.Anything, mock.Anything).Return(nil).Once()
+
+	lp.ExportedOnNewHead(context.Background(), *head)
+
+	orm.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+}
+
+func TestLogPoller_DoesNothingWithoutRegistrations(t *testing.T) {
+	t.Parallel()
+
+	config := new(logmocks.Config)
+	config.Test(t)
+	orm := new(logmocks.ORM)
+	orm.Test(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	lp := log.NewTestLogPoller(orm, ethClient, config, logger.TestLogger(t))
+	listener := new(logmocks.Listener)
+	listener.Test(t)
+	listener.On("JobID").Return(int32(1))
+	unsubscribe := lp.ExportedRegister(listener, log.ListenerOpts{
+		Contract:       cltest.NewAddress(),
+		LogsWithTopics: map[common.Hash][][]log.Topic{utils.NewHash(): nil},
+		Persistent:     true,
+	})
+	unsubscribe()
+
+	lp.ExportedOnNewHead(context.Background(), *cltest.Head(10))
+
+	orm.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+}
+
+// filterQuery matches a query for the events of the contracts between from
+// and to
+func filterQuery(from, to int64, addresses []common.Address, sigs []common.Hash) interface{} {
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i][:], addresses[j][:]) < 0 })
+	sort.Slice(sigs, func(i, j int) bool { return bytes.Compare(sigs[i][:], sigs[j][:]) < 0 })
+	return mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+		return q.FromBlock.Cmp(big.NewInt(from)) == 0 && q.ToBlock.Cmp(big.NewInt(to)) == 0 &&
+			assert.ObjectsAreEqual(addresses, q.Addresses) &&
+			assert.ObjectsAreEqual([][]common.Hash{sigs}, q.Topics)
+	})
+}
+
+// chain returns the head of a chain of blocks from..to, on top of parent
+func chain(parent *eth.Head, from, to int64) *eth.Head {
+	head := parent
+	for n := from; n <= to; n++ {
+		h := cltest.Head(n)
+		h.Parent = head
+		if head != nil {
+			h.ParentHash = head.Hash
+		}
+		head = h
+	}
+	return head
+}
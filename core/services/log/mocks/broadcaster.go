@@ -5,12 +5,16 @@ package mocks
 import (
 	context "context"
 
+	common "github.com/ethereum/go-ethereum/common"
+
 	eth "github.com/smartcontractkit/chainlink/core/services/eth"
 	log "github.com/smartcontractkit/chainlink/core/services/log"
 
 	mock "github.com/stretchr/testify/mock"
 
 	postgres "github.com/smartcontractkit/chainlink/core/services/postgres"
+
+	types "github.com/ethereum/go-ethereum/core/types"
 )
 
 // Broadcaster is an autogenerated mock type for the Broadcaster type
@@ -86,6 +90,62 @@ func (_m *Broadcaster) IsConnected() bool {
 	return r0
 }
 
+// JobReplays provides a mock function with given fields: jobID
+func (_m *Broadcaster) JobReplays(jobID *int32) ([]log.JobReplay, error) {
+	ret := _m.Called(jobID)
+
+	var r0 []log.JobReplay
+	if rf, ok := ret.Get(0).(func(*int32) []log.JobReplay); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.JobReplay)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*int32) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogsByBlockRange provides a mock function with given fields: contract, eventSigs, fromBlock, toBlock, qopts
+func (_m *Broadcaster) LogsByBlockRange(contract common.Address, eventSigs []common.Hash, fromBlock int64, toBlock int64, qopts ...postgres.QOpt) ([]types.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, contract)
+	_ca = append(_ca, eventSigs)
+	_ca = append(_ca, fromBlock)
+	_ca = append(_ca, toBlock)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []types.Log
+	if rf, ok := ret.Get(0).(func(common.Address, []common.Hash, int64, int64, ...postgres.QOpt) []types.Log); ok {
+		r0 = rf(contract, eventSigs, fromBlock, toBlock, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, []common.Hash, int64, int64, ...postgres.QOpt) error); ok {
+		r1 = rf(contract, eventSigs, fromBlock, toBlock, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarkConsumed provides a mock function with given fields: lb, qopts
 func (_m *Broadcaster) MarkConsumed(lb log.Broadcast, qopts ...postgres.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -147,6 +207,27 @@ func (_m *Broadcaster) ReplayFromBlock(number int64) {
 	_m.Called(number)
 }
 
+// ReplayJob provides a mock function with given fields: opts
+func (_m *Broadcaster) ReplayJob(opts log.JobReplayOpts) (log.JobReplay, error) {
+	ret := _m.Called(opts)
+
+	var r0 log.JobReplay
+	if rf, ok := ret.Get(0).(func(log.JobReplayOpts) log.JobReplay); ok {
+		r0 = rf(opts)
+	} else {
+		r0 = ret.Get(0).(log.JobReplay)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(log.JobReplayOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *Broadcaster) Start() error {
 	ret := _m.Called()
@@ -161,6 +242,39 @@ func (_m *Broadcaster) Start() error {
 	return r0
 }
 
+// UnconsumedLogs provides a mock function with given fields: jobID, contract, after, limit, qopts
+func (_m *Broadcaster) UnconsumedLogs(jobID int32, contract common.Address, after log.LogPosition, limit int, qopts ...postgres.QOpt) ([]types.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, contract)
+	_ca = append(_ca, after)
+	_ca = append(_ca, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []types.Log
+	if rf, ok := ret.Get(0).(func(int32, common.Address, log.LogPosition, int, ...postgres.QOpt) []types.Log); ok {
+		r0 = rf(jobID, contract, after, limit, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, common.Address, log.LogPosition, int, ...postgres.QOpt) error); ok {
+		r1 = rf(jobID, contract, after, limit, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WasAlreadyConsumed provides a mock function with given fields: lb, qopts
 func (_m *Broadcaster) WasAlreadyConsumed(lb log.Broadcast, qopts ...postgres.QOpt) (bool, error) {
 	_va := make([]interface{}, len(qopts))
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Config is an autogenerated mock type for the Config type
 type Config struct {
@@ -51,6 +55,20 @@ func (_m *Config) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagsEnabled provides a mock function with given fields:
+func (_m *Config) EvmFinalityTagsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmLogBackfillBatchSize provides a mock function with given fields:
 func (_m *Config) EvmLogBackfillBatchSize() uint32 {
 	ret := _m.Called()
@@ -64,3 +82,17 @@ func (_m *Config) EvmLogBackfillBatchSize() uint32 {
 
 	return r0
 }
+
+// EvmPollInterval provides a mock function with given fields:
+func (_m *Config) EvmPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
@@ -8,6 +8,10 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	postgres "github.com/smartcontractkit/chainlink/core/services/postgres"
+
+	time "time"
+
+	types "github.com/ethereum/go-ethereum/core/types"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -15,6 +19,27 @@ type ORM struct {
 	mock.Mock
 }
 
+// AbortJobReplays provides a mock function with given fields: reason, qopts
+func (_m *ORM) AbortJobReplays(reason string, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, reason)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...postgres.QOpt) error); ok {
+		r0 = rf(reason, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateBroadcast provides a mock function with given fields: blockHash, blockNumber, logIndex, jobID, qopts
 func (_m *ORM) CreateBroadcast(blockHash common.Hash, blockNumber uint64, logIndex uint, jobID int32, qopts ...postgres.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -36,6 +61,27 @@ func (_m *ORM) CreateBroadcast(blockHash common.Hash, blockNumber uint64, logInd
 	return r0
 }
 
+// DeleteConsumedLogPollerLogs provides a mock function with given fields: before, savedBefore, qopts
+func (_m *ORM) DeleteConsumedLogPollerLogs(before int64, savedBefore time.Time, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, before, savedBefore)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, time.Time, ...postgres.QOpt) error); ok {
+		r0 = rf(before, savedBefore, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindBroadcasts provides a mock function with given fields: fromBlockNum, toBlockNum
 func (_m *ORM) FindBroadcasts(fromBlockNum int64, toBlockNum int64) ([]log.LogBroadcast, error) {
 	ret := _m.Called(fromBlockNum, toBlockNum)
@@ -88,6 +134,132 @@ func (_m *ORM) GetPendingMinBlock(qopts ...postgres.QOpt) (*int64, error) {
 	return r0, r1
 }
 
+// GetPollCursor provides a mock function with given fields: qopts
+func (_m *ORM) GetPollCursor(qopts ...postgres.QOpt) (*int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *int64
+	if rf, ok := ret.Get(0).(func(...postgres.QOpt) *int64); ok {
+		r0 = rf(qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...postgres.QOpt) error); ok {
+		r1 = rf(qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertJobReplay provides a mock function with given fields: replay, keep, qopts
+func (_m *ORM) InsertJobReplay(replay *log.JobReplay, keep int, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, replay)
+	_ca = append(_ca, keep)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*log.JobReplay, int, ...postgres.QOpt) error); ok {
+		r0 = rf(replay, keep, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertLogPollerBlocks provides a mock function with given fields: blocks, pruneBefore, qopts
+func (_m *ORM) InsertLogPollerBlocks(blocks []log.LogPollerBlock, pruneBefore int64, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, blocks)
+	_ca = append(_ca, pruneBefore)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]log.LogPollerBlock, int64, ...postgres.QOpt) error); ok {
+		r0 = rf(blocks, pruneBefore, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertLogPollerLogs provides a mock function with given fields: logs, fromBlocks, toBlock, qopts
+func (_m *ORM) InsertLogPollerLogs(logs []types.Log, fromBlocks map[int64]int64, toBlock int64, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, logs, fromBlocks, toBlock)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]types.Log, map[int64]int64, int64, ...postgres.QOpt) error); ok {
+		r0 = rf(logs, fromBlocks, toBlock, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LowestOrphanedLogPollerLog provides a mock function with given fields: fromBlock, toBlock, blockHashes, qopts
+func (_m *ORM) LowestOrphanedLogPollerLog(fromBlock int64, toBlock int64, blockHashes []common.Hash, qopts ...postgres.QOpt) (*int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, fromBlock)
+	_ca = append(_ca, toBlock)
+	_ca = append(_ca, blockHashes)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *int64
+	if rf, ok := ret.Get(0).(func(int64, int64, []common.Hash, ...postgres.QOpt) *int64); ok {
+		r0 = rf(fromBlock, toBlock, blockHashes, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, []common.Hash, ...postgres.QOpt) error); ok {
+		r1 = rf(fromBlock, toBlock, blockHashes, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarkBroadcastConsumed provides a mock function with given fields: blockHash, blockNumber, logIndex, jobID, qopts
 func (_m *ORM) MarkBroadcastConsumed(blockHash common.Hash, blockNumber uint64, logIndex uint, jobID int32, qopts ...postgres.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -109,6 +281,26 @@ func (_m *ORM) MarkBroadcastConsumed(blockHash common.Hash, blockNumber uint64,
 	return r0
 }
 
+// PruneLogPollerLogs provides a mock function with given fields: qopts
+func (_m *ORM) PruneLogPollerLogs(qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...postgres.QOpt) error); ok {
+		r0 = rf(qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Reinitialize provides a mock function with given fields: qopts
 func (_m *ORM) Reinitialize(qopts ...postgres.QOpt) (*int64, error) {
 	_va := make([]interface{}, len(qopts))
@@ -138,6 +330,155 @@ func (_m *ORM) Reinitialize(qopts ...postgres.QOpt) (*int64, error) {
 	return r0, r1
 }
 
+// RewindLogPoller provides a mock function with given fields: blockNumber, qopts
+func (_m *ORM) RewindLogPoller(blockNumber int64, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, blockNumber)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, ...postgres.QOpt) error); ok {
+		r0 = rf(blockNumber, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SelectJobReplays provides a mock function with given fields: jobID, limit, qopts
+func (_m *ORM) SelectJobReplays(jobID *int32, limit int, qopts ...postgres.QOpt) ([]log.JobReplay, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []log.JobReplay
+	if rf, ok := ret.Get(0).(func(*int32, int, ...postgres.QOpt) []log.JobReplay); ok {
+		r0 = rf(jobID, limit, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.JobReplay)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*int32, int, ...postgres.QOpt) error); ok {
+		r1 = rf(jobID, limit, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectLogPollerBlocks provides a mock function with given fields: fromBlock, qopts
+func (_m *ORM) SelectLogPollerBlocks(fromBlock int64, qopts ...postgres.QOpt) ([]log.LogPollerBlock, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, fromBlock)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []log.LogPollerBlock
+	if rf, ok := ret.Get(0).(func(int64, ...postgres.QOpt) []log.LogPollerBlock); ok {
+		r0 = rf(fromBlock, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.LogPollerBlock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, ...postgres.QOpt) error); ok {
+		r1 = rf(fromBlock, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectLogPollerLogsByBlockRange provides a mock function with given fields: address, eventSigs, fromBlock, toBlock, qopts
+func (_m *ORM) SelectLogPollerLogsByBlockRange(address common.Address, eventSigs []common.Hash, fromBlock int64, toBlock int64, qopts ...postgres.QOpt) ([]types.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address)
+	_ca = append(_ca, eventSigs)
+	_ca = append(_ca, fromBlock)
+	_ca = append(_ca, toBlock)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []types.Log
+	if rf, ok := ret.Get(0).(func(common.Address, []common.Hash, int64, int64, ...postgres.QOpt) []types.Log); ok {
+		r0 = rf(address, eventSigs, fromBlock, toBlock, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, []common.Hash, int64, int64, ...postgres.QOpt) error); ok {
+		r1 = rf(address, eventSigs, fromBlock, toBlock, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectUnconsumedLogPollerLogs provides a mock function with given fields: jobID, address, after, toBlock, limit, qopts
+func (_m *ORM) SelectUnconsumedLogPollerLogs(jobID int32, address common.Address, after log.LogPosition, toBlock int64, limit int, qopts ...postgres.QOpt) ([]types.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, address)
+	_ca = append(_ca, after)
+	_ca = append(_ca, toBlock)
+	_ca = append(_ca, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []types.Log
+	if rf, ok := ret.Get(0).(func(int32, common.Address, log.LogPosition, int64, int, ...postgres.QOpt) []types.Log); ok {
+		r0 = rf(jobID, address, after, toBlock, limit, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, common.Address, log.LogPosition, int64, int, ...postgres.QOpt) error); ok {
+		r1 = rf(jobID, address, after, toBlock, limit, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetPendingMinBlock provides a mock function with given fields: blockNum, qopts
 func (_m *ORM) SetPendingMinBlock(blockNum *int64, qopts ...postgres.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -159,6 +500,78 @@ func (_m *ORM) SetPendingMinBlock(blockNum *int64, qopts ...postgres.QOpt) error
 	return r0
 }
 
+// SetPollCursor provides a mock function with given fields: blockNumber, qopts
+func (_m *ORM) SetPollCursor(blockNumber int64, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, blockNumber)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, ...postgres.QOpt) error); ok {
+		r0 = rf(blockNumber, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateJobReplay provides a mock function with given fields: replay, qopts
+func (_m *ORM) UpdateJobReplay(replay log.JobReplay, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, replay)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(log.JobReplay, ...postgres.QOpt) error); ok {
+		r0 = rf(replay, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertLogPollerFilter provides a mock function with given fields: jobID, address, eventSigs, qopts
+func (_m *ORM) UpsertLogPollerFilter(jobID int32, address common.Address, eventSigs []common.Hash, qopts ...postgres.QOpt) (log.LogPollerFilter, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, address)
+	_ca = append(_ca, eventSigs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 log.LogPollerFilter
+	if rf, ok := ret.Get(0).(func(int32, common.Address, []common.Hash, ...postgres.QOpt) log.LogPollerFilter); ok {
+		r0 = rf(jobID, address, eventSigs, qopts...)
+	} else {
+		r0 = ret.Get(0).(log.LogPollerFilter)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, common.Address, []common.Hash, ...postgres.QOpt) error); ok {
+		r1 = rf(jobID, address, eventSigs, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WasBroadcastConsumed provides a mock function with given fields: blockHash, logIndex, jobID, qopts
 func (_m *ORM) WasBroadcastConsumed(blockHash common.Hash, logIndex uint, jobID int32, qopts ...postgres.QOpt) (bool, error) {
 	_va := make([]interface{}, len(qopts))
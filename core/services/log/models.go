@@ -31,6 +31,9 @@ type (
 		rawLog            types.Log
 		jobID             int32
 		evmChainID        big.Int
+		// ignoreConsumed is set for logs replayed to a job regardless of
+		// whether it consumed them already
+		ignoreConsumed bool
 	}
 )
 
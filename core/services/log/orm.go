@@ -3,9 +3,11 @@ package log
 import (
 	"database/sql"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
@@ -39,6 +41,43 @@ type ORM interface {
 	// Reinitialize cleans up the database by removing any unconsumed broadcasts, then updating (if necessary) and
 	// returning the pending minimum block number.
 	Reinitialize(qopts ...postgres.QOpt) (blockNumber *int64, err error)
+
+	// SetPollCursor sets the block number up to which logs have been polled for, on chains without subscriptions.
+	SetPollCursor(blockNumber int64, qopts ...postgres.QOpt) error
+	// GetPollCursor returns the block number up to which logs have been polled for, or nil if they never were.
+	GetPollCursor(qopts ...postgres.QOpt) (blockNumber *int64, err error)
+
+	// UpsertLogPollerFilter saves the log poller filter of jobID for a contract, keeping its cursor if it exists already.
+	UpsertLogPollerFilter(jobID int32, address common.Address, eventSigs []common.Hash, qopts ...postgres.QOpt) (LogPollerFilter, error)
+	// InsertLogPollerLogs saves the polled logs, skipping the ones saved already, then moves the cursors of the filters polled to toBlock.
+	// fromBlocks maps the IDs of the filters polled to the first block polled for each.
+	InsertLogPollerLogs(logs []types.Log, fromBlocks map[int64]int64, toBlock int64, qopts ...postgres.QOpt) error
+	// SelectLogPollerBlocks returns the blocks seen by the log poller from fromBlock up.
+	SelectLogPollerBlocks(fromBlock int64, qopts ...postgres.QOpt) ([]LogPollerBlock, error)
+	// InsertLogPollerBlocks saves the blocks seen by the log poller, replacing any with the same numbers, and deletes the ones before pruneBefore.
+	InsertLogPollerBlocks(blocks []LogPollerBlock, pruneBefore int64, qopts ...postgres.QOpt) error
+	// LowestOrphanedLogPollerLog returns the lowest block number between fromBlock and toBlock with logs from none of the given blocks, or nil if there is none.
+	LowestOrphanedLogPollerLog(fromBlock, toBlock int64, blockHashes []common.Hash, qopts ...postgres.QOpt) (*int64, error)
+	// RewindLogPoller deletes the logs and blocks from blockNumber up, and moves the cursors of the filters back before it.
+	RewindLogPoller(blockNumber int64, qopts ...postgres.QOpt) error
+	// SelectLogPollerLogsByBlockRange returns the logs of the contract's events between fromBlock and toBlock, in order.
+	SelectLogPollerLogsByBlockRange(address common.Address, eventSigs []common.Hash, fromBlock, toBlock int64, qopts ...postgres.QOpt) ([]types.Log, error)
+	// SelectUnconsumedLogPollerLogs returns up to limit logs of the filter of jobID for a contract, after the given position and up to toBlock, which jobID has not consumed.
+	SelectUnconsumedLogPollerLogs(jobID int32, address common.Address, after LogPosition, toBlock int64, limit int, qopts ...postgres.QOpt) ([]types.Log, error)
+	// PruneLogPollerLogs deletes the logs which no filter matches anymore.
+	PruneLogPollerLogs(qopts ...postgres.QOpt) error
+	// DeleteConsumedLogPollerLogs deletes the logs before the given block which every job whose filter matches them has consumed,
+	// as well as those saved before savedBefore whether they were consumed or not.
+	DeleteConsumedLogPollerLogs(before int64, savedBefore time.Time, qopts ...postgres.QOpt) error
+
+	// InsertJobReplay saves the replay, setting its ID, and deletes the finished replays older than the latest keep ones.
+	InsertJobReplay(replay *JobReplay, keep int, qopts ...postgres.QOpt) error
+	// UpdateJobReplay saves the progress and state of the replay.
+	UpdateJobReplay(replay JobReplay, qopts ...postgres.QOpt) error
+	// AbortJobReplays marks the replays in progress as errored with the given reason, e.g. after the node restarted.
+	AbortJobReplays(reason string, qopts ...postgres.QOpt) error
+	// SelectJobReplays returns up to limit of the latest replays, of jobID only if it is set, latest first.
+	SelectJobReplays(jobID *int32, limit int, qopts ...postgres.QOpt) ([]JobReplay, error)
 }
 
 type orm struct {
@@ -162,6 +201,29 @@ func (o *orm) GetPendingMinBlock(qopts ...postgres.QOpt) (*int64, error) {
 	return blockNumber, nil
 }
 
+func (o *orm) SetPollCursor(blockNumber int64, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	_, err := q.Exec(`
+        INSERT INTO log_broadcasts_poll_cursors (evm_chain_id, block_number, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (evm_chain_id) DO UPDATE SET block_number = EXCLUDED.block_number, updated_at = NOW()
+    `, o.evmChainID, blockNumber)
+	return errors.Wrap(err, "failed to set poll cursor")
+}
+
+func (o *orm) GetPollCursor(qopts ...postgres.QOpt) (*int64, error) {
+	q := postgres.NewQ(o.db, qopts...)
+	var blockNumber int64
+	err := q.Get(&blockNumber, `
+        SELECT block_number FROM log_broadcasts_poll_cursors WHERE evm_chain_id = $1
+    `, o.evmChainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to get poll cursor")
+	}
+	return &blockNumber, nil
+}
+
 func (o *orm) getUnconsumedMinBlock(qopts ...postgres.QOpt) (*int64, error) {
 	q := postgres.NewQ(o.db, qopts...)
 	var blockNumber *int64
@@ -190,6 +252,331 @@ func (o *orm) removeUnconsumed(qopts ...postgres.QOpt) error {
 	return errors.Wrap(err, "failed to delete unconsumed broadcasts")
 }
 
+// insertLogsBatchSize is the number of logs saved per statement, to stay
+// below the limit on the number of query parameters
+const insertLogsBatchSize = 1000
+
+func (o *orm) UpsertLogPollerFilter(jobID int32, address common.Address, eventSigs []common.Hash, qopts ...postgres.QOpt) (filter LogPollerFilter, err error) {
+	q := postgres.NewQ(o.db, qopts...)
+	var sigs [][]byte
+	err = q.QueryRowx(`
+        INSERT INTO log_poller_filters (evm_chain_id, job_id, address, event_sigs, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		ON CONFLICT (evm_chain_id, job_id, address) DO UPDATE SET event_sigs = EXCLUDED.event_sigs, updated_at = NOW()
+		RETURNING id, job_id, address, event_sigs, start_block, block_number
+    `, o.evmChainID, jobID, address, pq.ByteaArray(hashesToBytes(eventSigs))).Scan(
+		&filter.ID, &filter.JobID, &filter.Address, (*pq.ByteaArray)(&sigs), &filter.StartBlock, &filter.BlockNumber)
+	if err != nil {
+		return filter, errors.Wrap(err, "failed to upsert log poller filter")
+	}
+	for _, sig := range sigs {
+		filter.EventSigs = append(filter.EventSigs, common.BytesToHash(sig))
+	}
+	return filter, nil
+}
+
+func (o *orm) InsertLogPollerLogs(logs []types.Log, fromBlocks map[int64]int64, toBlock int64, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	// The logs are saved before the cursors move and duplicates are skipped,
+	// so a failure in between only causes the same blocks to be polled again
+	for i := 0; i < len(logs); i += insertLogsBatchSize {
+		end := i + insertLogsBatchSize
+		if end > len(logs) {
+			end = len(logs)
+		}
+		rows := make([]logPollerLog, 0, end-i)
+		for _, log := range logs[i:end] {
+			if len(log.Topics) == 0 {
+				continue
+			}
+			rows = append(rows, newLogPollerLog(log, o.evmChainID))
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		_, err := q.NamedExec(`
+        INSERT INTO log_poller_logs (evm_chain_id, block_hash, block_number, log_index, address, event_sig, topics, tx_hash, tx_index, data, created_at)
+		VALUES (:evm_chain_id, :block_hash, :block_number, :log_index, :address, :event_sig, :topics, :tx_hash, :tx_index, :data, NOW())
+		ON CONFLICT DO NOTHING
+    `, rows)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert log poller logs")
+		}
+	}
+	filterIDs := make([]int64, 0, len(fromBlocks))
+	froms := make([]int64, 0, len(fromBlocks))
+	for filterID, from := range fromBlocks {
+		filterIDs = append(filterIDs, filterID)
+		froms = append(froms, from)
+	}
+	_, err := q.Exec(`
+        UPDATE log_poller_filters f SET start_block = COALESCE(f.start_block, polled.from_block), block_number = $2, updated_at = NOW()
+		FROM unnest($3::bigint[], $4::bigint[]) AS polled(id, from_block)
+		WHERE f.id = polled.id AND f.evm_chain_id = $1
+    `, o.evmChainID, toBlock, pq.Array(filterIDs), pq.Array(froms))
+	return errors.Wrap(err, "failed to update log poller filter cursors")
+}
+
+func (o *orm) SelectLogPollerBlocks(fromBlock int64, qopts ...postgres.QOpt) ([]LogPollerBlock, error) {
+	q := postgres.NewQ(o.db, qopts...)
+	var blocks []LogPollerBlock
+	err := q.Select(&blocks, `
+        SELECT block_number, block_hash FROM log_poller_blocks
+		WHERE evm_chain_id = $1 AND block_number >= $2
+		ORDER BY block_number
+    `, o.evmChainID, fromBlock)
+	return blocks, errors.Wrap(err, "failed to select log poller blocks")
+}
+
+func (o *orm) InsertLogPollerBlocks(blocks []LogPollerBlock, pruneBefore int64, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	numbers := make([]int64, len(blocks))
+	hashes := make([]common.Hash, len(blocks))
+	for i, block := range blocks {
+		numbers[i] = block.BlockNumber
+		hashes[i] = block.BlockHash
+	}
+	_, err := q.Exec(`
+        INSERT INTO log_poller_blocks (evm_chain_id, block_number, block_hash, created_at)
+		SELECT $1, unnest($2::bigint[]), unnest($3::bytea[]), NOW()
+		ON CONFLICT (evm_chain_id, block_number) DO UPDATE SET block_hash = EXCLUDED.block_hash, created_at = NOW()
+    `, o.evmChainID, pq.Array(numbers), pq.ByteaArray(hashesToBytes(hashes)))
+	if err != nil {
+		return errors.Wrap(err, "failed to insert log poller blocks")
+	}
+	_, err = q.Exec(`DELETE FROM log_poller_blocks WHERE evm_chain_id = $1 AND block_number < $2`, o.evmChainID, pruneBefore)
+	return errors.Wrap(err, "failed to prune log poller blocks")
+}
+
+func (o *orm) LowestOrphanedLogPollerLog(fromBlock, toBlock int64, blockHashes []common.Hash, qopts ...postgres.QOpt) (*int64, error) {
+	q := postgres.NewQ(o.db, qopts...)
+	var blockNumber *int64
+	err := q.Get(&blockNumber, `
+        SELECT min(block_number) FROM log_poller_logs
+		WHERE evm_chain_id = $1
+		AND block_number >= $2
+		AND block_number <= $3
+		AND NOT (block_hash = ANY($4))
+    `, o.evmChainID, fromBlock, toBlock, pq.ByteaArray(hashesToBytes(blockHashes)))
+	return blockNumber, errors.Wrap(err, "failed to find orphaned log poller logs")
+}
+
+func (o *orm) RewindLogPoller(blockNumber int64, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	_, err := q.Exec(`
+        WITH deleted_logs AS (
+			DELETE FROM log_poller_logs WHERE evm_chain_id = $1 AND block_number >= $2
+		), deleted_blocks AS (
+			DELETE FROM log_poller_blocks WHERE evm_chain_id = $1 AND block_number >= $2
+		)
+		UPDATE log_poller_filters SET block_number = $3, updated_at = NOW()
+		WHERE evm_chain_id = $1 AND block_number >= $2
+    `, o.evmChainID, blockNumber, blockNumber-1)
+	return errors.Wrap(err, "failed to rewind log poller")
+}
+
+func (o *orm) SelectLogPollerLogsByBlockRange(address common.Address, eventSigs []common.Hash, fromBlock, toBlock int64, qopts ...postgres.QOpt) ([]types.Log, error) {
+	q := postgres.NewQ(o.db, qopts...)
+	var rows []logPollerLog
+	err := q.Select(&rows, `
+        SELECT block_hash, block_number, log_index, address, event_sig, topics, tx_hash, tx_index, data FROM log_poller_logs
+		WHERE evm_chain_id = $1
+		AND address = $2
+		AND event_sig = ANY($3)
+		AND block_number >= $4
+		AND block_number <= $5
+		ORDER BY block_number, log_index
+    `, o.evmChainID, address, pq.ByteaArray(hashesToBytes(eventSigs)), fromBlock, toBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to select log poller logs")
+	}
+	return logPollerLogsToLogs(rows), nil
+}
+
+func (o *orm) SelectUnconsumedLogPollerLogs(jobID int32, address common.Address, after LogPosition, toBlock int64, limit int, qopts ...postgres.QOpt) ([]types.Log, error) {
+	q := postgres.NewQ(o.db, qopts...)
+	var rows []logPollerLog
+	err := q.Select(&rows, `
+        SELECT l.block_hash, l.block_number, l.log_index, l.address, l.event_sig, l.topics, l.tx_hash, l.tx_index, l.data
+		FROM log_poller_filters f
+		JOIN log_poller_logs l ON l.evm_chain_id = f.evm_chain_id AND l.address = f.address AND l.event_sig = ANY(f.event_sigs)
+		WHERE f.evm_chain_id = $1
+		AND f.job_id = $2
+		AND f.address = $3
+		AND l.block_number >= f.start_block
+		AND l.block_number <= $4
+		AND (l.block_number, l.log_index) > ($5, $6)
+		AND NOT EXISTS (
+			SELECT 1 FROM log_broadcasts b
+			WHERE b.evm_chain_id = l.evm_chain_id
+			AND b.job_id = f.job_id
+			AND b.block_hash = l.block_hash
+			AND b.log_index = l.log_index
+			AND b.consumed
+		)
+		ORDER BY l.block_number, l.log_index
+		LIMIT $7
+    `, o.evmChainID, jobID, address, toBlock, after.BlockNumber, after.LogIndex, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to select unconsumed log poller logs")
+	}
+	return logPollerLogsToLogs(rows), nil
+}
+
+func (o *orm) PruneLogPollerLogs(qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	_, err := q.Exec(`
+        DELETE FROM log_poller_logs l
+		WHERE evm_chain_id = $1
+		AND NOT EXISTS (
+			SELECT 1 FROM log_poller_filters f
+			WHERE f.evm_chain_id = l.evm_chain_id AND f.address = l.address AND l.event_sig = ANY(f.event_sigs)
+		)
+    `, o.evmChainID)
+	return errors.Wrap(err, "failed to prune log poller logs")
+}
+
+func (o *orm) DeleteConsumedLogPollerLogs(before int64, savedBefore time.Time, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	// A log is kept until the job of every filter matching it has consumed
+	// it, since a log delivered to a listener is lost if the node stops
+	// before the listener consumes it
+	_, err := q.Exec(`
+        DELETE FROM log_poller_logs l
+		WHERE l.evm_chain_id = $1
+		AND l.block_number < $2
+		AND (l.created_at < $3 OR NOT EXISTS (
+			SELECT 1 FROM log_poller_filters f
+			WHERE f.evm_chain_id = l.evm_chain_id
+			AND f.address = l.address
+			AND l.event_sig = ANY(f.event_sigs)
+			AND (f.start_block IS NULL OR l.block_number >= f.start_block)
+			AND NOT EXISTS (
+				SELECT 1 FROM log_broadcasts b
+				WHERE b.evm_chain_id = l.evm_chain_id
+				AND b.job_id = f.job_id
+				AND b.block_hash = l.block_hash
+				AND b.log_index = l.log_index
+				AND b.consumed
+			)
+		))
+    `, o.evmChainID, before, savedBefore)
+	return errors.Wrap(err, "failed to delete consumed log poller logs")
+}
+
+func (o *orm) InsertJobReplay(replay *JobReplay, keep int, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	err := q.QueryRowx(`
+        INSERT INTO job_replays (evm_chain_id, job_id, contract, from_block, to_block, ignore_consumed, block_number, logs_delivered, state, error, created_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), $11, $12)
+		RETURNING id
+    `, o.evmChainID, replay.JobID, replay.Contract, replay.FromBlock, replay.ToBlock, replay.IgnoreConsumed, replay.BlockNumber,
+		replay.LogsDelivered, replay.State, replay.Error, replay.CreatedAt, replay.FinishedAt).Scan(&replay.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert job replay")
+	}
+	_, err = q.Exec(`
+        DELETE FROM job_replays
+		WHERE evm_chain_id = $1 AND state <> $2 AND id <= (
+			SELECT id FROM job_replays WHERE evm_chain_id = $1 ORDER BY id DESC OFFSET $3 LIMIT 1
+		)
+    `, o.evmChainID, JobReplayStateInProgress, keep)
+	return errors.Wrap(err, "failed to delete old job replays")
+}
+
+func (o *orm) UpdateJobReplay(replay JobReplay, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	_, err := q.Exec(`
+        UPDATE job_replays SET block_number = $2, logs_delivered = $3, state = $4, error = NULLIF($5, ''), finished_at = $6
+		WHERE id = $1
+    `, replay.ID, replay.BlockNumber, replay.LogsDelivered, replay.State, replay.Error, replay.FinishedAt)
+	return errors.Wrap(err, "failed to update job replay")
+}
+
+func (o *orm) AbortJobReplays(reason string, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	_, err := q.Exec(`
+        UPDATE job_replays SET state = $2, error = $3, finished_at = NOW()
+		WHERE evm_chain_id = $1 AND state = $4
+    `, o.evmChainID, JobReplayStateErrored, reason, JobReplayStateInProgress)
+	return errors.Wrap(err, "failed to abort job replays")
+}
+
+func (o *orm) SelectJobReplays(jobID *int32, limit int, qopts ...postgres.QOpt) (replays []JobReplay, err error) {
+	q := postgres.NewQ(o.db, qopts...)
+	err = q.Select(&replays, `
+        SELECT id, evm_chain_id, job_id, contract, from_block, to_block, ignore_consumed, block_number, logs_delivered, state,
+			COALESCE(error, '') AS error, created_at, finished_at
+		FROM job_replays
+		WHERE evm_chain_id = $1 AND ($2::int4 IS NULL OR job_id = $2)
+		ORDER BY id DESC
+		LIMIT $3
+    `, o.evmChainID, jobID, limit)
+	return replays, errors.Wrap(err, "failed to select job replays")
+}
+
+// logPollerLog is a row of the log_poller_logs table
+type logPollerLog struct {
+	EVMChainID  utils.Big      `db:"evm_chain_id"`
+	BlockHash   common.Hash    `db:"block_hash"`
+	BlockNumber int64          `db:"block_number"`
+	LogIndex    int64          `db:"log_index"`
+	Address     common.Address `db:"address"`
+	EventSig    common.Hash    `db:"event_sig"`
+	Topics      pq.ByteaArray  `db:"topics"`
+	TxHash      common.Hash    `db:"tx_hash"`
+	TxIndex     int64          `db:"tx_index"`
+	Data        []byte         `db:"data"`
+}
+
+func newLogPollerLog(log types.Log, evmChainID utils.Big) logPollerLog {
+	data := log.Data
+	if data == nil {
+		data = []byte{}
+	}
+	return logPollerLog{
+		EVMChainID:  evmChainID,
+		BlockHash:   log.BlockHash,
+		BlockNumber: int64(log.BlockNumber),
+		LogIndex:    int64(log.Index),
+		Address:     log.Address,
+		EventSig:    log.Topics[0],
+		Topics:      hashesToBytes(log.Topics),
+		TxHash:      log.TxHash,
+		TxIndex:     int64(log.TxIndex),
+		Data:        data,
+	}
+}
+
+func logPollerLogsToLogs(rows []logPollerLog) []types.Log {
+	logs := make([]types.Log, len(rows))
+	for i, row := range rows {
+		topics := make([]common.Hash, len(row.Topics))
+		for j, topic := range row.Topics {
+			topics[j] = common.BytesToHash(topic)
+		}
+		logs[i] = types.Log{
+			Address:     row.Address,
+			Topics:      topics,
+			Data:        row.Data,
+			BlockNumber: uint64(row.BlockNumber),
+			TxHash:      row.TxHash,
+			TxIndex:     uint(row.TxIndex),
+			BlockHash:   row.BlockHash,
+			Index:       uint(row.LogIndex),
+		}
+	}
+	return logs
+}
+
+func hashesToBytes(hashes []common.Hash) [][]byte {
+	bs := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		bs[i] = hash.Bytes()
+	}
+	return bs
+}
+
 // LogBroadcast - gorm-compatible receive data from log_broadcasts table columns
 type LogBroadcast struct {
 	BlockHash common.Hash
@@ -4,8 +4,10 @@ import (
 	"math/big"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
@@ -14,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestORM_broadcasts(t *testing.T) {
@@ -188,3 +191,258 @@ func TestORM_Reinitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestORM_LogPoller(t *testing.T) {
+	gdb := pgtest.NewGormDB(t)
+	db := postgres.UnwrapGormDB(gdb)
+	orm := log.NewORM(db, cltest.FixtureChainID)
+
+	jobID := cltest.MustInsertV2JobSpec(t, gdb, cltest.NewAddress()).ID
+	contract := cltest.NewAddress()
+	eventSig := utils.NewHash()
+	otherSig := utils.NewHash()
+
+	newLog := func(blockNumber uint64, index uint, sig common.Hash) types.Log {
+		return types.Log{
+			Address:     contract,
+			Topics:      []common.Hash{sig, utils.NewHash()},
+			Data:        []byte{1, 2, 3},
+			BlockNumber: blockNumber,
+			BlockHash:   common.BigToHash(big.NewInt(int64(blockNumber))),
+			TxHash:      utils.NewHash(),
+			Index:       index,
+		}
+	}
+	log10 := newLog(10, 0, eventSig)
+	log11a := newLog(11, 0, eventSig)
+	log11b := newLog(11, 1, eventSig)
+	otherLog11 := newLog(11, 2, otherSig)
+
+	filter, err := orm.UpsertLogPollerFilter(jobID, contract, []common.Hash{eventSig})
+	require.NoError(t, err)
+	assert.Equal(t, jobID, filter.JobID)
+	assert.Equal(t, contract, filter.Address)
+	assert.Equal(t, []common.Hash{eventSig}, filter.EventSigs)
+	assert.False(t, filter.StartBlock.Valid)
+	assert.False(t, filter.BlockNumber.Valid)
+
+	require.NoError(t, orm.InsertLogPollerLogs([]types.Log{log10, log11a}, map[int64]int64{filter.ID: 10}, 10))
+	// Inserting the same logs again is a no-op
+	require.NoError(t, orm.InsertLogPollerLogs([]types.Log{log11a, log11b, otherLog11}, map[int64]int64{filter.ID: 11}, 11))
+
+	filter, err = orm.UpsertLogPollerFilter(jobID, contract, []common.Hash{eventSig})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), filter.StartBlock.Int64)
+	assert.Equal(t, int64(11), filter.BlockNumber.Int64)
+
+	t.Run("SelectLogPollerLogsByBlockRange", func(t *testing.T) {
+		logs, err := orm.SelectLogPollerLogsByBlockRange(contract, []common.Hash{eventSig}, 11, 11)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, log11a, logs[0])
+		assert.Equal(t, log11b, logs[1])
+
+		logs, err = orm.SelectLogPollerLogsByBlockRange(contract, []common.Hash{otherSig}, 0, 20)
+		require.NoError(t, err)
+		assert.Len(t, logs, 1)
+	})
+
+	t.Run("SelectUnconsumedLogPollerLogs", func(t *testing.T) {
+		logs, err := orm.SelectUnconsumedLogPollerLogs(jobID, contract, log.FirstLogPosition, 11, 2)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, log10, logs[0])
+		assert.Equal(t, log11a, logs[1])
+
+		logs, err = orm.SelectUnconsumedLogPollerLogs(jobID, contract, log.NewLogPosition(log11a), 11, 2)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, log11b, logs[0])
+
+		require.NoError(t, orm.MarkBroadcastConsumed(log10.BlockHash, log10.BlockNumber, log10.Index, jobID))
+		logs, err = orm.SelectUnconsumedLogPollerLogs(jobID, contract, log.FirstLogPosition, 10, 2)
+		require.NoError(t, err)
+		assert.Len(t, logs, 0)
+	})
+
+	t.Run("LowestOrphanedLogPollerLog", func(t *testing.T) {
+		lowest, err := orm.LowestOrphanedLogPollerLog(10, 11, []common.Hash{log10.BlockHash, log11a.BlockHash})
+		require.NoError(t, err)
+		assert.Nil(t, lowest)
+
+		lowest, err = orm.LowestOrphanedLogPollerLog(10, 11, []common.Hash{log10.BlockHash})
+		require.NoError(t, err)
+		require.NotNil(t, lowest)
+		assert.Equal(t, int64(11), *lowest)
+	})
+
+	t.Run("InsertLogPollerBlocks", func(t *testing.T) {
+		blocks := []log.LogPollerBlock{
+			{BlockNumber: 9, BlockHash: utils.NewHash()},
+			{BlockNumber: 10, BlockHash: log10.BlockHash},
+			{BlockNumber: 11, BlockHash: log11a.BlockHash},
+		}
+		require.NoError(t, orm.InsertLogPollerBlocks(blocks, 10))
+
+		saved, err := orm.SelectLogPollerBlocks(0)
+		require.NoError(t, err)
+		assert.Equal(t, blocks[1:], saved)
+	})
+
+	t.Run("RewindLogPoller", func(t *testing.T) {
+		require.NoError(t, orm.RewindLogPoller(11))
+
+		logs, err := orm.SelectLogPollerLogsByBlockRange(contract, []common.Hash{eventSig, otherSig}, 0, 20)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, log10, logs[0])
+
+		blocks, err := orm.SelectLogPollerBlocks(0)
+		require.NoError(t, err)
+		require.Len(t, blocks, 1)
+		assert.Equal(t, int64(10), blocks[0].BlockNumber)
+
+		filter, err := orm.UpsertLogPollerFilter(jobID, contract, []common.Hash{eventSig})
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), filter.BlockNumber.Int64)
+	})
+
+	t.Run("PruneLogPollerLogs", func(t *testing.T) {
+		require.NoError(t, orm.InsertLogPollerLogs([]types.Log{newLog(11, 3, otherSig)}, map[int64]int64{filter.ID: 11}, 11))
+		require.NoError(t, orm.PruneLogPollerLogs())
+
+		logs, err := orm.SelectLogPollerLogsByBlockRange(contract, []common.Hash{eventSig, otherSig}, 0, 20)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, log10, logs[0])
+	})
+
+	t.Run("DeleteConsumedLogPollerLogs", func(t *testing.T) {
+		require.NoError(t, orm.InsertLogPollerLogs([]types.Log{log11a}, map[int64]int64{filter.ID: 11}, 11))
+		selectLogs := func() []types.Log {
+			logs, err := orm.SelectLogPollerLogsByBlockRange(contract, []common.Hash{eventSig}, 0, 20)
+			require.NoError(t, err)
+			return logs
+		}
+		markConsumed := func(l types.Log, jobID int32) {
+			require.NoError(t, orm.MarkBroadcastConsumed(l.BlockHash, l.BlockNumber, l.Index, jobID))
+		}
+		longAgo := time.Now().Add(-time.Hour)
+
+		// Logs which were not consumed are kept
+		require.NoError(t, orm.DeleteConsumedLogPollerLogs(20, longAgo))
+		assert.Equal(t, []types.Log{log10, log11a}, selectLogs())
+
+		// Consumed logs before the given block are deleted
+		markConsumed(log10, jobID)
+		markConsumed(log11a, jobID)
+		require.NoError(t, orm.DeleteConsumedLogPollerLogs(11, longAgo))
+		assert.Equal(t, []types.Log{log11a}, selectLogs())
+
+		// Logs are kept while the job of any filter matching them has not
+		// consumed them
+		otherJobID := cltest.MustInsertV2JobSpec(t, gdb, cltest.NewAddress()).ID
+		_, err := orm.UpsertLogPollerFilter(otherJobID, contract, []common.Hash{eventSig})
+		require.NoError(t, err)
+		require.NoError(t, orm.DeleteConsumedLogPollerLogs(20, longAgo))
+		assert.Equal(t, []types.Log{log11a}, selectLogs())
+
+		markConsumed(log11a, otherJobID)
+		require.NoError(t, orm.DeleteConsumedLogPollerLogs(20, longAgo))
+		assert.Len(t, selectLogs(), 0)
+
+		// Logs saved before the retention period are deleted, consumed or not
+		require.NoError(t, orm.InsertLogPollerLogs([]types.Log{log11b}, map[int64]int64{filter.ID: 11}, 11))
+		require.NoError(t, orm.DeleteConsumedLogPollerLogs(20, time.Now().Add(time.Minute)))
+		assert.Len(t, selectLogs(), 0)
+	})
+}
+
+func TestORM_JobReplays(t *testing.T) {
+	gdb := pgtest.NewGormDB(t)
+	db := postgres.UnwrapGormDB(gdb)
+	orm := log.NewORM(db, cltest.FixtureChainID)
+
+	jobID := cltest.MustInsertV2JobSpec(t, gdb, cltest.NewAddress()).ID
+	otherJobID := cltest.MustInsertV2JobSpec(t, gdb, cltest.NewAddress()).ID
+	contract := cltest.NewAddress()
+
+	newReplay := func(jobID int32) log.JobReplay {
+		return log.JobReplay{
+			JobReplayOpts: log.JobReplayOpts{JobID: jobID, Contract: &contract, FromBlock: 10, IgnoreConsumed: true},
+			ToBlock:       20,
+			State:         log.JobReplayStateInProgress,
+			CreatedAt:     time.Now(),
+		}
+	}
+
+	replay := newReplay(jobID)
+	require.NoError(t, orm.InsertJobReplay(&replay, 2))
+	assert.NotZero(t, replay.ID)
+
+	t.Run("UpdateJobReplay", func(t *testing.T) {
+		now := time.Now()
+		replay.BlockNumber.Int64, replay.BlockNumber.Valid = 14, true
+		replay.LogsDelivered = 3
+		replay.State = log.JobReplayStateErrored
+		replay.Error = "boom"
+		replay.FinishedAt = &now
+		require.NoError(t, orm.UpdateJobReplay(replay))
+
+		replays, err := orm.SelectJobReplays(nil, 10)
+		require.NoError(t, err)
+		require.Len(t, replays, 1)
+		assert.Equal(t, replay.ID, replays[0].ID)
+		assert.Equal(t, jobID, replays[0].JobID)
+		assert.Equal(t, contract, *replays[0].Contract)
+		assert.Equal(t, int64(10), replays[0].FromBlock)
+		assert.Equal(t, int64(20), replays[0].ToBlock)
+		assert.True(t, replays[0].IgnoreConsumed)
+		assert.Equal(t, cltest.FixtureChainID.String(), replays[0].EVMChainID.String())
+		assert.Equal(t, int64(14), replays[0].BlockNumber.Int64)
+		assert.Equal(t, int64(3), replays[0].LogsDelivered)
+		assert.Equal(t, log.JobReplayStateErrored, replays[0].State)
+		assert.Equal(t, "boom", replays[0].Error)
+		assert.NotNil(t, replays[0].FinishedAt)
+	})
+
+	t.Run("SelectJobReplays", func(t *testing.T) {
+		other := newReplay(otherJobID)
+		other.Contract = nil
+		require.NoError(t, orm.InsertJobReplay(&other, 2))
+
+		replays, err := orm.SelectJobReplays(nil, 10)
+		require.NoError(t, err)
+		require.Len(t, replays, 2)
+		assert.Equal(t, other.ID, replays[0].ID, "expected replays latest first")
+		assert.Nil(t, replays[0].Contract)
+		assert.Equal(t, "", replays[0].Error)
+
+		replays, err = orm.SelectJobReplays(&jobID, 10)
+		require.NoError(t, err)
+		require.Len(t, replays, 1)
+		assert.Equal(t, replay.ID, replays[0].ID)
+	})
+
+	t.Run("InsertJobReplay deletes the oldest finished replays", func(t *testing.T) {
+		latest := newReplay(jobID)
+		require.NoError(t, orm.InsertJobReplay(&latest, 2))
+
+		replays, err := orm.SelectJobReplays(nil, 10)
+		require.NoError(t, err)
+		require.Len(t, replays, 2)
+		assert.Equal(t, latest.ID, replays[0].ID)
+	})
+
+	t.Run("AbortJobReplays", func(t *testing.T) {
+		require.NoError(t, orm.AbortJobReplays("interrupted"))
+
+		replays, err := orm.SelectJobReplays(nil, 10)
+		require.NoError(t, err)
+		for _, replay := range replays {
+			assert.Equal(t, log.JobReplayStateErrored, replay.State)
+			assert.Equal(t, "interrupted", replay.Error)
+			assert.NotNil(t, replay.FinishedAt)
+		}
+	})
+}
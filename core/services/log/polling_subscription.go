@@ -0,0 +1,163 @@
+package log
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// pollingSubscription is used in place of a log subscription when no node with
+// a websocket url is available. It calls eth_getLogs for the blocks since the
+// last poll at a fixed interval, and persists the last polled block so that
+// no logs are missed across restarts.
+type pollingSubscription struct {
+	ethClient eth.Client
+	orm       ORM
+	config    Config
+	logger    logger.Logger
+	query     ethereum.FilterQuery
+
+	// the last block which logs have been fetched for
+	cursor int64
+
+	chRawLogs chan types.Log
+	chErr     chan error
+	chStop    chan struct{}
+	wgDone    sync.WaitGroup
+}
+
+var _ managedSubscription = (*pollingSubscription)(nil)
+
+func (sub *ethSubscriber) newPollingSubscription(ctx context.Context, query ethereum.FilterQuery) (*pollingSubscription, error) {
+	cursor, err := sub.orm.GetPollCursor()
+	if err != nil {
+		return nil, err
+	}
+	if cursor == nil {
+		// Like a subscription, start from the current head
+		latestBlock, err := sub.ethClient.HeadByNumber(ctx, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not fetch latest block header")
+		} else if latestBlock == nil {
+			return nil, errors.New("got nil block header")
+		}
+		latest := latestBlock.Number - 1
+		cursor = &latest
+	}
+
+	p := &pollingSubscription{
+		ethClient: sub.ethClient,
+		orm:       sub.orm,
+		config:    sub.config,
+		logger:    sub.logger,
+		query:     query,
+		cursor:    *cursor,
+		chRawLogs: make(chan types.Log),
+		chErr:     make(chan error),
+		chStop:    make(chan struct{}),
+	}
+	p.wgDone.Add(1)
+	go p.run()
+	return p, nil
+}
+
+func (p *pollingSubscription) run() {
+	defer p.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(p.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(p.config.EvmPollInterval())
+	defer ticker.Stop()
+
+	for {
+		if err := p.poll(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Warnw("LogBroadcaster: Failed to poll for logs, will retry", "err", err, "fromBlock", p.cursor+1)
+		}
+
+		select {
+		case <-p.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches and sends the logs from the block after the cursor up to the
+// latest block, in batches of EvmLogBackfillBatchSize blocks, advancing the
+// cursor after each batch
+func (p *pollingSubscription) poll(ctx context.Context) error {
+	ctxHead, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	latestBlock, err := p.ethClient.HeadByNumber(ctxHead, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch latest block header")
+	} else if latestBlock == nil {
+		return errors.New("got nil block header")
+	}
+	latestHeight := latestBlock.Number
+
+	batchSize := int64(p.config.EvmLogBackfillBatchSize())
+	for from := p.cursor + 1; from <= latestHeight; from += batchSize {
+		to := from + batchSize - 1
+		if to > latestHeight {
+			to = latestHeight
+		}
+		q := p.query
+		q.FromBlock = big.NewInt(from)
+		q.ToBlock = big.NewInt(to)
+
+		ctxLogs, cancel := eth.DefaultQueryCtx(ctx)
+		logs, err := p.ethClient.FilterLogs(ctxLogs, q)
+		cancel()
+		if err != nil {
+			return errors.Wrap(err, "could not fetch logs")
+		}
+
+		for _, log := range logs {
+			if log.Removed {
+				// Each block is only polled once, so a log which was reorged
+				// out by the time it was fetched has never been sent
+				p.logger.Debugw("LogBroadcaster: Dropping polled log which was removed", "blockNumber", log.BlockNumber, "blockHash", log.BlockHash, "txHash", log.TxHash, "logIndex", log.Index)
+				continue
+			}
+			select {
+			case p.chRawLogs <- log:
+			case <-p.chStop:
+				return nil
+			}
+		}
+
+		p.cursor = to
+		if err := p.orm.SetPollCursor(to); err != nil {
+			// The cursor is kept in memory, so only a restart could miss logs
+			p.logger.Errorw("LogBroadcaster: Failed to persist poll cursor", "err", err, "blockNumber", to)
+		}
+	}
+	return nil
+}
+
+// Err returns a channel which is never written to, as polling errors are
+// retried on the next tick
+func (p *pollingSubscription) Err() <-chan error {
+	return p.chErr
+}
+
+func (p *pollingSubscription) Logs() chan types.Log {
+	return p.chRawLogs
+}
+
+func (p *pollingSubscription) Unsubscribe() {
+	close(p.chStop)
+	p.wgDone.Wait()
+	close(p.chRawLogs)
+}
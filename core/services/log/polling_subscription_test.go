@@ -0,0 +1,114 @@
+package log_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	logmocks "github.com/smartcontractkit/chainlink/core/services/log/mocks"
+)
+
+func TestPollingSubscription(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(t *testing.T) *logmocks.Config {
+		config := new(logmocks.Config)
+		config.Test(t)
+		config.On("EvmPollInterval").Return(10 * time.Millisecond)
+		config.On("EvmLogBackfillBatchSize").Return(uint32(2))
+		return config
+	}
+	query := ethereum.FilterQuery{Addresses: []common.Address{cltest.NewAddress()}}
+	blockRange := func(from, to int64) interface{} {
+		return mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+			return q.FromBlock.Cmp(big.NewInt(from)) == 0 && q.ToBlock.Cmp(big.NewInt(to)) == 0 &&
+				assert.ObjectsAreEqual(query.Addresses, q.Addresses)
+		})
+	}
+
+	t.Run("resumes from the persisted cursor in batches", func(t *testing.T) {
+		config := newConfig(t)
+		orm := new(logmocks.ORM)
+		orm.Test(t)
+		cursor := int64(10)
+		orm.On("GetPollCursor").Return(&cursor, nil).Once()
+		orm.On("SetPollCursor", int64(12)).Return(nil).Once()
+		orm.On("SetPollCursor", int64(13)).Return(nil).Once()
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(cltest.Head(13), nil)
+		log1 := types.Log{BlockNumber: 11, Index: 1}
+		log2 := types.Log{BlockNumber: 13, Index: 2}
+		ethClient.On("FilterLogs", mock.Anything, blockRange(11, 12)).Return([]types.Log{log1}, nil).Once()
+		ethClient.On("FilterLogs", mock.Anything, blockRange(13, 13)).Return([]types.Log{log2}, nil).Once()
+
+		sub, err := log.NewTestPollingSubscription(orm, ethClient, config, logger.TestLogger(t), query)
+		require.NoError(t, err)
+
+		assert.Equal(t, log1, <-sub.Logs())
+		assert.Equal(t, log2, <-sub.Logs())
+
+		// Polling again with no new head fetches nothing
+		time.Sleep(50 * time.Millisecond)
+		sub.Unsubscribe()
+
+		ethClient.AssertExpectations(t)
+		orm.AssertExpectations(t)
+	})
+
+	t.Run("drops removed logs", func(t *testing.T) {
+		config := newConfig(t)
+		orm := new(logmocks.ORM)
+		orm.Test(t)
+		cursor := int64(10)
+		orm.On("GetPollCursor").Return(&cursor, nil).Once()
+		orm.On("SetPollCursor", int64(12)).Return(nil).Once()
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(cltest.Head(12), nil)
+		removed := types.Log{BlockNumber: 11, Index: 1, Removed: true}
+		log1 := types.Log{BlockNumber: 12, Index: 2}
+		ethClient.On("FilterLogs", mock.Anything, blockRange(11, 12)).Return([]types.Log{removed, log1}, nil).Once()
+
+		sub, err := log.NewTestPollingSubscription(orm, ethClient, config, logger.TestLogger(t), query)
+		require.NoError(t, err)
+
+		assert.Equal(t, log1, <-sub.Logs())
+		sub.Unsubscribe()
+
+		ethClient.AssertExpectations(t)
+		orm.AssertExpectations(t)
+	})
+
+	t.Run("starts from the latest head without a cursor", func(t *testing.T) {
+		config := newConfig(t)
+		orm := new(logmocks.ORM)
+		orm.Test(t)
+		orm.On("GetPollCursor").Return(nil, nil).Once()
+		orm.On("SetPollCursor", int64(20)).Return(nil).Once()
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(cltest.Head(20), nil)
+		log1 := types.Log{BlockNumber: 20}
+		ethClient.On("FilterLogs", mock.Anything, blockRange(20, 20)).Return([]types.Log{log1}, nil).Once()
+
+		sub, err := log.NewTestPollingSubscription(orm, ethClient, config, logger.TestLogger(t), query)
+		require.NoError(t, err)
+
+		assert.Equal(t, log1, <-sub.Logs())
+		sub.Unsubscribe()
+
+		_, open := <-sub.Logs()
+		assert.False(t, open)
+	})
+}
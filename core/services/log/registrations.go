@@ -15,6 +15,7 @@ import (
 )
 
 // 1. Each listener being registered can specify a custom NumConfirmations - number of block confirmations required for any log being sent to it.
+// Alternatively it can specify a ConfirmationTag, in which case logs are sent to it once their block is at or below the latest block with the tag.
 //
 // 2. All received logs are kept in an array and deleted ONLY after they are outside the confirmation range for all subscribers
 // (when given log height is lower than (latest height - max(highestNumConfirmations, ETH_FINALITY_DEPTH)) ) -> see: pool.go
@@ -41,6 +42,9 @@ type (
 		// highest 'NumConfirmations' per all listeners, used to decide about deleting older logs if it's higher than EvmFinalityDepth
 		// it's: max(listeners.map(l => l.num_confirmations)
 		highestNumConfirmations uint64
+
+		// subscribers by confirmation tag, for listeners which wait for a tagged block instead of a number of confirmations
+		taggedSubscribers map[eth.BlockTag]*subscribers
 	}
 
 	subscribers struct {
@@ -63,10 +67,11 @@ type (
 
 func newRegistrations(logger logger.Logger, evmChainID big.Int) *registrations {
 	return &registrations{
-		subscribers: make(map[uint64]*subscribers),
-		decoders:    make(map[common.Address]ParseLogFunc),
-		evmChainID:  evmChainID,
-		logger:      logger,
+		subscribers:       make(map[uint64]*subscribers),
+		taggedSubscribers: make(map[eth.BlockTag]*subscribers),
+		decoders:          make(map[common.Address]ParseLogFunc),
+		evmChainID:        evmChainID,
+		logger:            logger,
 	}
 }
 
@@ -74,6 +79,13 @@ func (r *registrations) addSubscriber(reg registration) (needsResubscribe bool)
 	addr := reg.opts.Contract
 	r.decoders[addr] = reg.opts.ParseLog
 
+	if tag := reg.opts.ConfirmationTag; tag != "" {
+		if _, exists := r.taggedSubscribers[tag]; !exists {
+			r.taggedSubscribers[tag] = newSubscribers(r.evmChainID)
+		}
+		return r.taggedSubscribers[tag].addSubscriber(reg)
+	}
+
 	if _, exists := r.subscribers[reg.opts.NumConfirmations]; !exists {
 		r.subscribers[reg.opts.NumConfirmations] = newSubscribers(r.evmChainID)
 	}
@@ -89,6 +101,18 @@ func (r *registrations) addSubscriber(reg registration) (needsResubscribe bool)
 }
 
 func (r *registrations) removeSubscriber(reg registration) (needsResubscribe bool) {
+	if tag := reg.opts.ConfirmationTag; tag != "" {
+		subscribers, exists := r.taggedSubscribers[tag]
+		if !exists {
+			return
+		}
+		needsResubscribe = subscribers.removeSubscriber(reg)
+		if len(subscribers.handlers) == 0 {
+			delete(r.taggedSubscribers, tag)
+		}
+		return
+	}
+
 	subscribers, exists := r.subscribers[reg.opts.NumConfirmations]
 	if !exists {
 		return
@@ -115,10 +139,42 @@ func (r *registrations) resetHighestNumConfirmationsValue() {
 	r.highestNumConfirmations = highestNumConfirmations
 }
 
+func (r *registrations) hasTaggedSubscribers() bool {
+	return len(r.taggedSubscribers) > 0
+}
+
+// lowestTaggedBlockNumber returns the lowest number of the tagged blocks that
+// subscribers wait for, if known for the given head
+func (r *registrations) lowestTaggedBlockNumber(head eth.Head) (lowest int64, exists bool) {
+	for tag := range r.taggedSubscribers {
+		n := head.TagNumber(tag)
+		if !n.Valid {
+			continue
+		}
+		if !exists || n.Int64 < lowest {
+			lowest = n.Int64
+			exists = true
+		}
+	}
+	return
+}
+
+// allSubscribers returns the subscribers for each number of confirmations and each tag
+func (r *registrations) allSubscribers() []*subscribers {
+	all := make([]*subscribers, 0, len(r.subscribers)+len(r.taggedSubscribers))
+	for _, sub := range r.subscribers {
+		all = append(all, sub)
+	}
+	for _, sub := range r.taggedSubscribers {
+		all = append(all, sub)
+	}
+	return all
+}
+
 func (r *registrations) addressesAndTopics() ([]common.Address, []common.Hash) {
 	var addresses []common.Address
 	var topics []common.Hash
-	for _, sub := range r.subscribers {
+	for _, sub := range r.allSubscribers() {
 		add, t := sub.addressesAndTopics()
 		addresses = append(addresses, add...)
 		topics = append(topics, t...)
@@ -127,7 +183,7 @@ func (r *registrations) addressesAndTopics() ([]common.Address, []common.Hash) {
 }
 
 func (r *registrations) isAddressRegistered(address common.Address) bool {
-	for _, sub := range r.subscribers {
+	for _, sub := range r.allSubscribers() {
 		if sub.isAddressRegistered(address) {
 			return true
 		}
@@ -162,6 +218,17 @@ func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead eth.Head,
 				subscribers.sendLog(log, latestHead, broadcastsExisting, r.decoders, bc, r.logger)
 			}
 		}
+
+		for tag, subscribers := range r.taggedSubscribers {
+			tagNumber := latestHead.TagNumber(tag)
+			if !tagNumber.Valid || int64(logsPerBlock.BlockNumber) > tagNumber.Int64 {
+				continue
+			}
+
+			for _, log := range logsPerBlock.Logs {
+				subscribers.sendLog(log, latestHead, broadcastsExisting, r.decoders, bc, r.logger)
+			}
+		}
 	}
 }
 
@@ -317,12 +384,12 @@ func (r *subscribers) sendLog(log types.Log, latestHead eth.Head,
 		go func() {
 			defer wg.Done()
 			listener.HandleLog(&broadcast{
-				latestBlockNumber,
-				latestHead.Hash,
-				decodedLog,
-				logCopy,
-				jobID,
-				r.evmChainID,
+				latestBlockNumber: latestBlockNumber,
+				latestBlockHash:   latestHead.Hash,
+				decodedLog:        decodedLog,
+				rawLog:            logCopy,
+				jobID:             jobID,
+				evmChainID:        r.evmChainID,
 			})
 		}()
 	}
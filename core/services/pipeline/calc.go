@@ -0,0 +1,538 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	maxCalcExpressionLength = 4096
+	maxCalcExpressionDepth  = 64
+	// maxCalcExponent bounds the exponents of number literals and the decimal
+	// places of round, as larger ones force huge rescales of the operands
+	maxCalcExponent = 256
+)
+
+var ErrCalcExpression = errors.New("invalid calc expression")
+
+// CalcExpression is a parsed arithmetic/boolean expression, as evaluated by
+// the calc task. It can only reference pipeline variables and a handful of
+// builtin functions, there is no way to call out of the sandbox.
+//
+// Grammar, from lowest to highest precedence:
+//
+//	expr    = or
+//	or      = and { "||" and }
+//	and     = compare { "&&" compare }
+//	compare = sum [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) sum ]
+//	sum     = product { ( "+" | "-" ) product }
+//	product = unary { ( "*" | "/" | "%" ) unary }
+//	unary   = ( "-" | "!" ) unary | primary
+//	primary = number | "true" | "false" | "$(" keypath ")" | func "(" expr { "," expr } ")" | "(" expr ")"
+//	func    = "min" | "max" | "abs" | "round"
+type CalcExpression struct {
+	root calcNode
+}
+
+// ParseCalcExpression parses and checks the expression without evaluating it
+func ParseCalcExpression(expr string) (*CalcExpression, error) {
+	if len(expr) > maxCalcExpressionLength {
+		return nil, errors.Wrapf(ErrCalcExpression, "expression is longer than %v characters", maxCalcExpressionLength)
+	}
+	tokens, err := lexCalcExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &calcParser{tokens: tokens}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != calcTokenEOF {
+		return nil, errors.Wrapf(ErrCalcExpression, "unexpected %v at position %v", tok, tok.pos)
+	}
+	return &CalcExpression{root: root}, nil
+}
+
+// Evaluate returns a decimal.Decimal or a bool. If precision is not nil,
+// divisions are rounded to that many decimal places.
+func (e *CalcExpression) Evaluate(vars Vars, precision *int32) (interface{}, error) {
+	return e.root.eval(calcContext{vars: vars, precision: precision})
+}
+
+type calcContext struct {
+	vars      Vars
+	precision *int32
+}
+
+type calcNode interface {
+	eval(ctx calcContext) (interface{}, error)
+}
+
+type (
+	calcLiteral struct {
+		value interface{}
+	}
+	calcVariable struct {
+		keypath string
+	}
+	calcUnary struct {
+		op      string
+		operand calcNode
+	}
+	calcBinary struct {
+		op          string
+		left, right calcNode
+	}
+	calcCall struct {
+		name string
+		args []calcNode
+	}
+)
+
+func (n calcLiteral) eval(calcContext) (interface{}, error) {
+	return n.value, nil
+}
+
+func (n calcVariable) eval(ctx calcContext) (interface{}, error) {
+	val, err := ctx.vars.Get(n.keypath)
+	if err != nil {
+		return nil, err
+	}
+	if b, is := val.(bool); is {
+		return b, nil
+	}
+	var d DecimalParam
+	if err = d.UnmarshalPipelineParam(val); err != nil {
+		return nil, errors.Wrapf(err, "$(%v)", n.keypath)
+	}
+	if exp := d.Decimal().Exponent(); exp > maxCalcExponent || exp < -maxCalcExponent {
+		return nil, errors.Wrapf(ErrBadInput, "$(%v) is out of range, exponents are limited to ±%d", n.keypath, maxCalcExponent)
+	}
+	return d.Decimal(), nil
+}
+
+func (n calcUnary) eval(ctx calcContext) (interface{}, error) {
+	val, err := n.operand.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "-":
+		d, err := calcDecimal(n.op, val)
+		if err != nil {
+			return nil, err
+		}
+		return d.Neg(), nil
+	case "!":
+		b, err := calcBool(n.op, val)
+		if err != nil {
+			return nil, err
+		}
+		return !b, nil
+	}
+	panic("unreachable")
+}
+
+func (n calcBinary) eval(ctx calcContext) (interface{}, error) {
+	left, err := n.left.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || short circuit, so the right hand side is only evaluated if needed
+	if n.op == "&&" || n.op == "||" {
+		l, err := calcBool(n.op, left)
+		if err != nil {
+			return nil, err
+		}
+		if l == (n.op == "||") {
+			return l, nil
+		}
+		right, err := n.right.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return calcBool(n.op, right)
+	}
+
+	right, err := n.right.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.op == "==" || n.op == "!=" {
+		lb, lIsBool := left.(bool)
+		rb, rIsBool := right.(bool)
+		if lIsBool != rIsBool {
+			return nil, errors.Wrapf(ErrBadInput, "operator %v cannot compare a bool with a number", n.op)
+		}
+		if lIsBool {
+			return (lb == rb) == (n.op == "=="), nil
+		}
+	}
+
+	l, err := calcDecimal(n.op, left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := calcDecimal(n.op, right)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "+":
+		return l.Add(r), nil
+	case "-":
+		return l.Sub(r), nil
+	case "*":
+		return l.Mul(r), nil
+	case "/":
+		if r.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "division by zero")
+		}
+		if ctx.precision != nil {
+			return l.DivRound(r, *ctx.precision), nil
+		}
+		// Note that decimal library defaults to rounding to 16 precision
+		return l.Div(r), nil
+	case "%":
+		if r.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "division by zero")
+		}
+		return l.Mod(r), nil
+	case "==":
+		return l.Equal(r), nil
+	case "!=":
+		return !l.Equal(r), nil
+	case "<":
+		return l.LessThan(r), nil
+	case "<=":
+		return l.LessThanOrEqual(r), nil
+	case ">":
+		return l.GreaterThan(r), nil
+	case ">=":
+		return l.GreaterThanOrEqual(r), nil
+	}
+	panic("unreachable")
+}
+
+// calcFunctions maps the builtin functions to their minimum and maximum number
+// of arguments (-1 for unlimited)
+var calcFunctions = map[string][2]int{
+	"min":   {1, -1},
+	"max":   {1, -1},
+	"abs":   {1, 1},
+	"round": {1, 2},
+}
+
+func (n calcCall) eval(ctx calcContext) (interface{}, error) {
+	args := make([]decimal.Decimal, len(n.args))
+	for i, arg := range n.args {
+		val, err := arg.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if args[i], err = calcDecimal(n.name, val); err != nil {
+			return nil, err
+		}
+	}
+	switch n.name {
+	case "min":
+		return decimal.Min(args[0], args[1:]...), nil
+	case "max":
+		return decimal.Max(args[0], args[1:]...), nil
+	case "abs":
+		return args[0].Abs(), nil
+	case "round":
+		var places int32
+		if len(args) == 2 {
+			if !args[1].Equal(args[1].Truncate(0)) {
+				return nil, errors.Wrapf(ErrBadInput, "round: decimal places must be an integer, got %v", args[1])
+			}
+			if args[1].Abs().GreaterThan(decimal.NewFromInt(maxCalcExponent)) {
+				return nil, errors.Wrapf(ErrBadInput, "round: decimal places must be between -%d and %d, got %v", maxCalcExponent, maxCalcExponent, args[1])
+			}
+			places = int32(args[1].IntPart())
+		}
+		return args[0].Round(places), nil
+	}
+	panic("unreachable")
+}
+
+func calcDecimal(op string, val interface{}) (decimal.Decimal, error) {
+	d, is := val.(decimal.Decimal)
+	if !is {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "%v expects a number, got %v", op, val)
+	}
+	return d, nil
+}
+
+func calcBool(op string, val interface{}) (bool, error) {
+	b, is := val.(bool)
+	if !is {
+		return false, errors.Wrapf(ErrBadInput, "%v expects a bool, got %v", op, val)
+	}
+	return b, nil
+}
+
+type calcTokenKind int
+
+const (
+	calcTokenEOF calcTokenKind = iota
+	calcTokenNumber
+	calcTokenIdent
+	calcTokenVariable
+	calcTokenOperator
+)
+
+type calcToken struct {
+	kind  calcTokenKind
+	value string
+	pos   int
+}
+
+func (t calcToken) String() string {
+	if t.kind == calcTokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("'%v'", t.value)
+}
+
+var calcOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "+", "-", "*", "/", "%", "!", "(", ")", ","}
+
+func lexCalcExpression(expr string) ([]calcToken, error) {
+	var tokens []calcToken
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+
+		case c == '$':
+			match := variableRegexp.FindStringSubmatchIndex(expr[i:])
+			if match == nil || match[0] != 0 {
+				return nil, errors.Wrapf(ErrCalcExpression, "invalid variable at position %v", i)
+			}
+			tokens = append(tokens, calcToken{calcTokenVariable, expr[i+match[2] : i+match[3]], i})
+			i += match[1]
+
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(expr) && (unicode.IsDigit(rune(expr[i])) || expr[i] == '.') {
+				i++
+			}
+			// exponent, e.g. 1e18 or 2.5E-3
+			if i < len(expr) && (expr[i] == 'e' || expr[i] == 'E') {
+				i++
+				if i < len(expr) && (expr[i] == '+' || expr[i] == '-') {
+					i++
+				}
+				for i < len(expr) && unicode.IsDigit(rune(expr[i])) {
+					i++
+				}
+			}
+			tokens = append(tokens, calcToken{calcTokenNumber, expr[start:i], start})
+
+		case unicode.IsLetter(c) || c == '_':
+			start := i
+			for i < len(expr) && (unicode.IsLetter(rune(expr[i])) || unicode.IsDigit(rune(expr[i])) || expr[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, calcToken{calcTokenIdent, expr[start:i], start})
+
+		default:
+			var op string
+			for _, o := range calcOperators {
+				if strings.HasPrefix(expr[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, errors.Wrapf(ErrCalcExpression, "unexpected character '%c' at position %v", c, i)
+			}
+			tokens = append(tokens, calcToken{calcTokenOperator, op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, calcToken{kind: calcTokenEOF, pos: len(expr)}), nil
+}
+
+type calcParser struct {
+	tokens []calcToken
+	pos    int
+	depth  int
+}
+
+func (p *calcParser) peek() calcToken {
+	return p.tokens[p.pos]
+}
+
+func (p *calcParser) next() calcToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != calcTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is one of the given operators
+func (p *calcParser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != calcTokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.value == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *calcParser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		tok := p.peek()
+		return errors.Wrapf(ErrCalcExpression, "expected '%v' at position %v, got %v", op, tok.pos, tok)
+	}
+	return nil
+}
+
+func (p *calcParser) parseExpr() (calcNode, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxCalcExpressionDepth {
+		return nil, errors.Wrapf(ErrCalcExpression, "expression is nested more than %v levels deep", maxCalcExpressionDepth)
+	}
+	return p.parseBinary(0)
+}
+
+// calcPrecedence lists the binary operators, from lowest to highest precedence
+var calcPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *calcParser) parseBinary(level int) (calcNode, error) {
+	if level == len(calcPrecedence) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(calcPrecedence[level]...)
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = calcBinary{op: op, left: left, right: right}
+		// comparisons don't chain, a < b < c is an error
+		if level == 2 {
+			if tok := p.peek(); tok.kind == calcTokenOperator {
+				for _, cmp := range calcPrecedence[level] {
+					if tok.value == cmp {
+						return nil, errors.Wrapf(ErrCalcExpression, "comparisons cannot be chained, at position %v", tok.pos)
+					}
+				}
+			}
+			return left, nil
+		}
+	}
+}
+
+func (p *calcParser) parseUnary() (calcNode, error) {
+	if op, ok := p.accept("-", "!"); ok {
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxCalcExpressionDepth {
+			return nil, errors.Wrapf(ErrCalcExpression, "expression is nested more than %v levels deep", maxCalcExpressionDepth)
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return calcUnary{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *calcParser) parsePrimary() (calcNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case calcTokenNumber:
+		d, err := decimal.NewFromString(tok.value)
+		if err != nil {
+			return nil, errors.Wrapf(ErrCalcExpression, "invalid number %v at position %v", tok, tok.pos)
+		}
+		if exp := d.Exponent(); exp > maxCalcExponent || exp < -maxCalcExponent {
+			return nil, errors.Wrapf(ErrCalcExpression, "number %v at position %v is out of range, exponents are limited to ±%d", tok, tok.pos, maxCalcExponent)
+		}
+		return calcLiteral{value: d}, nil
+
+	case calcTokenVariable:
+		if _, err := newKeypathFromString(tok.value); err != nil {
+			return nil, errors.Wrapf(ErrCalcExpression, "invalid variable at position %v: %v", tok.pos, err)
+		}
+		return calcVariable{keypath: tok.value}, nil
+
+	case calcTokenIdent:
+		switch tok.value {
+		case "true":
+			return calcLiteral{value: true}, nil
+		case "false":
+			return calcLiteral{value: false}, nil
+		}
+		arity, exists := calcFunctions[tok.value]
+		if !exists {
+			return nil, errors.Wrapf(ErrCalcExpression, "unknown function %v at position %v", tok, tok.pos)
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		var args []calcNode
+		for {
+			arg, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		if len(args) < arity[0] || (arity[1] >= 0 && len(args) > arity[1]) {
+			return nil, errors.Wrapf(ErrCalcExpression, "wrong number of arguments to %v at position %v", tok.value, tok.pos)
+		}
+		return calcCall{name: tok.value, args: args}, nil
+
+	case calcTokenOperator:
+		if tok.value == "(" {
+			node, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrCalcExpression, "unexpected %v at position %v", tok, tok.pos)
+}
@@ -56,6 +56,7 @@ type (
 		JobPipelineMaxRunDuration() time.Duration
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
+		JobPipelineSecretsFile() string
 	}
 )
 
@@ -78,6 +79,10 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+
+	// taskRuns are the task runs of pipelines nested within the task, such as
+	// the body of a foreach
+	taskRuns []TaskRun
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -169,6 +174,8 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// Skipped is true if the task was on a branch that was not taken
+	Skipped bool
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -195,9 +202,13 @@ func (trrs TaskRunResults) FinalResult() FinalResult {
 	for _, trr := range trrs {
 		fr.AllErrors = append(fr.AllErrors, trr.Result.Error)
 		if trr.IsTerminal() {
+			found = true
+			// terminal tasks on branches that were not taken have no result
+			if trr.Skipped {
+				continue
+			}
 			fr.Values = append(fr.Values, trr.Result.Value)
 			fr.FatalErrors = append(fr.FatalErrors, trr.Result.Error)
-			found = true
 		}
 	}
 
@@ -205,6 +216,11 @@ func (trrs TaskRunResults) FinalResult() FinalResult {
 		logger.Errorw("expected at least one task to be final", "tasks", trrs)
 		panic("expected at least one task to be final")
 	}
+	// a run whose terminal tasks were all skipped completes with no result
+	if len(fr.FatalErrors) == 0 {
+		fr.Values = []interface{}{nil}
+		fr.FatalErrors = []error{nil}
+	}
 	return fr
 }
 
@@ -319,6 +335,9 @@ const (
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
 	TaskTypeETHABIDecodeLog  TaskType = "ethabidecodelog"
 	TaskTypeMerge            TaskType = "merge"
+	TaskTypeConditional      TaskType = "conditional"
+	TaskTypeCalc             TaskType = "calc"
+	TaskTypeForEach          TaskType = "foreach"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -326,6 +345,12 @@ const (
 	TaskTypeFail  TaskType = "fail"
 )
 
+// taskValidator is implemented by tasks which check their parameters when the
+// pipeline is parsed, so that mistakes are caught at job validation time
+type taskValidator interface {
+	validate() error
+}
+
 var (
 	stringType     = reflect.TypeOf("")
 	bytesType      = reflect.TypeOf([]byte(nil))
@@ -345,59 +370,14 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 
 	taskType = TaskType(strings.ToLower(string(taskType)))
 
-	var task Task
-	switch taskType {
-	case TaskTypePanic:
-		task = &PanicTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeHTTP:
-		task = &HTTPTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeBridge:
-		task = &BridgeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeMean:
-		task = &MeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeMedian:
-		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeMode:
-		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeSum:
-		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeAny:
-		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeJSONParse:
-		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeMemo:
-		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeMultiply:
-		task = &MultiplyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeDivide:
-		task = &DivideTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeVRF:
-		task = &VRFTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeVRFV2:
-		task = &VRFTaskV2{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeEstimateGasLimit:
-		task = &EstimateGasLimitTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeETHCall:
-		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeETHTx:
-		task = &ETHTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeETHABIEncode:
-		task = &ETHABIEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeETHABIEncode2:
-		task = &ETHABIEncodeTask2{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeETHABIDecode:
-		task = &ETHABIDecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeETHABIDecodeLog:
-		task = &ETHABIDecodeLogTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeCBORParse:
-		task = &CBORParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeFail:
-		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	case TaskTypeMerge:
-		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
-	default:
+	r, exists := lookupTaskType(taskType)
+	if !exists {
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
+	task := r.factory(BaseTask{id: ID, dotID: dotID})
+	if task == nil || task.Type() != taskType {
+		return nil, errors.Errorf(`task type "%v" is registered with a factory returning a task of another type`, taskType)
+	}
 
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		Result:           task,
@@ -425,6 +405,11 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 	if err != nil {
 		return nil, err
 	}
+	if v, ok := task.(taskValidator); ok {
+		if err = v.validate(); err != nil {
+			return nil, errors.Wrapf(err, "task %v", dotID)
+		}
+	}
 	return task, nil
 }
 
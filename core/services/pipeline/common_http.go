@@ -18,6 +18,7 @@ func makeHTTPRequest(
 	method StringParam,
 	url URLParam,
 	requestData MapParam,
+	reqHeaders map[string]string,
 	allowUnrestrictedNetworkAccess BoolParam,
 	cfg Config,
 ) ([]byte, int, http.Header, time.Duration, error) {
@@ -39,6 +40,9 @@ func makeHTTPRequest(
 		return nil, 0, nil, 0, errors.Wrap(err, "failed to create http.Request")
 	}
 	request.Header.Set("Content-Type", "application/json")
+	for name, value := range reqHeaders {
+		request.Header.Set(name, value)
+	}
 
 	httpRequest := utils.HTTPRequest{
 		Request: request,
@@ -0,0 +1,178 @@
+package pipeline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// RunEventType is the lifecycle step of a pipeline run that an event reports
+type RunEventType string
+
+const (
+	RunEventRunCreated   RunEventType = "run_created"
+	RunEventTaskStarted  RunEventType = "task_started"
+	RunEventTaskFinished RunEventType = "task_finished"
+	RunEventRunFinished  RunEventType = "run_finished"
+)
+
+// RunEvent is a live notification about a pipeline run of a job. Events are
+// not stored, they are only sent to the subscribers present at the time.
+//
+// RunEventRunCreated is only sent once a run was saved and has an ID. Runs
+// which are only saved once they finish have no ID while executing, so their
+// task events have a zero RunID and come before RunEventRunCreated, which is
+// followed right away by RunEventRunFinished. Runs which are never saved have
+// only task events.
+type RunEvent struct {
+	Type           RunEventType `json:"type"`
+	JobID          int32        `json:"jobId"`
+	PipelineSpecID int32        `json:"pipelineSpecId"`
+	RunID          int64        `json:"runId,omitempty"`
+	Time           time.Time    `json:"time"`
+
+	// set for task events
+	DotID    string   `json:"dotId,omitempty"`
+	TaskType TaskType `json:"taskType,omitempty"`
+	Attempt  uint     `json:"attempt,omitempty"`
+
+	// set for finished events, Output holds the outputs of the run for
+	// RunEventRunFinished and Errors its fatal errors
+	State  RunStatus         `json:"state,omitempty"`
+	Output *JSONSerializable `json:"output,omitempty"`
+	Error  string            `json:"error,omitempty"`
+	Errors RunErrors         `json:"errors,omitempty"`
+}
+
+// RunEventSubscription receives the run events of a job, or of all jobs.
+// Events are dropped rather than delayed when the subscriber falls behind.
+type RunEventSubscription interface {
+	Events() <-chan RunEvent
+	Close()
+}
+
+// runEventBufferSize is the number of events a subscription holds before
+// dropping new ones
+const runEventBufferSize = 100
+
+type runEventBroadcaster struct {
+	subscriptions   map[*runEventSubscription]struct{}
+	subscriptionsMu sync.RWMutex
+	lggr            logger.Logger
+}
+
+func newRunEventBroadcaster(lggr logger.Logger) *runEventBroadcaster {
+	return &runEventBroadcaster{
+		subscriptions: make(map[*runEventSubscription]struct{}),
+		lggr:          lggr,
+	}
+}
+
+// subscribe returns a subscription to the events of the job, or of all jobs
+// if jobID is nil
+func (b *runEventBroadcaster) subscribe(jobID *int32) *runEventSubscription {
+	sub := &runEventSubscription{
+		broadcaster: b,
+		chEvents:    make(chan RunEvent, runEventBufferSize),
+	}
+	if jobID != nil {
+		id := *jobID
+		sub.jobID = &id
+	}
+
+	b.subscriptionsMu.Lock()
+	defer b.subscriptionsMu.Unlock()
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+func (b *runEventBroadcaster) removeSubscription(sub *runEventSubscription) {
+	b.subscriptionsMu.Lock()
+	defer b.subscriptionsMu.Unlock()
+	delete(b.subscriptions, sub)
+}
+
+func (b *runEventBroadcaster) publish(event RunEvent) {
+	b.subscriptionsMu.RLock()
+	defer b.subscriptionsMu.RUnlock()
+
+	for sub := range b.subscriptions {
+		if sub.jobID != nil && *sub.jobID != event.JobID {
+			continue
+		}
+		select {
+		case sub.chEvents <- event:
+		default:
+			b.lggr.Warnw("Pipeline run event subscriber is falling behind, dropping event", "type", event.Type, "jobID", event.JobID, "runID", event.RunID)
+		}
+	}
+}
+
+type runEventSubscription struct {
+	broadcaster *runEventBroadcaster
+	jobID       *int32
+	chEvents    chan RunEvent
+	closeOnce   sync.Once
+}
+
+var _ RunEventSubscription = (*runEventSubscription)(nil)
+
+func (sub *runEventSubscription) Events() <-chan RunEvent {
+	return sub.chEvents
+}
+
+// Close unsubscribes and closes the events channel
+func (sub *runEventSubscription) Close() {
+	sub.closeOnce.Do(func() {
+		// the channel is only closed once no more events can be published to it
+		sub.broadcaster.removeSubscription(sub)
+		close(sub.chEvents)
+	})
+}
+
+// newRunEvent returns an event of the run, without any task or result fields
+func newRunEvent(typ RunEventType, run *Run) RunEvent {
+	return RunEvent{
+		Type:           typ,
+		JobID:          run.PipelineSpec.JobID,
+		PipelineSpecID: run.PipelineSpecID,
+		RunID:          run.ID,
+		Time:           time.Now(),
+	}
+}
+
+// newRunFinishedEvent returns the event of a run which finished executing
+func newRunFinishedEvent(run *Run) RunEvent {
+	event := newRunEvent(RunEventRunFinished, run)
+	event.State = run.State
+	outputs := run.Outputs
+	event.Output = &outputs
+	event.Errors = run.FatalErrors
+	return event
+}
+
+// newTaskStartedEvent returns the event of an attempt of a task run starting
+func newTaskStartedEvent(run *Run, taskRun *memoryTaskRun) RunEvent {
+	event := newRunEvent(RunEventTaskStarted, run)
+	event.DotID = taskRun.task.DotID()
+	event.TaskType = taskRun.task.Type()
+	event.Attempt = taskRun.attempts + 1
+	return event
+}
+
+// newTaskFinishedEvent returns the event of an attempt of a task run finishing
+func newTaskFinishedEvent(run *Run, result TaskRunResult, secrets Secrets) RunEvent {
+	event := newRunEvent(RunEventTaskFinished, run)
+	event.DotID = result.Task.DotID()
+	event.TaskType = result.Task.Type()
+	if result.Result.Error != nil {
+		event.State = RunStatusErrored
+		event.Error = secrets.RedactError(result.Result.Error).Error()
+	} else {
+		event.State = RunStatusCompleted
+		output := secrets.RedactOutput(result)
+		event.Output = &output
+	}
+	return event
+}
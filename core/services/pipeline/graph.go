@@ -27,6 +27,10 @@ func (g *Graph) NewNode() graph.Node {
 	return &GraphNode{Node: g.DirectedGraph.NewNode()}
 }
 
+func (g *Graph) NewEdge(from, to graph.Node) graph.Edge {
+	return &GraphEdge{Edge: g.DirectedGraph.NewEdge(from, to)}
+}
+
 func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	if g.DirectedGraph == nil {
 		g.DirectedGraph = simple.NewDirectedGraph()
@@ -93,6 +97,37 @@ func (n *GraphNode) Attributes() []encoding.Attribute {
 	return r
 }
 
+// GraphEdge holds the DOT attributes of an edge, such as the label which
+// selects the branch of a conditional task that the edge belongs to.
+type GraphEdge struct {
+	graph.Edge
+	attrs map[string]string
+}
+
+func (e *GraphEdge) SetAttribute(attr encoding.Attribute) error {
+	if e.attrs == nil {
+		e.attrs = make(map[string]string)
+	}
+	e.attrs[attr.Key] = bracketQuotedAttrRegexp.ReplaceAllString(attr.Value, "$1")
+	return nil
+}
+
+func (e *GraphEdge) Attributes() []encoding.Attribute {
+	var r []encoding.Attribute
+	for k, v := range e.attrs {
+		r = append(r, encoding.Attribute{Key: k, Value: v})
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Key < r[j].Key
+	})
+	return r
+}
+
+// Label returns the value of the edge's "label" attribute
+func (e *GraphEdge) Label() string {
+	return e.attrs["label"]
+}
+
 type Pipeline struct {
 	Tasks  []Task
 	tree   *Graph
@@ -136,6 +171,19 @@ func (p *Pipeline) RequiresPreInsert() bool {
 	return false
 }
 
+// HasSideEffects returns true for tasks that change state outside of the run,
+// which are stubbed out when a run is simulated
+func HasSideEffects(task Task) bool {
+	switch task.Type() {
+	case TaskTypeBridge:
+		return task.(*BridgeTask).Async == "true"
+	case TaskTypeETHTx:
+		return true
+	default:
+		return false
+	}
+}
+
 func (p *Pipeline) ByDotID(id string) Task {
 	for _, task := range p.Tasks {
 		if task.DotID() == id {
@@ -191,6 +239,14 @@ func Parse(text string) (*Pipeline, error) {
 
 			from.Base().outputs = append(from.Base().outputs, task)
 			task.Base().inputs = append(task.Base().inputs, from)
+
+			// Edge labels are only meaningful on the outputs of a conditional
+			// task, anywhere else they are ignored
+			if conditional, is := from.(*ConditionalTask); is {
+				if edge, is := g.Edge(inputs.Node().ID(), node.ID()).(*GraphEdge); is && edge.Label() != "" {
+					conditional.setBranch(task, edge.Label())
+				}
+			}
 		}
 
 		// This is subtle: g.To doesn't return nodes in deterministic order, which would occasionally swap the order
@@ -37,10 +37,18 @@ func (t *BridgeTask) HelperSetDependencies(config Config, db *sqlx.DB, id uuid.U
 	t.uuid = id
 }
 
+func (t *BridgeTask) HelperSetSimulated() {
+	t.simulated = true
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config) {
 	t.config = config
 }
 
+func (t *HTTPTask) HelperSetSecrets(secrets Secrets) {
+	t.secrets = secrets
+}
+
 func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config) {
 	t.chainSet = cc
 	t.config = config
@@ -158,6 +158,20 @@ func (_m *Config) JobPipelineReaperThreshold() time.Duration {
 	return r0
 }
 
+// JobPipelineSecretsFile provides a mock function with given fields:
+func (_m *Config) JobPipelineSecretsFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
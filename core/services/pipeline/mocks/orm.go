@@ -24,6 +24,43 @@ type ORM struct {
 	mock.Mock
 }
 
+// CancelRun provides a mock function with given fields: id
+func (_m *ORM) CancelRun(id int64) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelSuspendedRuns provides a mock function with given fields: jobID
+func (_m *ORM) CancelSuspendedRuns(jobID int32) ([]int64, error) {
+	ret := _m.Called(jobID)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(int32) []int64); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateRun provides a mock function with given fields: run, qopts
 func (_m *ORM) CreateRun(run *pipeline.Run, qopts ...postgres.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -20,6 +20,43 @@ type Runner struct {
 	mock.Mock
 }
 
+// CancelRun provides a mock function with given fields: runID
+func (_m *Runner) CancelRun(runID int64) error {
+	ret := _m.Called(runID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelSuspendedRuns provides a mock function with given fields: jobID
+func (_m *Runner) CancelSuspendedRuns(jobID int32) ([]int64, error) {
+	ret := _m.Called(jobID)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(int32) []int64); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -160,6 +197,27 @@ func (_m *Runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) erro
 	return r0
 }
 
+// RetryRun provides a mock function with given fields: ctx, runID, dotID, l
+func (_m *Runner) RetryRun(ctx context.Context, runID int64, dotID string, l logger.Logger) (int64, error) {
+	ret := _m.Called(ctx, runID, dotID, l)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, logger.Logger) int64); ok {
+		r0 = rf(ctx, runID, dotID, l)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, logger.Logger) error); ok {
+		r1 = rf(ctx, runID, dotID, l)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Run provides a mock function with given fields: ctx, run, l, saveSuccessfulTaskRuns, fn
 func (_m *Runner) Run(ctx context.Context, run *pipeline.Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(postgres.Queryer) error) (bool, error) {
 	ret := _m.Called(ctx, run, l, saveSuccessfulTaskRuns, fn)
@@ -181,6 +239,36 @@ func (_m *Runner) Run(ctx context.Context, run *pipeline.Run, l logger.Logger, s
 	return r0, r1
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, l
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, l logger.Logger) (pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, l)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, l)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 pipeline.TaskRunResults
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, l)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) error); ok {
+		r2 = rf(ctx, spec, vars, l)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields:
 func (_m *Runner) Start() error {
 	ret := _m.Called()
@@ -194,3 +282,19 @@ func (_m *Runner) Start() error {
 
 	return r0
 }
+
+// SubscribeToRunEvents provides a mock function with given fields: jobID
+func (_m *Runner) SubscribeToRunEvents(jobID *int32) pipeline.RunEventSubscription {
+	ret := _m.Called(jobID)
+
+	var r0 pipeline.RunEventSubscription
+	if rf, ok := ret.Get(0).(func(*int32) pipeline.RunEventSubscription); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.RunEventSubscription)
+		}
+	}
+
+	return r0
+}
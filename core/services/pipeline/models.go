@@ -50,9 +50,13 @@ type Run struct {
 	FinishedAt       null.Time        `json:"finishedAt"`
 	PipelineTaskRuns []TaskRun        `json:"taskRuns" gorm:"foreignkey:PipelineRunID;->"`
 	State            RunStatus        `json:"state"`
+	// RetryOfRunID is set on runs which retry an errored run
+	RetryOfRunID null.Int `json:"retryOfRunId"`
 
 	Pending   bool `gorm:"-"`
 	FailEarly bool `gorm:"-"`
+	// Simulated runs stub out tasks with side effects and are never stored
+	Simulated bool `gorm:"-"`
 }
 
 func (Run) TableName() string {
@@ -92,7 +96,9 @@ func (r Run) HasErrors() bool {
 
 // Status determines the status of the run.
 func (r *Run) Status() RunStatus {
-	if r.HasFatalErrors() {
+	if r.State == RunStatusCancelled {
+		return RunStatusCancelled
+	} else if r.HasFatalErrors() {
 		return RunStatusErrored
 	} else if r.FinishedAt.Valid {
 		return RunStatusCompleted
@@ -168,6 +174,7 @@ type TaskRun struct {
 	FinishedAt    null.Time        `json:"finishedAt"`
 	Index         int32            `json:"index"`
 	DotID         string           `json:"dotId"`
+	Skipped       bool             `json:"skipped"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -222,6 +229,8 @@ const (
 	RunStatusErrored RunStatus = "errored"
 	// RunStatusCompleted is used for when a run has successfully completed execution.
 	RunStatusCompleted RunStatus = "completed"
+	// RunStatusCancelled is used for when a run was cancelled before it could complete.
+	RunStatusCancelled RunStatus = "cancelled"
 )
 
 // Completed returns true if the status is RunStatusCompleted.
@@ -234,7 +243,12 @@ func (s RunStatus) Errored() bool {
 	return s == RunStatusErrored
 }
 
+// Cancelled returns true if the status is RunStatusCancelled.
+func (s RunStatus) Cancelled() bool {
+	return s == RunStatusCancelled
+}
+
 // Finished returns true if the status is final and can't be changed.
 func (s RunStatus) Finished() bool {
-	return s.Completed() || s.Errored()
+	return s.Completed() || s.Errored() || s.Cancelled()
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
@@ -16,6 +17,16 @@ import (
 
 var (
 	ErrNoSuchBridge = errors.New("no such bridge exists")
+	// ErrRunCancelled is recorded as the error of a cancelled run, and of its
+	// task runs that had not finished
+	ErrRunCancelled = errors.New("run cancelled")
+	// ErrRunNotCancellable is returned when cancelling a run that has already finished
+	ErrRunNotCancellable = errors.New("only running or suspended runs can be cancelled")
+	// ErrRunNotRetryable is returned when retrying a run that did not error
+	ErrRunNotRetryable = errors.New("only errored runs can be retried")
+	// ErrRetryTaskNotFound is returned when retrying a run from a task that is
+	// not part of its pipeline
+	ErrRetryTaskNotFound = errors.New("task not found in the pipeline of the run")
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -31,6 +42,8 @@ type ORM interface {
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
+	CancelRun(id int64) error
+	CancelSuspendedRuns(jobID int32) (ids []int64, err error)
 	DB() *sqlx.DB
 }
 
@@ -61,8 +74,8 @@ func (o *orm) CreateRun(run *Run, qopts ...postgres.QOpt) (err error) {
 
 	q := postgres.NewQ(o.db, qopts...)
 	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
-		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, inputs, created_at, state)
-		VALUES (:pipeline_spec_id, :meta, :inputs, :created_at, :state)
+		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, inputs, created_at, state, retry_of_run_id)
+		VALUES (:pipeline_spec_id, :meta, :inputs, :created_at, :state, :retry_of_run_id)
 		RETURNING id`
 
 		query, args, e := tx.BindNamed(sql, run)
@@ -84,8 +97,8 @@ func (o *orm) CreateRun(run *Run, qopts ...postgres.QOpt) (err error) {
 		}
 
 		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped);`
 		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
 		return err
 	})
@@ -98,14 +111,19 @@ func (o *orm) CreateRun(run *Run, qopts ...postgres.QOpt) (err error) {
 func (o *orm) StoreRun(run *Run, qopts ...postgres.QOpt) (restart bool, err error) {
 	q := postgres.NewQ(o.db, qopts...)
 	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+		// Lock the current run. This prevents races with /v2/resume and with cancellation
+		var state RunStatus
+		if err = tx.Get(&state, `SELECT state FROM pipeline_runs WHERE id = $1 FOR UPDATE;`, run.ID); err != nil {
+			return errors.Wrap(err, "StoreRun")
+		}
+		if state == RunStatusCancelled {
+			// The run was cancelled while it was executing, its results are discarded
+			run.State = RunStatusCancelled
+			return nil
+		}
+
 		finished := run.FinishedAt.Valid
 		if !finished {
-			// Lock the current run. This prevents races with /v2/resume
-			sql := `SELECT id FROM pipeline_runs WHERE id = $1 FOR UPDATE;`
-			if _, err = tx.Exec(sql, run.ID); err != nil {
-				return errors.Wrap(err, "StoreRun")
-			}
-
 			taskRuns := []TaskRun{}
 			// Reload task runs, we want to check for any changes while the run was ongoing
 			if err = sqlx.Select(tx, &taskRuns, `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = $1`, run.ID); err != nil {
@@ -139,7 +157,7 @@ func (o *orm) StoreRun(run *Run, qopts ...postgres.QOpt) (restart bool, err erro
 				return errors.Wrap(err, "StoreRun")
 			}
 		} else {
-			// Simply finish the run, no need to do any sort of locking
+			// Simply finish the run
 			if run.Outputs.Val == nil || len(run.FatalErrors) == 0 {
 				return errors.Errorf("run must have both Outputs and Errors, got Outputs: %#v, Errors: %#v", run.Outputs.Val, run.FatalErrors)
 			}
@@ -150,10 +168,10 @@ func (o *orm) StoreRun(run *Run, qopts ...postgres.QOpt) (restart bool, err erro
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at, skipped = EXCLUDED.skipped
 		RETURNING *;
 		`
 
@@ -243,8 +261,8 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 
 	q := postgres.NewQ(o.db, qopts...)
 	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
-		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, retry_of_run_id)
+		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :retry_of_run_id)
 		RETURNING id;`
 
 		query, args, e := tx.BindNamed(sql, run)
@@ -266,8 +284,8 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 		}
 
 		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped);`
 		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
 		return errors.Wrap(err, "failed to insert pipeline_task_runs")
 	})
@@ -336,6 +354,56 @@ func (o *orm) GetUnfinishedRuns(ctx context.Context, now time.Time, fn func(run
 	})
 }
 
+// CancelRun marks a running or suspended run, and its unfinished task runs, as
+// cancelled. Tasks which are still executing are not stopped, see Runner.CancelRun.
+func (o *orm) CancelRun(id int64) error {
+	q := postgres.NewQ(o.db)
+	err := q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+		var state RunStatus
+		if err := tx.Get(&state, `SELECT state FROM pipeline_runs WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return err
+		}
+		if state != RunStatusRunning && state != RunStatusSuspended {
+			return errors.Wrapf(ErrRunNotCancellable, "run %v is %v", id, state)
+		}
+		return cancelRuns(tx, []int64{id}, time.Now())
+	})
+	return errors.Wrap(err, "CancelRun failed")
+}
+
+// CancelSuspendedRuns cancels every suspended run of the job, returning the
+// IDs of the cancelled runs
+func (o *orm) CancelSuspendedRuns(jobID int32) (ids []int64, err error) {
+	q := postgres.NewQ(o.db)
+	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+		sql := `SELECT pipeline_runs.id FROM pipeline_runs
+		JOIN jobs ON (jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id)
+		WHERE jobs.id = $1 AND pipeline_runs.state = $2
+		ORDER BY pipeline_runs.id ASC
+		FOR UPDATE OF pipeline_runs`
+		if err = tx.Select(&ids, sql, jobID, RunStatusSuspended); err != nil {
+			return err
+		}
+		return cancelRuns(tx, ids, time.Now())
+	})
+	return ids, errors.Wrap(err, "CancelSuspendedRuns failed")
+}
+
+func cancelRuns(tx postgres.Queryer, ids []int64, now time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	runErrors := RunErrors{null.StringFrom(ErrRunCancelled.Error())}
+	outputs := JSONSerializable{Val: []interface{}{nil}, Valid: true}
+	sql := `UPDATE pipeline_runs SET state = $2, finished_at = $3, all_errors = $4, fatal_errors = $4, outputs = $5 WHERE id = ANY($1)`
+	if _, err := tx.Exec(sql, ids, RunStatusCancelled, now, runErrors, outputs); err != nil {
+		return errors.Wrap(err, "failed to cancel pipeline_runs")
+	}
+	sql = `UPDATE pipeline_task_runs SET error = $2, finished_at = $3 WHERE pipeline_run_id = ANY($1) AND finished_at IS NULL`
+	_, err := tx.Exec(sql, ids, ErrRunCancelled.Error(), now)
+	return errors.Wrap(err, "failed to cancel pipeline_task_runs")
+}
+
 // loads PipelineSpec and PipelineTaskRuns for Runs in exactly 2 queries
 func loadAssociations(q postgres.Queryer, runs []Run) error {
 	if len(runs) == 0 {
@@ -1,15 +1,18 @@
 package pipeline_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -348,3 +351,115 @@ func Test_PipelineORM_DeleteRun(t *testing.T) {
 	_, err = orm.FindRun(run.ID)
 	require.Error(t, err, "not found")
 }
+
+func Test_PipelineORM_CancelRun(t *testing.T) {
+	db, orm := setupORM(t)
+
+	run := mustInsertAsyncRun(t, orm, db)
+
+	now := time.Now()
+
+	run.PipelineTaskRuns = []pipeline.TaskRun{
+		// pending task
+		{
+			ID:            uuid.NewV4(),
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds1",
+			CreatedAt:     now,
+			FinishedAt:    null.Time{},
+		},
+		// finished task
+		{
+			ID:            uuid.NewV4(),
+			PipelineRunID: run.ID,
+			Type:          "median",
+			DotID:         "answer2",
+			Output:        pipeline.JSONSerializable{Val: 1, Valid: true},
+			CreatedAt:     now,
+			FinishedAt:    null.TimeFrom(now),
+		},
+	}
+	_, err := orm.StoreRun(run)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	require.NoError(t, orm.CancelRun(run.ID))
+
+	cancelled, err := orm.FindRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusCancelled, cancelled.State)
+	assert.Equal(t, pipeline.RunStatusCancelled, cancelled.Status())
+	assert.True(t, cancelled.FinishedAt.Valid)
+	assert.Equal(t, pipeline.RunErrors{null.StringFrom(pipeline.ErrRunCancelled.Error())}, cancelled.FatalErrors)
+
+	// only the pending task run is cancelled
+	ds1 := cancelled.ByDotID("ds1")
+	assert.Equal(t, null.StringFrom(pipeline.ErrRunCancelled.Error()), ds1.Error)
+	assert.True(t, ds1.FinishedAt.Valid)
+	answer2 := cancelled.ByDotID("answer2")
+	assert.False(t, answer2.Error.Valid)
+	assert.Equal(t, float64(1), answer2.Output.Val)
+
+	// storing the results of the run once it stops keeps it cancelled
+	run.ByDotID("ds1").Error = null.StringFrom("context canceled")
+	run.ByDotID("ds1").FinishedAt = null.TimeFrom(now)
+	run.FinishedAt = null.TimeFrom(now)
+	run.FatalErrors = pipeline.RunErrors{null.StringFrom("context canceled")}
+	run.Outputs = pipeline.JSONSerializable{Val: []interface{}{nil}, Valid: true}
+	run.State = pipeline.RunStatusErrored
+	restart, err := orm.StoreRun(run)
+	require.NoError(t, err)
+	require.False(t, restart)
+	require.Equal(t, pipeline.RunStatusCancelled, run.State)
+
+	cancelled, err = orm.FindRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusCancelled, cancelled.State)
+
+	err = orm.CancelRun(run.ID)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, pipeline.ErrRunNotCancellable))
+
+	err = orm.CancelRun(run.ID + 1000)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+}
+
+func Test_PipelineORM_CancelSuspendedRuns(t *testing.T) {
+	db, orm := setupORM(t)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	otherJob, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	insertRun := func(specID int32, state pipeline.RunStatus) int64 {
+		run := &pipeline.Run{
+			PipelineSpecID: specID,
+			State:          state,
+			CreatedAt:      time.Now(),
+		}
+		require.NoError(t, orm.CreateRun(run))
+		return run.ID
+	}
+	suspended := insertRun(jb.PipelineSpecID, pipeline.RunStatusSuspended)
+	running := insertRun(jb.PipelineSpecID, pipeline.RunStatusRunning)
+	otherSuspended := insertRun(otherJob.PipelineSpecID, pipeline.RunStatusSuspended)
+
+	ids, err := orm.CancelSuspendedRuns(jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{suspended}, ids)
+
+	for id, state := range map[int64]pipeline.RunStatus{
+		suspended:      pipeline.RunStatusCancelled,
+		running:        pipeline.RunStatusRunning,
+		otherSuspended: pipeline.RunStatusSuspended,
+	} {
+		run, err := orm.FindRun(id)
+		require.NoError(t, err)
+		assert.Equal(t, state, run.State)
+	}
+
+	ids, err = orm.CancelSuspendedRuns(jb.ID)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
@@ -0,0 +1,204 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// TaskFactory returns a new task of a registered type which embeds the given
+// base task. The parameters of the task are then decoded into it from the
+// attributes of its DOT node with mapstructure, like those of the built-in
+// tasks, so its fields are tagged the same way:
+//
+//	type MyTask struct {
+//	    pipeline.BaseTask `mapstructure:",squash"`
+//	    URL               string `json:"url"`
+//	}
+type TaskFactory func(base BaseTask) Task
+
+// TaskValidator checks a task of a registered type once it is decoded, in the
+// context of the type of the job it is part of. It is called by
+// job.ValidateSpec for the tasks of the job's pipeline.
+type TaskValidator func(task Task, jobType string) error
+
+// TaskDependencies are the services of the pipeline runner available to the
+// tasks of registered types, set on them by their TaskInitializer
+type TaskDependencies struct {
+	Config      Config
+	ORM         ORM
+	ChainSet    evm.ChainSet
+	ETHKeyStore ETHKeyStore
+	VRFKeyStore VRFKeyStore
+	Logger      logger.Logger
+}
+
+// SendHTTPRequest sends a JSON request with the limits and network access
+// restrictions of the node's config, like the http task does, and returns the
+// response body and status code
+func (d TaskDependencies) SendHTTPRequest(ctx context.Context, method string, u *url.URL, body map[string]interface{}, headers map[string]string) ([]byte, int, http.Header, error) {
+	response, statusCode, respHeaders, _, err := makeHTTPRequest(ctx, StringParam(method), URLParam(*u), body, headers,
+		BoolParam(d.Config.DefaultHTTPAllowUnrestrictedNetworkAccess()), d.Config)
+	return response, statusCode, respHeaders, err
+}
+
+// TaskInitializer sets the dependencies a task of a registered type needs on
+// it, before each run of a pipeline with the task
+type TaskInitializer func(task Task, deps TaskDependencies)
+
+// TaskTypeOpt sets an optional hook of a registered task type
+type TaskTypeOpt func(*taskTypeRegistration)
+
+// WithTaskValidator sets the validation hook of a registered task type
+func WithTaskValidator(validator TaskValidator) TaskTypeOpt {
+	return func(r *taskTypeRegistration) {
+		r.validator = validator
+	}
+}
+
+// WithTaskInitializer sets the dependency injection hook of a registered task
+// type
+func WithTaskInitializer(initializer TaskInitializer) TaskTypeOpt {
+	return func(r *taskTypeRegistration) {
+		r.initializer = initializer
+	}
+}
+
+type taskTypeRegistration struct {
+	factory     TaskFactory
+	validator   TaskValidator
+	initializer TaskInitializer
+	builtin     bool
+}
+
+var (
+	taskTypesMu sync.RWMutex
+	taskTypes   = make(map[TaskType]taskTypeRegistration)
+)
+
+// RegisterTaskType makes a task type usable in pipelines. Task types are
+// case-insensitive, and the Type method of the tasks returned by the factory
+// must return the task type in lower case. It is meant to be called from the
+// init function of the package implementing the task, and panics if the task
+// type is empty or already registered.
+func RegisterTaskType(taskType TaskType, factory TaskFactory, opts ...TaskTypeOpt) {
+	registerTaskType(taskType, factory, false, opts...)
+}
+
+func registerTaskType(taskType TaskType, factory TaskFactory, builtin bool, opts ...TaskTypeOpt) {
+	taskType = TaskType(strings.ToLower(string(taskType)))
+	if taskType == "" {
+		panic("pipeline: RegisterTaskType task type is empty")
+	}
+	if factory == nil {
+		panic(fmt.Sprintf("pipeline: RegisterTaskType factory of task type %q is nil", taskType))
+	}
+
+	r := taskTypeRegistration{factory: factory, builtin: builtin}
+	for _, opt := range opts {
+		opt(&r)
+	}
+
+	taskTypesMu.Lock()
+	defer taskTypesMu.Unlock()
+	if _, exists := taskTypes[taskType]; exists {
+		panic(fmt.Sprintf("pipeline: RegisterTaskType called twice for task type %q", taskType))
+	}
+	taskTypes[taskType] = r
+}
+
+// RegisteredTaskTypes returns the sorted names of all task types usable in
+// pipelines, built-in ones included
+func RegisteredTaskTypes() []TaskType {
+	taskTypesMu.RLock()
+	defer taskTypesMu.RUnlock()
+
+	types := make([]TaskType, 0, len(taskTypes))
+	for taskType := range taskTypes {
+		types = append(types, taskType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// IsCustomTaskType returns true if the task type was registered with
+// RegisterTaskType, rather than being built into the node
+func IsCustomTaskType(taskType TaskType) bool {
+	r, exists := lookupTaskType(taskType)
+	return exists && !r.builtin
+}
+
+func lookupTaskType(taskType TaskType) (taskTypeRegistration, bool) {
+	taskTypesMu.RLock()
+	defer taskTypesMu.RUnlock()
+	r, exists := taskTypes[taskType]
+	return r, exists
+}
+
+// ValidateTasks calls the validation hooks of the task types of the pipeline,
+// and of the pipelines nested in its foreach tasks, for a job of the given
+// type
+func ValidateTasks(p *Pipeline, jobType string) error {
+	for _, task := range p.Tasks {
+		if r, exists := lookupTaskType(task.Type()); exists && r.validator != nil {
+			if err := r.validator(task, jobType); err != nil {
+				return errors.Wrapf(err, "task %v", task.DotID())
+			}
+		}
+
+		if forEach, is := task.(*ForEachTask); is {
+			nested, err := forEach.parseDAG()
+			if err != nil {
+				return errors.Wrapf(err, "task %v: dag", task.DotID())
+			}
+			if err := ValidateTasks(nested, jobType); err != nil {
+				return errors.Wrapf(err, "task %v: dag", task.DotID())
+			}
+		}
+	}
+	return nil
+}
+
+func init() {
+	builtin := map[TaskType]TaskFactory{
+		TaskTypePanic:            func(base BaseTask) Task { return &PanicTask{BaseTask: base} },
+		TaskTypeHTTP:             func(base BaseTask) Task { return &HTTPTask{BaseTask: base} },
+		TaskTypeBridge:           func(base BaseTask) Task { return &BridgeTask{BaseTask: base} },
+		TaskTypeMean:             func(base BaseTask) Task { return &MeanTask{BaseTask: base} },
+		TaskTypeMedian:           func(base BaseTask) Task { return &MedianTask{BaseTask: base} },
+		TaskTypeMode:             func(base BaseTask) Task { return &ModeTask{BaseTask: base} },
+		TaskTypeSum:              func(base BaseTask) Task { return &SumTask{BaseTask: base} },
+		TaskTypeAny:              func(base BaseTask) Task { return &AnyTask{BaseTask: base} },
+		TaskTypeJSONParse:        func(base BaseTask) Task { return &JSONParseTask{BaseTask: base} },
+		TaskTypeMemo:             func(base BaseTask) Task { return &MemoTask{BaseTask: base} },
+		TaskTypeMultiply:         func(base BaseTask) Task { return &MultiplyTask{BaseTask: base} },
+		TaskTypeDivide:           func(base BaseTask) Task { return &DivideTask{BaseTask: base} },
+		TaskTypeVRF:              func(base BaseTask) Task { return &VRFTask{BaseTask: base} },
+		TaskTypeVRFV2:            func(base BaseTask) Task { return &VRFTaskV2{BaseTask: base} },
+		TaskTypeEstimateGasLimit: func(base BaseTask) Task { return &EstimateGasLimitTask{BaseTask: base} },
+		TaskTypeETHCall:          func(base BaseTask) Task { return &ETHCallTask{BaseTask: base} },
+		TaskTypeETHTx:            func(base BaseTask) Task { return &ETHTxTask{BaseTask: base} },
+		TaskTypeETHABIEncode:     func(base BaseTask) Task { return &ETHABIEncodeTask{BaseTask: base} },
+		TaskTypeETHABIEncode2:    func(base BaseTask) Task { return &ETHABIEncodeTask2{BaseTask: base} },
+		TaskTypeETHABIDecode:     func(base BaseTask) Task { return &ETHABIDecodeTask{BaseTask: base} },
+		TaskTypeETHABIDecodeLog:  func(base BaseTask) Task { return &ETHABIDecodeLogTask{BaseTask: base} },
+		TaskTypeCBORParse:        func(base BaseTask) Task { return &CBORParseTask{BaseTask: base} },
+		TaskTypeFail:             func(base BaseTask) Task { return &FailTask{BaseTask: base} },
+		TaskTypeMerge:            func(base BaseTask) Task { return &MergeTask{BaseTask: base} },
+		TaskTypeConditional:      func(base BaseTask) Task { return &ConditionalTask{BaseTask: base} },
+		TaskTypeCalc:             func(base BaseTask) Task { return &CalcTask{BaseTask: base} },
+		TaskTypeForEach:          func(base BaseTask) Task { return &ForEachTask{BaseTask: base} },
+	}
+	for taskType, factory := range builtin {
+		registerTaskType(taskType, factory, true)
+	}
+}
@@ -0,0 +1,118 @@
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+type greetTask struct {
+	pipeline.BaseTask `mapstructure:",squash"`
+	Name              string `json:"name"`
+	Times             int    `json:"times"`
+}
+
+func (t *greetTask) Type() pipeline.TaskType { return "greet" }
+
+func (t *greetTask) Run(context.Context, logger.Logger, pipeline.Vars, []pipeline.Result) (pipeline.Result, pipeline.RunInfo) {
+	return pipeline.Result{Value: "hello " + t.Name}, pipeline.RunInfo{}
+}
+
+// configuredGreetTask greets with the dependencies set by its initializer
+type configuredGreetTask struct {
+	greetTask `mapstructure:",squash"`
+	config    pipeline.Config
+}
+
+func (t *configuredGreetTask) Type() pipeline.TaskType { return "configuredgreet" }
+
+func (t *configuredGreetTask) Run(context.Context, logger.Logger, pipeline.Vars, []pipeline.Result) (pipeline.Result, pipeline.RunInfo) {
+	if t.config == nil {
+		return pipeline.Result{Error: errors.New("not initialized")}, pipeline.RunInfo{}
+	}
+	return pipeline.Result{Value: fmt.Sprintf("hello %s within %s", t.Name, t.config.DefaultHTTPTimeout())}, pipeline.RunInfo{}
+}
+
+func TestRegisterTaskType(t *testing.T) {
+	pipeline.RegisterTaskType("Greet", func(base pipeline.BaseTask) pipeline.Task { return &greetTask{BaseTask: base} })
+	assert.Contains(t, pipeline.RegisteredTaskTypes(), pipeline.TaskType("greet"))
+	assert.Contains(t, pipeline.RegisteredTaskTypes(), pipeline.TaskTypeHTTP)
+	assert.True(t, pipeline.IsCustomTaskType("greet"))
+	assert.False(t, pipeline.IsCustomTaskType(pipeline.TaskTypeHTTP))
+	assert.False(t, pipeline.IsCustomTaskType("nope"))
+
+	t.Run("decodes the params", func(t *testing.T) {
+		p, err := pipeline.Parse(`a [type=GREET name=satoshi times=2 retries=3]`)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 1)
+		task, is := p.Tasks[0].(*greetTask)
+		require.True(t, is)
+		assert.Equal(t, "a", task.DotID())
+		assert.Equal(t, "satoshi", task.Name)
+		assert.Equal(t, 2, task.Times)
+		assert.Equal(t, uint32(3), task.TaskRetries())
+	})
+
+	t.Run("runs", func(t *testing.T) {
+		orm := new(mocks.ORM)
+		orm.On("DB").Return(nil)
+		r, err := pipeline.NewRunner(orm, cltest.NewTestGeneralConfig(t), nil, nil, nil, logger.TestLogger(t))
+		require.NoError(t, err)
+		_, trrs, err := r.SimulateRun(context.Background(), pipeline.Spec{DotDagSource: `a [type=greet name=satoshi]`}, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Equal(t, "hello satoshi", trrs[0].Result.Value)
+	})
+
+	t.Run("initializes the tasks with the runner's dependencies", func(t *testing.T) {
+		var initialized []pipeline.Task
+		pipeline.RegisterTaskType("configuredgreet", func(base pipeline.BaseTask) pipeline.Task {
+			return &configuredGreetTask{greetTask: greetTask{BaseTask: base}}
+		}, pipeline.WithTaskInitializer(func(task pipeline.Task, deps pipeline.TaskDependencies) {
+			initialized = append(initialized, task)
+			task.(*configuredGreetTask).config = deps.Config
+		}))
+
+		orm := new(mocks.ORM)
+		orm.On("DB").Return(nil)
+		r, err := pipeline.NewRunner(orm, cltest.NewTestGeneralConfig(t), nil, nil, nil, logger.TestLogger(t))
+		require.NoError(t, err)
+		_, trrs, err := r.SimulateRun(context.Background(), pipeline.Spec{DotDagSource: `a [type=configuredgreet name=satoshi]`}, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+		assert.Equal(t, "hello satoshi within 15s", trrs[0].Result.Value)
+		assert.Len(t, initialized, 1)
+	})
+
+	t.Run("registered twice", func(t *testing.T) {
+		assert.Panics(t, func() {
+			pipeline.RegisterTaskType("greet", func(base pipeline.BaseTask) pipeline.Task { return &greetTask{BaseTask: base} })
+		})
+		assert.Panics(t, func() {
+			pipeline.RegisterTaskType(pipeline.TaskTypeHTTP, func(base pipeline.BaseTask) pipeline.Task { return &greetTask{BaseTask: base} })
+		})
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := pipeline.Parse(`a [type=nope]`)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown task type: "nope"`)
+	})
+
+	t.Run("factory returning another type", func(t *testing.T) {
+		pipeline.RegisterTaskType("greet2", func(base pipeline.BaseTask) pipeline.Task { return &greetTask{BaseTask: base} })
+		_, err := pipeline.Parse(`a [type=greet2]`)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "returning a task of another type")
+	})
+}
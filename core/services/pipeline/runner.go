@@ -32,10 +32,23 @@ type Runner interface {
 	// Note that `saveSuccessfulTaskRuns` value is ignored if the run contains async tasks.
 	Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(tx postgres.Queryer) error) (incomplete bool, err error)
 	ResumeRun(taskID uuid.UUID, value interface{}, err error) error
+	// CancelRun cancels a running or suspended run. Tasks of the run which are
+	// executing on this node have their context cancelled.
+	CancelRun(runID int64) error
+	// CancelSuspendedRuns cancels all suspended runs of a job and returns their IDs.
+	CancelSuspendedRuns(jobID int32) (runIDs []int64, err error)
+	// RetryRun executes a new run which retries an errored run from the task
+	// with the given dot ID, or from all errored tasks if dotID is empty. The
+	// results of the other tasks are kept. The new run is linked to the errored
+	// run by RetryOfRunID.
+	RetryRun(ctx context.Context, runID int64, dotID string, l logger.Logger) (retryRunID int64, err error)
 
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but tasks with
+	// side effects (see HasSideEffects) are stubbed out instead of executed.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) error
 
@@ -45,6 +58,11 @@ type Runner interface {
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
 	OnRunFinished(func(*Run))
+
+	// SubscribeToRunEvents returns a live stream of the lifecycle events of
+	// the runs of the job, or of all jobs if jobID is nil. Simulated runs have
+	// no events.
+	SubscribeToRunEvents(jobID *int32) RunEventSubscription
 }
 
 type runner struct {
@@ -53,12 +71,20 @@ type runner struct {
 	chainSet        evm.ChainSet
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
+	secrets         Secrets
 	runReaperWorker utils.SleeperTask
 	lggr            logger.Logger
 
 	// test helper
 	runFinished func(*Run)
 
+	// live lifecycle events of the runs of all jobs
+	events *runEventBroadcaster
+
+	// cancel functions of the stored runs executing on this node, by run ID
+	runCancelsMu sync.Mutex
+	runCancels   map[int64]context.CancelFunc
+
 	utils.StartStopOnce
 	chStop chan struct{}
 	wgDone sync.WaitGroup
@@ -94,22 +120,30 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger) *runner {
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger) (*runner, error) {
+	// jobs must not run with their secrets unresolved
+	secrets, err := LoadSecretsFile(config.JobPipelineSecretsFile())
+	if err != nil {
+		return nil, err
+	}
 	r := &runner{
 		orm:         orm,
 		config:      config,
 		chainSet:    chainSet,
 		ethKeyStore: ethks,
 		vrfKeyStore: vrfks,
+		secrets:     secrets,
 		chStop:      make(chan struct{}),
 		wgDone:      sync.WaitGroup{},
 		runFinished: func(*Run) {},
+		runCancels:  make(map[int64]context.CancelFunc),
 		lggr:        lggr.Named("PipelineRunner"),
 	}
+	r.events = newRunEventBroadcaster(r.lggr)
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperTaskFuncWorker(r.runReaper),
 	)
-	return r
+	return r, nil
 }
 
 func (r *runner) Start() error {
@@ -183,14 +217,51 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+func (r *runner) SubscribeToRunEvents(jobID *int32) RunEventSubscription {
+	return r.events.subscribe(jobID)
+}
+
+// finishRun is called once a run finished executing and was saved
+func (r *runner) finishRun(run *Run) {
+	r.runFinished(run)
+	if !run.Pending {
+		r.events.publish(newRunFinishedEvent(run))
+	}
+}
+
+// publishInsertedRun publishes the events of a run which is only saved once it
+// finished, as it has no ID until then
+func (r *runner) publishInsertedRun(run *Run) {
+	r.events.publish(newRunEvent(RunEventRunCreated, run))
+	r.events.publish(newRunFinishedEvent(run))
+}
+
 func (r *runner) ExecuteRun(
 	ctx context.Context,
 	spec Spec,
 	vars Vars,
 	l logger.Logger,
+) (Run, TaskRunResults, error) {
+	return r.executeRun(ctx, NewRun(spec, vars), vars, l)
+}
+
+func (r *runner) SimulateRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	l logger.Logger,
 ) (Run, TaskRunResults, error) {
 	run := NewRun(spec, vars)
+	run.Simulated = true
+	return r.executeRun(ctx, run, vars, l)
+}
 
+func (r *runner) executeRun(
+	ctx context.Context,
+	run Run,
+	vars Vars,
+	l logger.Logger,
+) (Run, TaskRunResults, error) {
 	pipeline, err := r.initializePipeline(&run)
 
 	if err != nil {
@@ -203,7 +274,7 @@ func (r *runner) ExecuteRun(
 	}
 
 	if run.Pending {
-		return run, nil, errors.Wrapf(err, "unexpected async run for spec ID %v, tried executing via ExecuteAndInsertFinishedRun", spec.ID)
+		return run, nil, errors.Wrapf(err, "unexpected async run for spec ID %v, tried executing via ExecuteAndInsertFinishedRun", run.PipelineSpec.ID)
 	}
 
 	return run, taskRunResults, nil
@@ -215,6 +286,22 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		return nil, err
 	}
 
+	r.initializeTasks(pipeline, run.PipelineSpec, run.Simulated)
+
+	// retain old UUID values
+	for _, taskRun := range run.PipelineTaskRuns {
+		if isNestedDotID(taskRun.DotID) {
+			continue
+		}
+		task := pipeline.ByDotID(taskRun.DotID)
+		task.Base().uuid = taskRun.ID
+	}
+
+	return pipeline, nil
+}
+
+// initializeTasks sets the runner's dependencies on the tasks that need them
+func (r *runner) initializeTasks(pipeline *Pipeline, spec Spec, simulated bool) {
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
 		task.Base().uuid = uuid.NewV4()
@@ -222,9 +309,11 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		switch task.Type() {
 		case TaskTypeHTTP:
 			task.(*HTTPTask).config = r.config
+			task.(*HTTPTask).secrets = r.secrets
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).queryer = r.orm.DB()
+			task.(*BridgeTask).simulated = simulated
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -237,17 +326,28 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).chainSet = r.chainSet
+		case TaskTypeForEach:
+			task.(*ForEachTask).runPipeline = func(ctx context.Context, p *Pipeline, vars Vars, l logger.Logger) TaskRunResults {
+				return r.runNested(ctx, spec, p, vars, simulated, l)
+			}
 		default:
 		}
-	}
 
-	// retain old UUID values
-	for _, taskRun := range run.PipelineTaskRuns {
-		task := pipeline.ByDotID(taskRun.DotID)
-		task.Base().uuid = taskRun.ID
+		if reg, exists := lookupTaskType(task.Type()); exists && reg.initializer != nil {
+			reg.initializer(task, r.taskDependencies())
+		}
 	}
+}
 
-	return pipeline, nil
+func (r *runner) taskDependencies() TaskDependencies {
+	return TaskDependencies{
+		Config:      r.config,
+		ORM:         r.orm,
+		ChainSet:    r.chainSet,
+		ETHKeyStore: r.ethKeyStore,
+		VRFKeyStore: r.vrfKeyStore,
+		Logger:      r.lggr,
+	}
 }
 
 func (r *runner) run(
@@ -259,34 +359,16 @@ func (r *runner) run(
 ) (TaskRunResults, error) {
 	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", run.PipelineSpec.JobID, "job name", run.PipelineSpec.JobName)
 
-	todo := context.TODO()
-	scheduler := newScheduler(todo, pipeline, run, vars)
-	go scheduler.Run()
-
-	for taskRun := range scheduler.taskCh {
-		// execute
-		go func(taskRun *memoryTaskRun) {
-			defer func() {
-				if err := recover(); err != nil {
-					l.Errorw("goroutine panicked executing run", "panic", err, "stacktrace", string(debug.Stack()))
-
-					t := time.Now()
-					scheduler.report(todo, TaskRunResult{
-						ID:         uuid.NewV4(),
-						Task:       taskRun.task,
-						Result:     Result{Error: ErrRunPanicked{err}},
-						FinishedAt: null.TimeFrom(t),
-						CreatedAt:  t, // TODO: more accurate start time
-					})
-				}
-			}()
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(todo, result)
-		}(taskRun)
+	scheduler := newScheduler(context.TODO(), pipeline, run, vars)
+	if !run.Simulated {
+		scheduler.onTaskStarted = func(taskRun *memoryTaskRun) {
+			r.events.publish(newTaskStartedEvent(run, taskRun))
+		}
+		scheduler.onTaskFinished = func(result TaskRunResult) {
+			r.events.publish(newTaskFinishedEvent(run, result, r.secrets))
+		}
 	}
+	r.executeScheduled(ctx, scheduler, run.PipelineSpec, l)
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -299,23 +381,26 @@ func (r *runner) run(
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime := run.FinishedAt.Time.Sub(run.CreatedAt)
 		l.Debugw("Finished all tasks for pipeline run", "specID", run.PipelineSpecID, "runTime", runTime)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if !run.Simulated {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
-		output := result.Result.OutputDB()
+		output := r.secrets.RedactOutput(result)
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
 			PipelineRunID: run.ID,
 			Type:          result.Task.Type(),
 			Index:         result.Task.OutputIndex(),
 			Output:        output,
-			Error:         result.Result.ErrorDB(),
+			Error:         r.secrets.RedactErrorDB(result.Result.ErrorDB()),
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Skipped:       result.Skipped,
 			task:          result.Task,
 		})
 
@@ -333,13 +418,18 @@ func (r *runner) run(
 			if result.Error.Valid {
 				errors = append(errors, result.Error)
 			}
-			// skip non-terminal results
-			if len(result.task.Outputs()) != 0 {
+			// skip non-terminal results, and terminal results on branches that were not taken
+			if len(result.task.Outputs()) != 0 || result.Skipped {
 				continue
 			}
 			fatalErrors = append(fatalErrors, result.Error)
 			outputs = append(outputs, result.Output.Val)
 		}
+		// a run whose terminal tasks were all skipped completes with no result
+		if len(fatalErrors) == 0 && len(run.PipelineTaskRuns) > 0 {
+			fatalErrors = append(fatalErrors, null.String{})
+			outputs = append(outputs, nil)
+		}
 		run.AllErrors = errors
 		run.FatalErrors = fatalErrors
 		run.Outputs = JSONSerializable{Val: outputs, Valid: true}
@@ -352,6 +442,19 @@ func (r *runner) run(
 		}
 	}
 
+	// The inputs of the run include the results of its tasks
+	if run.Inputs.Valid {
+		run.Inputs.Val = r.secrets.RedactValue(run.Inputs.Val)
+	}
+
+	// Task runs of nested pipelines are recorded after the run's own
+	for _, result := range scheduler.results {
+		for _, taskRun := range result.runInfo.taskRuns {
+			taskRun.PipelineRunID = run.ID
+			run.PipelineTaskRuns = append(run.PipelineTaskRuns, r.secrets.RedactTaskRun(taskRun))
+		}
+	}
+
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
 	for _, result := range scheduler.results {
@@ -361,6 +464,81 @@ func (r *runner) run(
 	return taskRunResults, nil
 }
 
+// executeScheduled executes the scheduler's task runs as they become ready,
+// until no further progress can be made
+func (r *runner) executeScheduled(ctx context.Context, scheduler *scheduler, spec Spec, l logger.Logger) {
+	todo := context.TODO()
+	go scheduler.Run()
+
+	for taskRun := range scheduler.taskCh {
+		// execute
+		go func(taskRun *memoryTaskRun) {
+			defer func() {
+				if err := recover(); err != nil {
+					l.Errorw("goroutine panicked executing run", "panic", err, "stacktrace", string(debug.Stack()))
+
+					t := time.Now()
+					scheduler.report(todo, TaskRunResult{
+						ID:         uuid.NewV4(),
+						Task:       taskRun.task,
+						Result:     Result{Error: ErrRunPanicked{err}},
+						FinishedAt: null.TimeFrom(t),
+						CreatedAt:  t, // TODO: more accurate start time
+					})
+				}
+			}()
+			if scheduler.onTaskStarted != nil {
+				scheduler.onTaskStarted(taskRun)
+			}
+			if scheduler.run.Simulated {
+				// simulated runs are not reported to prometheus, they are not part of any job
+				if HasSideEffects(taskRun.task) {
+					scheduler.report(todo, stubTaskRun(taskRun))
+				} else {
+					scheduler.report(todo, r.executeTaskRun(ctx, spec, taskRun, l))
+				}
+				return
+			}
+
+			result := r.executeTaskRun(ctx, spec, taskRun, l)
+
+			logTaskRunToPrometheus(result, spec)
+
+			scheduler.report(todo, result)
+		}(taskRun)
+	}
+}
+
+// runNested executes a pipeline nested within a task of a run, such as the
+// body of a foreach. Its results are returned to the task rather than stored.
+func (r *runner) runNested(ctx context.Context, spec Spec, pipeline *Pipeline, vars Vars, simulated bool, l logger.Logger) TaskRunResults {
+	r.initializeTasks(pipeline, spec, simulated)
+
+	run := NewRun(spec, vars)
+	run.Simulated = simulated
+	scheduler := newScheduler(context.TODO(), pipeline, &run, vars)
+	r.executeScheduled(ctx, scheduler, spec, l)
+
+	var taskRunResults TaskRunResults
+	for _, result := range scheduler.results {
+		taskRunResults = append(taskRunResults, result)
+	}
+	return taskRunResults
+}
+
+// stubTaskRun returns an empty result for a task that is not executed because
+// the run is simulated
+func stubTaskRun(taskRun *memoryTaskRun) TaskRunResult {
+	now := time.Now()
+	return TaskRunResult{
+		ID:         taskRun.task.Base().uuid,
+		Task:       taskRun.task,
+		Result:     Result{},
+		CreatedAt:  now,
+		FinishedAt: null.TimeFrom(now),
+	}
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -383,12 +561,14 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	// the result may contain secrets, e.g. echoed by a http response
+	redactedValue := r.secrets.RedactValue(result.Value)
 	loggerFields := []interface{}{"runInfo", runInfo,
-		"resultValue", result.Value,
-		"resultError", result.Error,
+		"resultValue", redactedValue,
+		"resultError", r.secrets.RedactError(result.Error),
 		"resultType", fmt.Sprintf("%T", result.Value),
 	}
-	switch v := result.Value.(type) {
+	switch v := redactedValue.(type) {
 	case []byte:
 		loggerFields = append(loggerFields, "resultString", fmt.Sprintf("%q", v))
 		loggerFields = append(loggerFields, "resultHex", fmt.Sprintf("%x", v))
@@ -441,6 +621,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err = r.orm.InsertFinishedRun(&run, saveSuccessfulTaskRuns); err != nil {
 		return 0, finalResult, errors.Wrapf(err, "error inserting finished results for spec ID %v", spec.ID)
 	}
+	r.publishInsertedRun(&run)
 	return run.ID, finalResult, nil
 
 }
@@ -452,13 +633,20 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 	}
 
 	preinsert := pipeline.RequiresPreInsert()
+	// runs are saved before they execute only if they have async tasks, and
+	// resumed runs are already saved
+	created := preinsert && run.ID == 0
 
 	err = postgres.NewQ(r.orm.DB(), postgres.WithParentCtx(ctx)).Transaction(r.lggr, func(tx postgres.Queryer) error {
 		// OPTIMISATION: avoid an extra db write if there is no async tasks present or if this is a resumed run
-		if preinsert && run.ID == 0 {
+		if created {
 			now := time.Now()
 			// initialize certain task params
 			for _, task := range pipeline.Tasks {
+				// a retried run already has the task runs it keeps
+				if run.ByDotID(task.DotID()) != nil {
+					continue
+				}
 				switch task.Type() {
 				case TaskTypeETHTx:
 					run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
@@ -486,14 +674,27 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 		return false, err
 	}
 
+	if created {
+		r.events.publish(newRunEvent(RunEventRunCreated, run))
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if run.ID != 0 {
+		r.trackRun(run.ID, cancel)
+		defer r.untrackRun(run.ID)
+	}
+	// runCtx is only cancelled on its own by CancelRun
+	cancelled := func() bool { return runCtx.Err() != nil && ctx.Err() == nil }
+
 	for {
-		if _, err = r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l); err != nil {
+		if _, err = r.run(runCtx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l); err != nil {
 			return false, errors.Wrapf(err, "failed to run for spec ID %v", run.PipelineSpec.ID)
 		}
 
 		if preinsert {
 			// if run failed and it's failEarly, skip StoreRun and instead delete all trace of it
-			if run.FailEarly {
+			if run.FailEarly && !cancelled() {
 				if err = r.orm.DeleteRun(run.ID); err != nil {
 					return false, errors.Wrap(err, "Run")
 				}
@@ -511,6 +712,11 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 				// instant restart: new data is already available in the database
 				continue
 			}
+
+			if run.State == RunStatusCancelled {
+				l.Debugw("Pipeline run was cancelled", "runID", run.ID)
+				run.Pending = false
+			}
 		} else {
 			if run.Pending {
 				return false, errors.Wrapf(err, "a run without async returned as pending")
@@ -523,9 +729,10 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 			if err = r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, postgres.WithParentCtx(ctx)); err != nil {
 				return false, errors.Wrapf(err, "error storing run for spec ID %v", run.PipelineSpec.ID)
 			}
+			r.events.publish(newRunEvent(RunEventRunCreated, run))
 		}
 
-		r.runFinished(run)
+		r.finishRun(run)
 
 		return run.Pending, err
 	}
@@ -554,8 +761,117 @@ func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error
 	return nil
 }
 
+func (r *runner) CancelRun(runID int64) error {
+	if err := r.orm.CancelRun(runID); err != nil {
+		return err
+	}
+	r.runCancelsMu.Lock()
+	cancel, exists := r.runCancels[runID]
+	r.runCancelsMu.Unlock()
+	if exists {
+		cancel()
+	}
+	return nil
+}
+
+func (r *runner) CancelSuspendedRuns(jobID int32) ([]int64, error) {
+	// suspended runs are not executing, so there is nothing else to cancel
+	return r.orm.CancelSuspendedRuns(jobID)
+}
+
+func (r *runner) RetryRun(ctx context.Context, runID int64, dotID string, l logger.Logger) (int64, error) {
+	run, err := r.orm.FindRun(runID)
+	if err != nil {
+		return 0, err
+	}
+	if run.State != RunStatusErrored {
+		return 0, ErrRunNotRetryable
+	}
+	pipeline, err := run.PipelineSpec.Pipeline()
+	if err != nil {
+		return 0, err
+	}
+
+	retry, err := newRetryRun(run, pipeline, dotID)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = r.Run(ctx, &retry, l, true, nil); err != nil {
+		return 0, err
+	}
+	if retry.FailEarly && retry.State != RunStatusCancelled {
+		return 0, errors.New("retry failed early and was not saved")
+	}
+	return retry.ID, nil
+}
+
+// newRetryRun returns a new run of the errored run's spec, seeded with the
+// task runs which don't need to be executed again. The task to retry from and
+// all of its descendants are left out, as well as the task runs of nested
+// pipelines, which are not used to resume a run.
+func newRetryRun(run Run, pipeline *Pipeline, dotID string) (Run, error) {
+	var from []Task
+	if dotID != "" {
+		task := pipeline.ByDotID(dotID)
+		if task == nil {
+			return Run{}, errors.Wrap(ErrRetryTaskNotFound, dotID)
+		}
+		from = append(from, task)
+	} else {
+		for _, tr := range run.PipelineTaskRuns {
+			if !tr.Error.Valid || isNestedDotID(tr.DotID) {
+				continue
+			}
+			if task := pipeline.ByDotID(tr.DotID); task != nil {
+				from = append(from, task)
+			}
+		}
+	}
+
+	reset := make(map[string]bool)
+	for len(from) > 0 {
+		task := from[0]
+		from = from[1:]
+		if reset[task.DotID()] {
+			continue
+		}
+		reset[task.DotID()] = true
+		from = append(from, task.Outputs()...)
+	}
+
+	inputs, _ := run.Inputs.Val.(map[string]interface{})
+	retry := NewRun(run.PipelineSpec, NewVarsFrom(inputs).Copy())
+	retry.Meta = run.Meta
+	retry.RetryOfRunID = null.IntFrom(run.ID)
+	for _, tr := range run.PipelineTaskRuns {
+		if reset[tr.DotID] || isNestedDotID(tr.DotID) {
+			continue
+		}
+		tr.ID = uuid.NewV4()
+		tr.PipelineRunID = 0
+		retry.PipelineTaskRuns = append(retry.PipelineTaskRuns, tr)
+	}
+	return retry, nil
+}
+
+func (r *runner) trackRun(runID int64, cancel context.CancelFunc) {
+	r.runCancelsMu.Lock()
+	defer r.runCancelsMu.Unlock()
+	r.runCancels[runID] = cancel
+}
+
+func (r *runner) untrackRun(runID int64) {
+	r.runCancelsMu.Lock()
+	defer r.runCancelsMu.Unlock()
+	delete(r.runCancels, runID)
+}
+
 func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) error {
-	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	r.publishInsertedRun(run)
+	return nil
 }
 
 func (r *runner) runReaper() {
@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,11 +10,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gopkg.in/guregu/null.v4"
@@ -39,7 +43,8 @@ func newRunner(t testing.TB, gdb *gorm.DB, cfg *configtest.TestGeneralConfig) (p
 	db := postgres.UnwrapGormDB(gdb)
 	orm.On("DB").Return(db)
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, logger.TestLogger(t))
+	r, err := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, logger.TestLogger(t))
+	require.NoError(t, err)
 	return r, orm
 }
 
@@ -434,7 +439,8 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: gdb, GeneralConfig: cfg})
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, lggr)
+	r, err := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, lggr)
+	require.NoError(t, err)
 
 	spec := pipeline.Spec{DotDagSource: `
 fail_but_i_dont_care [type=fail]
@@ -504,6 +510,61 @@ a->b2;`,
 	assert.Equal(t, mustDecimal(t, "12").String(), result.Values[1].(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_Conditional(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, pgtest.NewGormDB(t), cfg)
+	spec := pipeline.Spec{
+		DotDagSource: `
+a     [type=multiply input="$(val)" times=2]
+check [type=conditional value="$(a)" equals=4]
+b1    [type=multiply input="$(a)" times=2 index=0]
+b2    [type=multiply input="$(a)" times=3 index=1]
+c     [type=multiply input="$(b2)" times=10]
+a->check;
+check->b1 [label="true"];
+check->b2 [label="false"];
+b2->c;`,
+	}
+
+	_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"val": 2}), logger.TestLogger(t))
+	require.NoError(t, err)
+	require.Equal(t, 5, len(trrs))
+	for _, trr := range trrs {
+		assert.Equal(t, trr.Task.DotID() == "b2" || trr.Task.DotID() == "c", trr.Skipped, trr.Task.DotID())
+	}
+	result, err := trrs.FinalResult().SingularResult()
+	require.NoError(t, err)
+	require.NoError(t, result.Error)
+	assert.Equal(t, mustDecimal(t, "8").String(), result.Value.(decimal.Decimal).String())
+
+	_, trrs, err = r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"val": 3}), logger.TestLogger(t))
+	require.NoError(t, err)
+	result, err = trrs.FinalResult().SingularResult()
+	require.NoError(t, err)
+	require.NoError(t, result.Error)
+	assert.Equal(t, mustDecimal(t, "180").String(), result.Value.(decimal.Decimal).String())
+}
+
+func Test_PipelineRunner_Conditional_AllTerminalsSkipped(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, pgtest.NewGormDB(t), cfg)
+	spec := pipeline.Spec{
+		DotDagSource: `
+check  [type=conditional value="$(changed)"]
+submit [type=multiply input=1 times=2]
+check->submit [label="true"];`,
+	}
+
+	run, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"changed": false}), logger.TestLogger(t))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(trrs))
+	result := trrs.FinalResult()
+	assert.False(t, result.HasFatalErrors())
+	assert.Equal(t, []interface{}{nil}, result.Values)
+	assert.False(t, run.HasFatalErrors())
+	assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+}
+
 func Test_PipelineRunner_PanicTask_Run(t *testing.T) {
 	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 		res.WriteHeader(http.StatusOK)
@@ -793,3 +854,414 @@ ds_panic->ds1->ds_parse->ds_multiply;`, s.URL),
 	assert.Contains(t, run.ByDotID("ds_parse").Error.String, "cancelled")
 	assert.Contains(t, run.ByDotID("ds_multiply").Error.String, "cancelled")
 }
+
+func Test_PipelineRunner_ForEach(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/fail" {
+			res.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+		res.Write([]byte(fmt.Sprintf(`{"price": %v}`, len(req.URL.Path))))
+	}))
+	defer s.Close()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, err := pipeline.NewRunner(new(mocks.ORM), cfg, nil, nil, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+	spec := pipeline.Spec{
+		DotDagSource: `
+prices [type=foreach input="$(urls)" parallelism=2 dag="
+	fetch    [type=http method=GET url=<$(item)> allowUnrestrictedNetworkAccess=true]
+	parse    [type=jsonparse path=price]
+	multiply [type=multiply input=<$(parse)> times=<$(index)>]
+	fetch -> parse -> multiply
+"]
+`,
+	}
+
+	run, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{
+		"urls": []interface{}{s.URL + "/a", s.URL + "/bb", s.URL + "/ccc"},
+	}), logger.TestLogger(t))
+	require.NoError(t, err)
+	result, err := trrs.FinalResult().SingularResult()
+	require.NoError(t, err)
+	require.NoError(t, result.Error)
+	values := result.Value.([]interface{})
+	require.Len(t, values, 3)
+	for i, want := range []string{"0", "3", "8"} {
+		assert.Equal(t, want, values[i].(decimal.Decimal).String())
+	}
+
+	// the task runs of every element are recorded on the run
+	require.Len(t, run.PipelineTaskRuns, 1+3*3)
+	tr := run.ByDotID("prices[2].parse")
+	require.NotNil(t, tr)
+	assert.Equal(t, float64(4), tr.Output.Val)
+
+	_, trrs, err = r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{
+		"urls": []interface{}{s.URL + "/a", s.URL + "/fail"},
+	}), logger.TestLogger(t))
+	require.NoError(t, err)
+	result, err = trrs.FinalResult().SingularResult()
+	require.NoError(t, err)
+	require.Error(t, result.Error)
+	assert.Contains(t, result.Error.Error(), "element 1")
+}
+
+func Test_PipelineRunner_RedactsSecrets(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		auth := req.Header.Get("Authorization")
+		res.WriteHeader(http.StatusOK)
+		res.Write([]byte(fmt.Sprintf(`{"echo": "%s", "list": ["%s"]}`, auth, auth)))
+	}))
+	defer s.Close()
+
+	secretsFile := filepath.Join(t.TempDir(), "secrets.json")
+	require.NoError(t, ioutil.WriteFile(secretsFile, []byte(`{"token": "s3cr3t"}`), 0600))
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.JobPipelineSecretsFile = null.StringFrom(secretsFile)
+	r, err := pipeline.NewRunner(new(mocks.ORM), cfg, nil, nil, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	spec := pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+fetch [type=http method=GET url="%s" headers=<{"Authorization": "Bearer $(secrets.token)"}> allowUnrestrictedNetworkAccess=true]
+echo  [type=jsonparse path=echo index=0]
+nope  [type=jsonparse path=nope index=1]
+list  [type=jsonparse path=list]
+each  [type=foreach input=<$(list)> index=2 dag="
+	echo [type=memo value=<[$(item)]>]
+"]
+fetch -> echo
+fetch -> nope
+fetch -> list -> each
+`, s.URL),
+	}
+	sub := r.SubscribeToRunEvents(nil)
+	defer sub.Close()
+
+	run, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+	require.NoError(t, err)
+
+	// the results of the run are consumed unredacted
+	assert.Equal(t, "Bearer s3cr3t", trrs.FinalResult().Values[0])
+
+	// but the task runs, the outputs and the errors of the run are redacted,
+	// including those of downstream and nested tasks
+	require.Len(t, run.PipelineTaskRuns, 6)
+	assert.Equal(t, "Bearer [redacted]", run.ByDotID("echo").Output.Val)
+	assert.Equal(t, []interface{}{"Bearer [redacted]"}, run.ByDotID("list").Output.Val)
+	assert.Equal(t, []interface{}{"Bearer [redacted]"}, run.ByDotID("each[0].echo").Output.Val)
+	assert.Contains(t, run.ByDotID("nope").Error.String, "Bearer [redacted]")
+	assert.Contains(t, run.FatalErrors[1].String, "Bearer [redacted]")
+
+	b, err := json.Marshal(run)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "s3cr3t")
+
+	// and so are the published events
+	for len(sub.Events()) > 0 {
+		b, err = json.Marshal(<-sub.Events())
+		require.NoError(t, err)
+		assert.NotContains(t, string(b), "s3cr3t")
+	}
+}
+
+func Test_PipelineRunner_MissingSecretsFile(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.JobPipelineSecretsFile = null.StringFrom(filepath.Join(t.TempDir(), "nope.json"))
+	_, err := pipeline.NewRunner(new(mocks.ORM), cfg, nil, nil, nil, logger.TestLogger(t))
+	require.Error(t, err)
+}
+
+func Test_PipelineRunner_CancelRun(t *testing.T) {
+	gdb := pgtest.NewGormDB(t)
+	db := postgres.UnwrapGormDB(gdb)
+
+	// the bridge returns a pending response, so the run is stored
+	bridge := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Chainlink-Pending", "true")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{}))
+	}))
+	defer bridge.Close()
+	bt, _ := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: bridge.URL})
+
+	// the http task blocks until its request is cancelled
+	var once sync.Once
+	requested := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() { close(requested) })
+		<-r.Context().Done()
+	}))
+	defer s.Close()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, orm := newRunner(t, gdb, cfg)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+ds1    [type=bridge async=true name="%s"]
+ds2    [type=http method=GET url="%s"]
+answer [type=median]
+
+ds1 -> answer
+ds2 -> answer
+`, bt.Name.String(), s.URL)}
+	run := pipeline.NewRun(spec, pipeline.NewVarsFrom(nil))
+
+	orm.On("CreateRun", mock.AnythingOfType("*pipeline.Run"), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*pipeline.Run).ID = 1
+	}).Once()
+	orm.On("CancelRun", int64(1)).Return(nil).Once()
+	// the run has been cancelled in the database by the time it is stored
+	orm.On("StoreRun", mock.AnythingOfType("*pipeline.Run")).Return(false, nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*pipeline.Run).State = pipeline.RunStatusCancelled
+	}).Once()
+
+	type result struct {
+		incomplete bool
+		err        error
+	}
+	chResult := make(chan result)
+	go func() {
+		incomplete, err := r.Run(context.Background(), &run, logger.TestLogger(t), false, nil)
+		chResult <- result{incomplete, err}
+	}()
+
+	select {
+	case <-requested:
+	case <-time.After(cltest.DefaultWaitTimeout):
+		t.Fatal("timed out waiting for the http task")
+	}
+	require.NoError(t, r.CancelRun(1))
+
+	var res result
+	select {
+	case res = <-chResult:
+	case <-time.After(cltest.DefaultWaitTimeout):
+		t.Fatal("timed out waiting for the run to be cancelled")
+	}
+	require.NoError(t, res.err)
+	assert.False(t, res.incomplete)
+	assert.Equal(t, pipeline.RunStatusCancelled, run.State)
+	assert.Contains(t, run.ByDotID("ds2").Error.String, "context canceled")
+	orm.AssertExpectations(t)
+}
+
+func Test_PipelineRunner_RunEvents(t *testing.T) {
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(nil)
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, err := pipeline.NewRunner(orm, cfg, nil, nil, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	spec := pipeline.Spec{
+		ID:    1,
+		JobID: 1,
+		DotDagSource: `
+a [type=memo value="1"]
+b [type=fail msg="uh oh"]
+a -> b
+`,
+	}
+
+	sub := r.SubscribeToRunEvents(&spec.JobID)
+	otherJobID := int32(2)
+	otherSub := r.SubscribeToRunEvents(&otherJobID)
+	allSub := r.SubscribeToRunEvents(nil)
+	defer allSub.Close()
+
+	// runs which are never saved have no ID, and so no run events
+	_, _, err = r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+	require.NoError(t, err)
+	// simulated runs are not part of any job and have no events
+	_, _, err = r.SimulateRun(context.Background(), spec, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+	require.NoError(t, err)
+	// runs which are saved once they finish are created when they are saved
+	orm.On("InsertFinishedRun", mock.AnythingOfType("*pipeline.Run"), false).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*pipeline.Run).ID = 42
+	}).Once()
+	runID, _, err := r.ExecuteAndInsertFinishedRun(context.Background(), spec, pipeline.NewVarsFrom(nil), logger.TestLogger(t), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), runID)
+	sub.Close()
+	otherSub.Close()
+
+	var events []pipeline.RunEvent
+	for event := range sub.Events() {
+		assert.Equal(t, spec.JobID, event.JobID)
+		assert.Equal(t, spec.ID, event.PipelineSpecID)
+		events = append(events, event)
+	}
+	require.Len(t, events, 10)
+
+	assert.Equal(t, pipeline.RunEventTaskStarted, events[0].Type)
+	assert.Equal(t, "a", events[0].DotID)
+	assert.Equal(t, uint(1), events[0].Attempt)
+	assert.Equal(t, pipeline.RunEventTaskFinished, events[1].Type)
+	assert.Equal(t, "a", events[1].DotID)
+	assert.Equal(t, pipeline.RunStatusCompleted, events[1].State)
+	assert.True(t, events[1].Output.Valid)
+
+	assert.Equal(t, pipeline.RunEventTaskStarted, events[2].Type)
+	assert.Equal(t, "b", events[2].DotID)
+	assert.Equal(t, pipeline.RunEventTaskFinished, events[3].Type)
+	assert.Equal(t, pipeline.TaskTypeFail, events[3].TaskType)
+	assert.Equal(t, pipeline.RunStatusErrored, events[3].State)
+	assert.Equal(t, "uh oh", events[3].Error)
+
+	for _, event := range events[4:8] {
+		assert.Zero(t, event.RunID)
+	}
+	assert.Equal(t, pipeline.RunEventRunCreated, events[8].Type)
+	assert.Equal(t, int64(42), events[8].RunID)
+	assert.Equal(t, pipeline.RunEventRunFinished, events[9].Type)
+	assert.Equal(t, int64(42), events[9].RunID)
+	assert.Equal(t, pipeline.RunStatusErrored, events[9].State)
+
+	_, open := <-otherSub.Events()
+	assert.False(t, open)
+	assert.Len(t, allSub.Events(), 10)
+}
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusOK)
+		res.Write([]byte(`{"price": 4}`))
+	}))
+	defer s.Close()
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(nil)
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, err := pipeline.NewRunner(orm, cfg, nil, nil, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+	spec := pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+fetch  [type=http method=GET url="%s" allowUnrestrictedNetworkAccess=true]
+parse  [type=jsonparse path=price]
+submit [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data=<$(parse)>]
+notify [type=bridge name=notifier async=true requestData=<{"price": $(parse)}>]
+fetch -> parse -> submit
+parse -> notify
+`, s.URL),
+	}
+
+	// tasks with side effects are stubbed, the run is not stored
+	run, trrs, err := r.SimulateRun(context.Background(), spec, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.True(t, run.Simulated)
+	assert.False(t, run.Pending)
+	assert.Equal(t, int64(0), run.ID)
+	require.Len(t, trrs, 4)
+	for _, trr := range trrs {
+		require.NoError(t, trr.Result.Error)
+		switch trr.Task.DotID() {
+		case "parse":
+			assert.Equal(t, float64(4), trr.Result.Value)
+		case "submit", "notify":
+			assert.True(t, pipeline.HasSideEffects(trr.Task))
+			assert.Nil(t, trr.Result.Value)
+		}
+	}
+	assert.Len(t, run.PipelineTaskRuns, 4)
+	assert.Len(t, run.FatalErrors, 2)
+	assert.False(t, run.HasFatalErrors())
+
+	orm.AssertExpectations(t)
+}
+
+func Test_PipelineRunner_RetryRun(t *testing.T) {
+	gdb := pgtest.NewGormDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, orm := newRunner(t, gdb, cfg)
+
+	var priceRequests int
+	var submitted []byte
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/price" {
+			priceRequests++
+		} else {
+			submitted, _ = ioutil.ReadAll(req.Body)
+		}
+		w.Write([]byte(`{"price": 4}`))
+	}))
+	defer s.Close()
+
+	spec := pipeline.Spec{ID: 3, DotDagSource: fmt.Sprintf(`
+fetch  [type=http method=GET url="%[1]s/price" allowUnrestrictedNetworkAccess=true]
+parse  [type=jsonparse path=price]
+submit [type=http method=POST url="%[1]s/submit" requestData=<{"price": $(parse)}> allowUnrestrictedNetworkAccess=true]
+fetch -> parse -> submit
+`, s.URL)}
+	now := time.Now()
+	errored := pipeline.Run{
+		ID:             1,
+		PipelineSpecID: spec.ID,
+		PipelineSpec:   spec,
+		State:          pipeline.RunStatusErrored,
+		Inputs:         pipeline.JSONSerializable{Val: map[string]interface{}{}, Valid: true},
+		CreatedAt:      now,
+		FinishedAt:     null.TimeFrom(now),
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{ID: uuid.NewV4(), PipelineRunID: 1, DotID: "fetch", Type: pipeline.TaskTypeHTTP, Output: pipeline.JSONSerializable{Val: `{"price": 4}`, Valid: true}, CreatedAt: now, FinishedAt: null.TimeFrom(now)},
+			{ID: uuid.NewV4(), PipelineRunID: 1, DotID: "parse", Type: pipeline.TaskTypeJSONParse, Output: pipeline.JSONSerializable{Val: float64(4), Valid: true}, CreatedAt: now, FinishedAt: null.TimeFrom(now)},
+			{ID: uuid.NewV4(), PipelineRunID: 1, DotID: "submit", Type: pipeline.TaskTypeHTTP, Error: null.StringFrom("connection refused"), CreatedAt: now, FinishedAt: null.TimeFrom(now)},
+		},
+	}
+	orm.On("FindRun", int64(1)).Return(errored, nil)
+
+	var retry *pipeline.Run
+	orm.On("InsertFinishedRun", mock.AnythingOfType("*pipeline.Run"), true, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		retry = args.Get(0).(*pipeline.Run)
+		retry.ID = 2
+	}).Once()
+
+	retryRunID, err := r.RetryRun(context.Background(), 1, "", logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), retryRunID)
+	require.NotNil(t, retry)
+	assert.Equal(t, null.IntFrom(1), retry.RetryOfRunID)
+	assert.Equal(t, pipeline.RunStatusCompleted, retry.State)
+
+	// only the errored task is executed again, using the kept results
+	assert.Zero(t, priceRequests)
+	assert.JSONEq(t, `{"price": 4}`, string(submitted))
+	require.Len(t, retry.PipelineTaskRuns, 3)
+	for _, tr := range retry.PipelineTaskRuns {
+		assert.NotEqual(t, errored.ByDotID(tr.DotID).ID, tr.ID)
+		assert.False(t, tr.Error.Valid)
+	}
+
+	// retrying from an earlier task executes its descendants as well
+	orm.On("InsertFinishedRun", mock.AnythingOfType("*pipeline.Run"), true, mock.Anything).Return(nil).Once()
+	_, err = r.RetryRun(context.Background(), 1, "fetch", logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, 1, priceRequests)
+
+	orm.AssertExpectations(t)
+}
+
+func Test_PipelineRunner_RetryRun_Errors(t *testing.T) {
+	orm := new(mocks.ORM)
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, err := pipeline.NewRunner(orm, cfg, nil, nil, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	spec := pipeline.Spec{DotDagSource: `a [type=any]`}
+	orm.On("FindRun", int64(1)).Return(pipeline.Run{ID: 1, PipelineSpec: spec, State: pipeline.RunStatusCompleted}, nil)
+	orm.On("FindRun", int64(2)).Return(pipeline.Run{ID: 2, PipelineSpec: spec, State: pipeline.RunStatusErrored}, nil)
+	orm.On("FindRun", int64(3)).Return(pipeline.Run{}, sql.ErrNoRows)
+
+	_, err = r.RetryRun(context.Background(), 1, "", logger.TestLogger(t))
+	assert.True(t, errors.Is(err, pipeline.ErrRunNotRetryable))
+
+	_, err = r.RetryRun(context.Background(), 2, "nope", logger.TestLogger(t))
+	assert.True(t, errors.Is(err, pipeline.ErrRetryTaskNotFound))
+
+	_, err = r.RetryRun(context.Background(), 3, "", logger.TestLogger(t))
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+
+	orm.AssertExpectations(t)
+}
@@ -26,6 +26,10 @@ func (s *scheduler) newMemoryTaskRun(task Task) *memoryTaskRun {
 		// NOTE: we could just allocate via make, then assign directly to run.inputs[i.OutputIndex()]
 		// if we're confident that indices are within range
 		for _, i := range task.Inputs() {
+			// inputs on branches that were not taken are left out
+			if s.results[i.ID()].Skipped {
+				continue
+			}
 			inputs = append(inputs, input{index: int32(i.OutputIndex()), result: s.results[i.ID()].Result})
 		}
 		sort.Slice(inputs, func(i, j int) bool {
@@ -53,6 +57,10 @@ type scheduler struct {
 	pending bool
 	exiting bool
 
+	// optional hooks called as each attempt of a task run starts and finishes
+	onTaskStarted  func(*memoryTaskRun)
+	onTaskFinished func(TaskRunResult)
+
 	taskCh   chan *memoryTaskRun
 	resultCh chan TaskRunResult
 }
@@ -85,6 +93,7 @@ func newScheduler(ctx context.Context, p *Pipeline, run *Run, vars Vars) *schedu
 	s.reconstructResults()
 
 	// immediately schedule all doable tasks
+	var ready []Task
 	for id, task := range p.Tasks {
 		// skip tasks that are not ready
 		if s.dependencies[id] != 0 {
@@ -96,20 +105,87 @@ func newScheduler(ctx context.Context, p *Pipeline, run *Run, vars Vars) *schedu
 			continue
 		}
 
+		ready = append(ready, task)
+	}
+	// collected first, since skipping a task may schedule its outputs
+	for _, task := range ready {
+		s.schedule(task)
+	}
+
+	return s
+}
+
+// schedule queues a run of the task, unless it is on a branch that was not
+// taken, in which case it is marked as skipped and its outputs are scheduled.
+func (s *scheduler) schedule(task Task) {
+	if !s.isSkipped(task) {
 		run := s.newMemoryTaskRun(task)
 
 		logger.Debugw("scheduling task run", "dot_id", task.DotID(), "attempts", run.attempts)
 
 		s.taskCh <- run
 		s.waiting++
+		return
 	}
 
-	return s
+	logger.Debugw("skipping task run", "dot_id", task.DotID())
+
+	now := time.Now()
+	s.results[task.ID()] = TaskRunResult{
+		ID:         task.Base().uuid,
+		Task:       task,
+		Skipped:    true,
+		CreatedAt:  now,
+		FinishedAt: null.TimeFrom(now),
+	}
+	s.scheduleOutputs(task)
+}
+
+// scheduleOutputs marks the task as complete for each of its outputs,
+// scheduling the ones which have no more pending dependencies
+func (s *scheduler) scheduleOutputs(task Task) {
+	for _, output := range task.Outputs() {
+		id := output.ID()
+		s.dependencies[id]--
+
+		// if all dependencies are done, schedule task run
+		if s.dependencies[id] == 0 {
+			s.schedule(s.pipeline.Tasks[id])
+		}
+	}
+}
+
+// isSkipped returns true if a conditional input did not take the branch
+// leading to the task, or if all of the task's inputs were skipped
+func (s *scheduler) isSkipped(task Task) bool {
+	inputs := task.Inputs()
+	if len(inputs) == 0 {
+		return false
+	}
+	skipped := 0
+	for _, input := range inputs {
+		result := s.results[input.ID()]
+		if result.Skipped {
+			skipped++
+			continue
+		}
+		// an errored conditional doesn't select a branch, the error is
+		// propagated to all outputs like any other task
+		if conditional, is := input.(*ConditionalTask); is && result.Result.Error == nil && !conditional.takesBranch(result.Result, task) {
+			return true
+		}
+	}
+	return skipped == len(inputs)
 }
 
 func (s *scheduler) reconstructResults() {
 	// if there's results already present on Run, then this is a resumption. Loop over them and fill results table
 	for _, r := range s.run.PipelineTaskRuns {
+		// task runs of nested pipelines are not part of this pipeline
+		if isNestedDotID(r.DotID) {
+			continue
+		}
+
 		task := s.pipeline.ByDotID(r.DotID)
 
 		if task == nil {
@@ -120,6 +196,20 @@ func (s *scheduler) reconstructResults() {
 			continue
 		}
 
+		if r.Skipped {
+			s.results[task.ID()] = TaskRunResult{
+				ID:         r.ID,
+				Task:       task,
+				Skipped:    true,
+				CreatedAt:  r.CreatedAt,
+				FinishedAt: r.FinishedAt,
+			}
+			for _, output := range task.Outputs() {
+				s.dependencies[output.ID()]--
+			}
+			continue
+		}
+
 		result := Result{}
 
 		if r.Error.Valid {
@@ -131,6 +221,7 @@ func (s *scheduler) reconstructResults() {
 		}
 
 		s.results[task.ID()] = TaskRunResult{
+			ID:         r.ID,
 			Task:       task,
 			Result:     result,
 			CreatedAt:  r.CreatedAt,
@@ -243,21 +334,7 @@ func (s *scheduler) Run() {
 			continue
 		}
 
-		for _, output := range result.Task.Outputs() {
-			id := output.ID()
-			s.dependencies[id]--
-
-			// if all dependencies are done, schedule task run
-			if s.dependencies[id] == 0 {
-				task := s.pipeline.Tasks[id]
-				run := s.newMemoryTaskRun(task)
-
-				logger.Debugw("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
-				s.taskCh <- run
-				s.waiting++
-			}
-		}
-
+		s.scheduleOutputs(result.Task)
 	}
 
 	close(s.taskCh)
@@ -278,6 +355,11 @@ func (s *scheduler) markRemaining(err error) {
 }
 
 func (s *scheduler) report(ctx context.Context, result TaskRunResult) {
+	// a pending task run is only finished once it is resumed
+	if s.onTaskFinished != nil && !result.runInfo.IsPending {
+		s.onTaskFinished(result)
+	}
+
 	select {
 	case s.resultCh <- result:
 	case <-ctx.Done():
@@ -126,6 +126,98 @@ func Test_Scheduler(t *testing.T) {
 				require.Equal(t, ErrCancelled, result.Result.Error)
 			},
 		},
+		{
+			name: "conditional: skip branches that are not taken",
+			spec: `
+			a [type=conditional]
+			b [type=median]
+			c [type=median]
+			d [type=median]
+			e [type=median index=0]
+			a -> b [label="true"]
+			a -> c [label="false"]
+			c -> d
+			b -> e
+			d -> e`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Value: true},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+				// c and d are skipped, e runs because one of its inputs did
+				{
+					expected: "e",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.False(t, results[p.ByDotID("b").ID()].Skipped)
+				require.True(t, results[p.ByDotID("c").ID()].Skipped)
+				require.True(t, results[p.ByDotID("d").ID()].Skipped)
+				require.False(t, results[p.ByDotID("e").ID()].Skipped)
+			},
+		},
+		{
+			name: "conditional: default branch",
+			spec: `
+			a [type=conditional]
+			b [type=median index=0]
+			c [type=median index=1]
+			d [type=median index=2]
+			a -> b [label="foo"]
+			a -> c [label="default"]
+			a -> d`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Value: "bar"},
+				},
+				{
+					expected: "c",
+					result:   Result{Value: 1},
+				},
+				{
+					expected: "d",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.True(t, results[p.ByDotID("b").ID()].Skipped)
+				require.False(t, results[p.ByDotID("c").ID()].Skipped)
+				require.False(t, results[p.ByDotID("d").ID()].Skipped)
+			},
+		},
+		{
+			name: "conditional: errors are propagated to all branches",
+			spec: `
+			a [type=conditional]
+			b [type=median index=0]
+			c [type=median index=1]
+			a -> b [label="true"]
+			a -> c [label="false"]`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+				},
+				{
+					expected: "b",
+					result:   Result{Error: ErrTaskRunFailed},
+				},
+				{
+					expected: "c",
+					result:   Result{Error: ErrTaskRunFailed},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.False(t, results[p.ByDotID("b").ID()].Skipped)
+				require.False(t, results[p.ByDotID("c").ID()].Skipped)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,220 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+const (
+	secretsKeypathPrefix = "secrets."
+	redactedSecret       = "[redacted]"
+)
+
+var ErrSecretNotFound = errors.New("secret not found")
+
+// Secrets are named values stored on the node (see JOB_PIPELINE_SECRETS_FILE)
+// which can be referenced from http task headers as $(secrets.<name>), so that
+// API keys and tokens never have to appear in a job spec.
+type Secrets map[string]string
+
+// LoadSecretsFile reads a JSON object of secret names to values. An empty path
+// is not an error, it simply means that no secrets are configured.
+func LoadSecretsFile(path string) (Secrets, error) {
+	if path == "" {
+		return Secrets{}, nil
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read pipeline secrets file")
+	}
+	var secrets Secrets
+	if err = json.Unmarshal(b, &secrets); err != nil {
+		return nil, errors.Wrapf(err, "pipeline secrets file %s must contain a JSON object of strings", path)
+	}
+	if secrets == nil {
+		secrets = Secrets{}
+	}
+	return secrets, nil
+}
+
+// Get returns the value of the named secret
+func (s Secrets) Get(name string) (string, error) {
+	val, exists := s[name]
+	if !exists {
+		return "", errors.Wrapf(ErrSecretNotFound, "no secret named '%v'", name)
+	}
+	return val, nil
+}
+
+// Redact replaces every occurrence of a secret value in str
+func (s Secrets) Redact(str string) string {
+	if len(s) == 0 || str == "" {
+		return str
+	}
+	// Replace longer values first so that a secret which contains another
+	// secret is never partially revealed.
+	values := make([]string, 0, len(s))
+	for _, v := range s {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	for _, v := range values {
+		str = strings.ReplaceAll(str, v, redactedSecret)
+	}
+	return str
+}
+
+// RedactError returns err unchanged unless its message contains a secret, in
+// which case a new error is returned with the secret values redacted.
+func (s Secrets) RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	if redacted := s.Redact(msg); redacted != msg {
+		return errors.New(redacted)
+	}
+	return err
+}
+
+// RedactErrorDB redacts the secret values in a task run error to be persisted
+// or published
+func (s Secrets) RedactErrorDB(errString null.String) null.String {
+	if errString.Valid {
+		errString.String = s.Redact(errString.String)
+	}
+	return errString
+}
+
+// RedactValue returns a copy of a task run value with the secret values
+// redacted from every string in it, including those nested in the maps and
+// slices of parsed JSON. Values of other types are redacted in their JSON
+// form, as that is how they are persisted. The value itself is left unchanged.
+func (s Secrets) RedactValue(val interface{}) interface{} {
+	if len(s) == 0 {
+		return val
+	}
+	switch v := val.(type) {
+	case string:
+		return s.Redact(v)
+	case []byte:
+		if redacted := s.Redact(string(v)); redacted != string(v) {
+			return []byte(redacted)
+		}
+		return v
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			redacted[s.Redact(key)] = s.RedactValue(elem)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, elem := range v {
+			redacted[i] = s.RedactValue(elem)
+		}
+		return redacted
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return val
+		}
+		var redacted interface{}
+		if str := s.Redact(string(b)); str == string(b) || json.Unmarshal([]byte(str), &redacted) != nil {
+			return val
+		}
+		return redacted
+	}
+}
+
+// RedactOutput returns the output of a task run to be persisted or published.
+// Responses may echo the secrets sent in http task headers, and downstream
+// tasks may pass them on, so the output of every task is redacted. Downstream
+// tasks of the run still consume the result unchanged.
+func (s Secrets) RedactOutput(result TaskRunResult) JSONSerializable {
+	output := result.Result.OutputDB()
+	if output.Valid {
+		output.Val = s.RedactValue(output.Val)
+	}
+	return output
+}
+
+// RedactTaskRun redacts the output and error of a task run to be persisted
+func (s Secrets) RedactTaskRun(taskRun TaskRun) TaskRun {
+	if taskRun.Output.Valid {
+		taskRun.Output.Val = s.RedactValue(taskRun.Output.Val)
+	}
+	taskRun.Error = s.RedactErrorDB(taskRun.Error)
+	return taskRun
+}
+
+// resolveHeaders parses the http task's headers param. Literal headers in the
+// spec may interpolate $(...) expressions inside their values: $(secrets.<name>)
+// is looked up in the node's secrets, anything else is resolved from the
+// pipeline Vars. Headers taken wholesale from a variable are used verbatim,
+// since they may originate from untrusted input and must never be able to
+// reference secrets.
+func resolveHeaders(spec string, vars Vars, secrets Secrets) (map[string]string, error) {
+	var headers MapParam
+	err := ResolveParam(&headers, From(VarExpr(spec, vars)))
+	if err == nil {
+		return stringHeaders(headers, func(_, value string) (string, error) { return value, nil })
+	} else if errors.Cause(err) != ErrParameterEmpty {
+		return nil, err
+	}
+
+	if err = ResolveParam(&headers, From(NonemptyString(spec), nil)); err != nil {
+		return nil, err
+	}
+	return stringHeaders(headers, func(name, value string) (string, error) {
+		var err error
+		value = variableRegexp.ReplaceAllStringFunc(value, func(expr string) string {
+			keypath := strings.TrimSpace(expr[2 : len(expr)-1])
+			if strings.HasPrefix(keypath, secretsKeypathPrefix) {
+				secret, err2 := secrets.Get(strings.TrimPrefix(keypath, secretsKeypathPrefix))
+				if err2 != nil {
+					err = err2
+				}
+				return secret
+			}
+			val, err2 := vars.Get(keypath)
+			if err2 != nil {
+				err = err2
+				return ""
+			}
+			var sp StringParam
+			if err2 = sp.UnmarshalPipelineParam(val); err2 != nil {
+				err = errors.Wrapf(err2, "header '%v'", name)
+				return ""
+			}
+			return string(sp)
+		})
+		return value, err
+	})
+}
+
+func stringHeaders(headers MapParam, resolve func(name, value string) (string, error)) (map[string]string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	resolved := make(map[string]string, len(headers))
+	for name, v := range headers {
+		str, is := v.(string)
+		if !is {
+			return nil, errors.Wrapf(ErrBadInput, "header '%v' must be a string, got %T", name, v)
+		}
+		str, err := resolve(name, str)
+		if err != nil {
+			return nil, err
+		}
+		resolved[name] = str
+	}
+	return resolved, nil
+}
@@ -0,0 +1,94 @@
+package pipeline_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestLoadSecretsFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no file configured", func(t *testing.T) {
+		secrets, err := pipeline.LoadSecretsFile("")
+		require.NoError(t, err)
+		assert.Len(t, secrets, 0)
+	})
+
+	t.Run("valid file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "secrets.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"cmc_key": "abc", "token": "def"}`), 0600))
+
+		secrets, err := pipeline.LoadSecretsFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.Secrets{"cmc_key": "abc", "token": "def"}, secrets)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "secrets.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"cmc_key": 1}`), 0600))
+
+		_, err := pipeline.LoadSecretsFile(path)
+		require.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := pipeline.LoadSecretsFile(filepath.Join(t.TempDir(), "nope.json"))
+		require.Error(t, err)
+	})
+}
+
+func TestSecrets_Redact(t *testing.T) {
+	t.Parallel()
+
+	secrets := pipeline.Secrets{"short": "abc", "long": "abcdef", "empty": ""}
+
+	assert.Equal(t, "key=[redacted] other=[redacted]", secrets.Redact("key=abcdef other=abc"))
+	assert.Equal(t, "nothing to see", secrets.Redact("nothing to see"))
+
+	err := errors.New("bad")
+	assert.Equal(t, err, secrets.RedactError(err))
+	assert.EqualError(t, secrets.RedactError(errors.New("bad token abc")), "bad token [redacted]")
+	assert.Nil(t, secrets.RedactError(nil))
+}
+
+func TestSecrets_RedactOutput(t *testing.T) {
+	t.Parallel()
+
+	secrets := pipeline.Secrets{"token": "s3cr3t"}
+
+	output := secrets.RedactOutput(pipeline.TaskRunResult{
+		Task:   &pipeline.HTTPTask{},
+		Result: pipeline.Result{Value: `{"echo": "Bearer s3cr3t"}`},
+	})
+	assert.Equal(t, pipeline.JSONSerializable{Val: `{"echo": "Bearer [redacted]"}`, Valid: true}, output)
+
+	// the outputs of downstream tasks are redacted too, however nested
+	value := map[string]interface{}{"echo": []interface{}{"Bearer s3cr3t", float64(1)}, "bytes": []byte("s3cr3t")}
+	output = secrets.RedactOutput(pipeline.TaskRunResult{
+		Task:   &pipeline.JSONParseTask{},
+		Result: pipeline.Result{Value: value},
+	})
+	assert.Equal(t, pipeline.JSONSerializable{
+		Val:   map[string]interface{}{"echo": []interface{}{"Bearer [redacted]", float64(1)}, "bytes": []byte("[redacted]")},
+		Valid: true,
+	}, output)
+	// without changing the value itself
+	assert.Equal(t, "Bearer s3cr3t", value["echo"].([]interface{})[0])
+
+	output = secrets.RedactOutput(pipeline.TaskRunResult{
+		Task:   &pipeline.HTTPTask{},
+		Result: pipeline.Result{Error: errors.New("bad")},
+	})
+	assert.False(t, output.Valid)
+
+	assert.Equal(t, null.StringFrom("bad [redacted]"), secrets.RedactErrorDB(null.StringFrom("bad s3cr3t")))
+	assert.False(t, secrets.RedactErrorDB(null.String{}).Valid)
+}
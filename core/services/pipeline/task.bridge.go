@@ -2,11 +2,16 @@ package pipeline
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
 	"encoding/json"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -28,12 +33,29 @@ type BridgeTask struct {
 
 	queryer postgres.Queryer
 	config  Config
+	// simulated bridge tasks read the cache but never write to it
+	simulated bool
 }
 
 var _ Task = (*BridgeTask)(nil)
 
 var zeroURL = new(url.URL)
 
+var (
+	promBridgeCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_bridge_cache_hits",
+		Help: "Number of times a cached response was returned because the external adapter failed",
+	},
+		[]string{"bridge_name"},
+	)
+	promBridgeCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_bridge_cache_misses",
+		Help: "Number of times the external adapter failed and no usable cached response was found",
+	},
+		[]string{"bridge_name"},
+	)
+)
+
 func (t *BridgeTask) Type() TaskType {
 	return TaskTypeBridge
 }
@@ -58,10 +80,29 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: err}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bt, err := t.getBridgeFromName(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bt.URL)
+
+	// The cache is keyed on the request data from the spec and the included
+	// input, before the run-specific meta is added to it
+	var cacheKey []byte
+	if bt.CacheEnabled() && t.Async != "true" {
+		keyData := requestData
+		if t.IncludeInputAtKey != "" && len(inputValues) > 0 {
+			keyData = make(MapParam, len(requestData)+1)
+			for k, v := range requestData {
+				keyData[k] = v
+			}
+			keyData[string(includeInputAtKey)] = inputValues[0]
+		}
+		cacheKey, err = bridgeCacheKey(keyData)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
 
 	var metaMap MapParam
 
@@ -105,8 +146,23 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 	)
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config)
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, "POST", url, requestData, nil, allowUnrestrictedNetworkAccess, t.config)
 	if err != nil {
+		if cacheKey != nil {
+			cached, found, cacheErr := t.getCachedResponse(bt, cacheKey)
+			if cacheErr != nil {
+				lggr.Errorw("Bridge task: failed to read cached response", "err", cacheErr, "bridge", bt.Name)
+			} else if found {
+				promBridgeCacheHits.WithLabelValues(bt.Name.String()).Inc()
+				lggr.Warnw("Bridge task: request failed, returning cached response",
+					"err", err,
+					"url", url.String(),
+					"dotID", t.DotID(),
+				)
+				return Result{Value: string(cached)}, runInfo
+			}
+			promBridgeCacheMisses.WithLabelValues(bt.Name.String()).Inc()
+		}
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
@@ -130,6 +186,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	// value instead.
 	result = Result{Value: string(responseBytes)}
 
+	if cacheKey != nil && !t.simulated {
+		if err := t.cacheResponse(bt, cacheKey, responseBytes); err != nil {
+			lggr.Errorw("Bridge task: failed to cache response", "err", err, "bridge", bt.Name)
+		}
+	}
+
 	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
@@ -141,13 +203,52 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
-	var bt bridges.BridgeType
-	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
+func (t BridgeTask) getBridgeFromName(name StringParam) (bt bridges.BridgeType, err error) {
+	err = t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
+	if err != nil {
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+func bridgeCacheKey(requestData MapParam) ([]byte, error) {
+	// json.Marshal sorts map keys, so equal requests always hash the same
+	b, err := json.Marshal(requestData)
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return nil, errors.Wrap(err, "failed to compute bridge cache key")
+	}
+	hash := sha256.Sum256(b)
+	return hash[:], nil
+}
+
+// cacheResponse stores the last successful response for a bridge request
+func (t BridgeTask) cacheResponse(bt bridges.BridgeType, key []byte, response []byte) error {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	_, err := t.queryer.ExecContext(ctx, `INSERT INTO bridge_last_values (bridge_name, request_hash, value, finished_at)
+	VALUES ($1, $2, $3, now())
+	ON CONFLICT (bridge_name, request_hash) DO UPDATE SET value = EXCLUDED.value, finished_at = EXCLUDED.finished_at`, bt.Name, key, response)
+	return errors.Wrap(err, "cacheResponse failed")
+}
+
+// getCachedResponse returns the last successful response for a bridge request,
+// as long as it is no older than the bridge's cache TTL, and records the hit
+// unless the task is simulated
+func (t BridgeTask) getCachedResponse(bt bridges.BridgeType, key []byte) (response []byte, found bool, err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err = t.queryer.GetContext(ctx, &response, `SELECT value FROM bridge_last_values
+	WHERE bridge_name = $1 AND request_hash = $2 AND finished_at >= $3`, bt.Name, key, time.Now().Add(-bt.CacheTTL.Duration()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, errors.Wrap(err, "getCachedResponse failed")
+	}
+	if t.simulated {
+		return response, true, nil
 	}
-	return URLParam(bt.URL), nil
+	_, err = t.queryer.ExecContext(ctx, `UPDATE bridge_types SET cache_hits = cache_hits + 1, cache_last_hit_at = now() WHERE name = $1`, bt.Name)
+	return response, true, errors.Wrap(err, "getCachedResponse failed to record cache hit")
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -593,6 +594,145 @@ func TestBridgeTask_ErrorIfBridgeMissing(t *testing.T) {
 	assert.Contains(t, result.Error.Error(), "could not find bridge with name 'foo'")
 }
 
+func TestBridgeTask_CachedResponse(t *testing.T) {
+	t.Parallel()
+
+	gdb := pgtest.NewGormDB(t)
+	db := postgres.UnwrapGormDB(gdb)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	failing := abool.New()
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.IsSet() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"data":{"result":9700}}`))
+		require.NoError(t, err)
+	}))
+	defer s1.Close()
+
+	newTask := func(t *testing.T, cacheTTL time.Duration) pipeline.BridgeTask {
+		_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s1.URL, CacheTTL: cacheTTL})
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg, db, uuid.UUID{})
+		return task
+	}
+	// meta differs between runs but must not affect the cache key
+	vars := func(round int) pipeline.Vars {
+		return pipeline.NewVarsFrom(map[string]interface{}{"jobRun": map[string]interface{}{"meta": map[string]interface{}{"round": round}}})
+	}
+
+	t.Run("returns the cached response when the adapter fails", func(t *testing.T) {
+		failing.UnSet()
+		task := newTask(t, time.Hour)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars(1), nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"data":{"result":9700}}`, result.Value)
+
+		failing.Set()
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars(2), nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		require.Equal(t, `{"data":{"result":9700}}`, result.Value)
+
+		bt, err := bridges.NewORM(db).FindBridge(bridges.TaskType(task.Name))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), bt.CacheHits)
+		assert.True(t, bt.CacheLastHitAt.Valid)
+	})
+
+	t.Run("does not return a cached response for a different request", func(t *testing.T) {
+		failing.UnSet()
+		task := newTask(t, time.Hour)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars(1), nil)
+		require.NoError(t, result.Error)
+
+		failing.Set()
+		task.RequestData = ethUSDPairing
+		result, _ = task.Run(context.Background(), logger.TestLogger(t), vars(2), nil)
+		require.Error(t, result.Error)
+	})
+
+	t.Run("does not return a cached response for a different included input", func(t *testing.T) {
+		failing.UnSet()
+		task := newTask(t, time.Hour)
+		task.IncludeInputAtKey = "input"
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars(1), []pipeline.Result{{Value: 1}})
+		require.NoError(t, result.Error)
+
+		failing.Set()
+		result, _ = task.Run(context.Background(), logger.TestLogger(t), vars(2), []pipeline.Result{{Value: 2}})
+		require.Error(t, result.Error)
+		result, _ = task.Run(context.Background(), logger.TestLogger(t), vars(3), []pipeline.Result{{Value: 1}})
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("does not return a cached response older than the TTL", func(t *testing.T) {
+		failing.UnSet()
+		task := newTask(t, time.Hour)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars(1), nil)
+		require.NoError(t, result.Error)
+		_, err := db.Exec(`UPDATE bridge_last_values SET finished_at = now() - interval '2 hours' WHERE bridge_name = $1`, task.Name)
+		require.NoError(t, err)
+
+		failing.Set()
+		result, _ = task.Run(context.Background(), logger.TestLogger(t), vars(2), nil)
+		require.Error(t, result.Error)
+	})
+
+	t.Run("does not cache when disabled", func(t *testing.T) {
+		failing.UnSet()
+		task := newTask(t, 0)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars(1), nil)
+		require.NoError(t, result.Error)
+
+		failing.Set()
+		result, _ = task.Run(context.Background(), logger.TestLogger(t), vars(2), nil)
+		require.Error(t, result.Error)
+
+		var count int
+		require.NoError(t, db.Get(&count, `SELECT count(*) FROM bridge_last_values WHERE bridge_name = $1`, task.Name))
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("reads but does not write the cache when simulated", func(t *testing.T) {
+		failing.UnSet()
+		task := newTask(t, time.Hour)
+		simulated := task
+		simulated.HelperSetSimulated()
+
+		result, _ := simulated.Run(context.Background(), logger.TestLogger(t), vars(1), nil)
+		require.NoError(t, result.Error)
+		var count int
+		require.NoError(t, db.Get(&count, `SELECT count(*) FROM bridge_last_values WHERE bridge_name = $1`, task.Name))
+		assert.Equal(t, 0, count)
+
+		result, _ = task.Run(context.Background(), logger.TestLogger(t), vars(2), nil)
+		require.NoError(t, result.Error)
+
+		failing.Set()
+		result, _ = simulated.Run(context.Background(), logger.TestLogger(t), vars(3), nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"data":{"result":9700}}`, result.Value)
+
+		bt, err := bridges.NewORM(db).FindBridge(bridges.TaskType(task.Name))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), bt.CacheHits)
+		assert.False(t, bt.CacheLastHitAt.Valid)
+	})
+}
+
 // Sample input taken from
 // https://github.com/smartcontractkit/price-adapters#chainlink-price-request-adapters
 func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
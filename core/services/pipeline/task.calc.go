@@ -0,0 +1,72 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// CalcTask evaluates an arithmetic or boolean expression over pipeline
+// variables, e.g.
+//
+//	change [type=calc expr="abs($(new) - $(old)) / $(old) * 100 > 0.5"]
+//
+// See CalcExpression for the supported syntax. The expression is parsed when
+// the pipeline is parsed, so syntax errors are reported at job validation time.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+type CalcTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Expr      string `json:"expr"`
+	Precision string `json:"precision"`
+
+	expr *CalcExpression
+}
+
+var _ Task = (*CalcTask)(nil)
+
+func (t *CalcTask) Type() TaskType {
+	return TaskTypeCalc
+}
+
+func (t *CalcTask) validate() (err error) {
+	t.expr, err = ParseCalcExpression(t.Expr)
+	return errors.Wrap(err, "expr")
+}
+
+func (t *CalcTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	if t.expr == nil {
+		if err = t.validate(); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	var maybePrecision MaybeInt32Param
+	err = errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var precision *int32
+	if p, isSet := maybePrecision.Int32(); isSet {
+		if p > maxCalcExponent || p < -maxCalcExponent {
+			return Result{Error: errors.Wrapf(ErrBadInput, "precision must be between -%d and %d, got %d", maxCalcExponent, maxCalcExponent, p)}, runInfo
+		}
+		precision = &p
+	}
+	value, err := t.expr.Evaluate(vars, precision)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
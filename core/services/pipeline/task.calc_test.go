@@ -0,0 +1,154 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestCalcTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":      "105.5",
+		"b":      100,
+		"zero":   0,
+		"ok":     true,
+		"answer": map[string]interface{}{"price": 4.25},
+		"feeds":  []interface{}{"1", "2"},
+		"text":   "foo",
+		"huge":   "1e999999999",
+	})
+
+	tests := []struct {
+		name              string
+		expr              string
+		precision         string
+		want              interface{}
+		wantErrorContains string
+	}{
+		{"literal", "42", "", mustDecimal(t, "42"), ""},
+		{"percentage change", "($(a) - $(b)) / $(b) * 100", "", mustDecimal(t, "5.5"), ""},
+		{"precedence", "1 + 2 * 3 - 4 / 2", "", mustDecimal(t, "5"), ""},
+		{"parentheses", "(1 + 2) * 3", "", mustDecimal(t, "9"), ""},
+		{"unary minus", "-$(b) + --1", "", mustDecimal(t, "-99"), ""},
+		{"modulo", "$(b) % 7", "", mustDecimal(t, "2"), ""},
+		{"exponent notation", "1.5e3 + 2E-1", "", mustDecimal(t, "1500.2"), ""},
+		{"nested keypath", "$(answer.price) * 4", "", mustDecimal(t, "17"), ""},
+		{"array keypath", "$(feeds.1) + 1", "", mustDecimal(t, "3"), ""},
+		{"min", "min($(a), $(b), 103)", "", mustDecimal(t, "100"), ""},
+		{"max", "max($(a), $(b))", "", mustDecimal(t, "105.5"), ""},
+		{"abs", "abs($(b) - $(a))", "", mustDecimal(t, "5.5"), ""},
+		{"round", "round($(a))", "", mustDecimal(t, "106"), ""},
+		{"round places", "round(2 / 3, 2)", "", mustDecimal(t, "0.67"), ""},
+		{"precision", "2 / 3", "3", mustDecimal(t, "0.667"), ""},
+		{"default precision", "2 / 3", "", mustDecimal(t, "0.6666666666666667"), ""},
+		{"less than", "$(b) < $(a)", "", true, ""},
+		{"greater or equal", "$(b) >= $(a)", "", false, ""},
+		{"equal decimals", "$(b) == 100.00", "", true, ""},
+		{"not equal", "$(b) != 100", "", false, ""},
+		{"boolean logic", "$(ok) && !($(b) > 1000 || false)", "", true, ""},
+		{"bool equality", "$(ok) == true", "", true, ""},
+		{"short circuit", "false && $(missing) > 1", "", false, ""},
+		{"division by zero", "$(b) / $(zero)", "", nil, "division by zero"},
+		{"modulo by zero", "$(b) % $(zero)", "", nil, "division by zero"},
+		{"missing variable", "$(missing) + 1", "", nil, "keypath not found"},
+		{"non numeric variable", "$(text) + 1", "", nil, "$(text)"},
+		{"bool arithmetic", "$(ok) + 1", "", nil, "+ expects a number"},
+		{"number logic", "1 && true", "", nil, "&& expects a bool"},
+		{"mixed equality", "1 == true", "", nil, "cannot compare a bool with a number"},
+		{"fractional round places", "round(1, 0.5)", "", nil, "must be an integer"},
+		{"out of range precision", "1 / 3", "1000000", nil, "precision must be between"},
+		{"out of range round places", "round(1, 4294967296)", "", nil, "decimal places must be between"},
+		{"out of range variable", "$(huge) + 1", "", nil, "$(huge) is out of range"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.CalcTask{
+				BaseTask:  pipeline.NewBaseTask(0, "calc", nil, nil, 0),
+				Expr:      test.expr,
+				Precision: test.precision,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				if want, is := test.want.(*decimal.Decimal); is {
+					require.Equal(t, want.String(), result.Value.(decimal.Decimal).String())
+				} else {
+					require.Equal(t, test.want, result.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCalcExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		expr              string
+		wantErrorContains string
+	}{
+		{"empty", "", "unexpected end of expression"},
+		{"dangling operator", "1 +", "unexpected end of expression"},
+		{"unbalanced parentheses", "(1 + 2", "expected ')'"},
+		{"trailing tokens", "1 2", "unexpected '2'"},
+		{"unknown function", "pow(2, 3)", "unknown function 'pow'"},
+		{"function without call", "min", "expected '('"},
+		{"too few arguments", "round()", "unexpected ')'"},
+		{"too many arguments", "abs(1, 2)", "wrong number of arguments to abs"},
+		{"unknown character", "1 ^ 2", "unexpected character '^'"},
+		{"bad variable", "$(foo bar)", "invalid variable"},
+		{"keypath too deep", "$(a.b.c)", "keypath too deep"},
+		{"bad number", "1.2.3", "invalid number"},
+		{"chained comparison", "1 < 2 < 3", "comparisons cannot be chained"},
+		{"huge exponent", "1e999999999 + 1", "is out of range"},
+		{"huge negative exponent", "1e-999999999", "is out of range"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			_, err := pipeline.ParseCalcExpression(test.expr)
+			require.Error(t, err)
+			require.Equal(t, pipeline.ErrCalcExpression, errors.Cause(err))
+			require.Contains(t, err.Error(), test.wantErrorContains)
+		})
+	}
+
+	t.Run("nesting limit", func(t *testing.T) {
+		expr := ""
+		for i := 0; i < 100; i++ {
+			expr += "("
+		}
+		_, err := pipeline.ParseCalcExpression(expr + "1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "nested more than")
+	})
+
+	t.Run("validated when parsing the pipeline", func(t *testing.T) {
+		_, err := pipeline.Parse(`a [type=calc expr="$(foo) +"]`)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "task a: expr")
+
+		_, err = pipeline.Parse(`a [type=calc expr="$(foo) + 1"]`)
+		require.NoError(t, err)
+	})
+}
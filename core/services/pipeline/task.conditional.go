@@ -0,0 +1,151 @@
+package pipeline
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// DefaultBranch is the edge label of the branch a conditional task takes when
+// none of its other labelled edges match.
+const DefaultBranch = "default"
+
+// ConditionalTask selects which of its outputs execute. The outgoing edges are
+// labelled with the value that selects them:
+//
+//	check [type=conditional value="$(decode.changed)"]
+//	check -> submit [label="true"]
+//	check -> noop   [label="false"]
+//
+// If `equals` is set the branch is "true" or "false" depending on whether
+// `value` is equal to it, numbers are compared by value. Otherwise the branch is
+// `value` itself, which must be a bool, number or string. An edge labelled
+// "default" is taken when no other label matches, otherwise no labelled edge
+// is taken, and unlabelled edges are always taken. Tasks on branches that are
+// not taken are skipped, as are tasks whose inputs were all skipped.
+//
+// Return types:
+//
+//	bool
+//	string
+type ConditionalTask struct {
+	BaseTask `mapstructure:",squash"`
+	Value    string `json:"value"`
+	Equals   string `json:"equals"`
+
+	// branches maps output task IDs to the label on the edge leading to them
+	branches map[int]string
+}
+
+var _ Task = (*ConditionalTask)(nil)
+
+func (t *ConditionalTask) Type() TaskType {
+	return TaskTypeConditional
+}
+
+func (t *ConditionalTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var value ObjectParam
+	err = errors.Wrap(ResolveParam(&value, From(VarExpr(t.Value, vars), NonemptyString(t.Value), Input(inputs, 0))), "value")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var branch interface{}
+	if t.Equals != "" {
+		var equals ObjectParam
+		err = errors.Wrap(ResolveParam(&equals, From(VarExpr(t.Equals, vars), NonemptyString(t.Equals))), "equals")
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		branch, err = conditionalEquals(value, equals)
+	} else {
+		branch, err = conditionalBranch(value)
+	}
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: branch}, runInfo
+}
+
+func (t *ConditionalTask) setBranch(output Task, label string) {
+	if t.branches == nil {
+		t.branches = make(map[int]string)
+	}
+	t.branches[output.ID()] = label
+}
+
+func (t *ConditionalTask) hasBranch(label string) bool {
+	for _, l := range t.branches {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// takesBranch returns whether the edge to output is followed, given the
+// (successful) result of the conditional task
+func (t *ConditionalTask) takesBranch(result Result, output Task) bool {
+	label, labelled := t.branches[output.ID()]
+	if !labelled {
+		return true
+	}
+	key := branchKey(result.Value)
+	if label == key {
+		return true
+	}
+	return label == DefaultBranch && !t.hasBranch(key)
+}
+
+func conditionalBranch(value ObjectParam) (interface{}, error) {
+	switch value.Type {
+	case BoolType:
+		return bool(value.BoolValue), nil
+	case DecimalType:
+		return value.DecimalValue.Decimal().String(), nil
+	case StringType:
+		return string(value.StringValue), nil
+	default:
+		return nil, errors.Wrapf(ErrBadInput, "value must be a bool, number or string, got %v", value.String())
+	}
+}
+
+func conditionalEquals(value, equals ObjectParam) (bool, error) {
+	a, err := conditionalBranch(value)
+	if err != nil {
+		return false, err
+	}
+	b, err := conditionalBranch(equals)
+	if err != nil {
+		return false, errors.Wrap(err, "equals")
+	}
+	if value.Type == DecimalType || equals.Type == DecimalType {
+		var x, y DecimalParam
+		if multierr.Combine(x.UnmarshalPipelineParam(a), y.UnmarshalPipelineParam(b)) == nil {
+			return x.Decimal().Equal(y.Decimal()), nil
+		}
+	}
+	return branchKey(a) == branchKey(b), nil
+}
+
+// branchKey returns the edge label selected by the output of a conditional task
+func branchKey(value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
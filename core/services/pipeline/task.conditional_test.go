@@ -0,0 +1,86 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestConditionalTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		value             string
+		equals            string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		want              interface{}
+		wantErrorContains string
+	}{
+		{"bool from input", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: true}}, true, ""},
+		{"string from input", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "foo"}}, "foo", ""},
+		{"number from var", "$(foo.bar)", "", pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"bar": 1.5}}), nil, "1.5", ""},
+		{"equals string", "$(foo)", "bar", pipeline.NewVarsFrom(map[string]interface{}{"foo": "bar"}), nil, true, ""},
+		{"not equals string", "$(foo)", "baz", pipeline.NewVarsFrom(map[string]interface{}{"foo": "bar"}), nil, false, ""},
+		{"equals number", "", "100", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 100.0}}, true, ""},
+		{"equals number exponent", "", "1e2", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 100}}, true, ""},
+		{"strings are not compared as numbers", "", "1e2", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "100"}}, false, ""},
+		{"equals var", "$(foo)", "$(bar)", pipeline.NewVarsFrom(map[string]interface{}{"foo": 3, "bar": "3.0"}), nil, true, ""},
+		{"not equals number", "", "3", pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 4}}, false, ""},
+		{"map value", "$(foo)", "", pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{}}), nil, nil, "value must be a bool, number or string"},
+		{"errored input", "", "", pipeline.NewVarsFrom(nil), []pipeline.Result{{Error: pipeline.ErrTaskRunFailed}}, nil, "task inputs"},
+		{"no value", "", "", pipeline.NewVarsFrom(nil), nil, nil, "value"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ConditionalTask{
+				BaseTask: pipeline.NewBaseTask(0, "conditional", nil, nil, 0),
+				Value:    test.value,
+				Equals:   test.equals,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestConditionalTask_NoMatchingBranch(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+check [type=conditional]
+a     [type=memo value=1]
+b     [type=memo value=2]
+check -> a [label="foo"]
+check -> b [label="bar"]
+`)
+	require.NoError(t, err)
+
+	task := p.ByDotID("check")
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "bar"}})
+	require.NoError(t, result.Error)
+	require.Equal(t, "bar", result.Value)
+
+	// No branch is taken
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "baz"}})
+	require.NoError(t, result.Error)
+	require.Equal(t, "baz", result.Value)
+}
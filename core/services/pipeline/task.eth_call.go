@@ -2,10 +2,14 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -116,5 +120,24 @@ func (t *ETHCallTask) retrieveRevertReason(baseErr error, lggr logger.Logger) er
 		return baseErr
 	}
 
-	return errors.Wrap(baseErr, reason)
+	// The revert data is kept in the error as well, so that custom errors
+	// can be decoded too
+	data, _ := eth.ExtractRevertDataFromRPCError(baseErr)
+	return errors.Wrap(baseErr, strings.TrimSpace(fmt.Sprintf("%s (revert data %s)", reason, hexutil.Encode(data))))
+}
+
+var revertDataRegex = regexp.MustCompile(`\(revert data (0x[0-9a-fA-F]*)\)`)
+
+// RevertDataFromError returns the revert data in the error of an ethcall task
+// run with extractRevertReason set, or nil if there is none
+func RevertDataFromError(taskRunError string) []byte {
+	matches := revertDataRegex.FindStringSubmatch(taskRunError)
+	if len(matches) != 2 {
+		return nil
+	}
+	data, err := hexutil.Decode(matches[1])
+	if err != nil {
+		return nil
+	}
+	return data
 }
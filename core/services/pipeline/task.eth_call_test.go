@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
@@ -129,3 +130,31 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_RevertData(t *testing.T) {
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	task := pipeline.ETHCallTask{
+		BaseTask:            pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+		Contract:            contractAddr.Hex(),
+		Data:                "$(foo)",
+		ExtractRevertReason: true,
+	}
+
+	ethClient := new(ethmocks.Client)
+	ethClient.
+		On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Data: []byte("foo bar")}, (*big.Int)(nil)).
+		Return(nil, &eth.JsonError{Code: 3, Data: "0x12345678", Message: "execution reverted"})
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+	task.HelperSetDependencies(cc, cfg)
+
+	// A custom error has no revert reason, but its data is kept in the error
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"foo": []byte("foo bar")}), nil)
+	require.Error(t, result.Error)
+	assert.Nil(t, result.Value)
+	assert.Contains(t, result.Error.Error(), "execution reverted")
+	assert.Equal(t, []byte{0x12, 0x34, 0x56, 0x78}, pipeline.RevertDataFromError(result.Error.Error()))
+
+	assert.Nil(t, pipeline.RevertDataFromError("execution reverted"))
+}
@@ -31,6 +31,11 @@ type ETHTxTask struct {
 	MinConfirmations string `json:"minConfirmations"`
 	EVMChainID       string `json:"evmChainID" mapstructure:"evmChainID"`
 	Simulate         string `json:"simulate" mapstructure:"simulate"`
+	NotBefore        string `json:"notBefore"`
+	NotBeforeBlock   string `json:"notBeforeBlock"`
+	ExpiresAt        string `json:"expiresAt"`
+	ExpiresAtBlock   string `json:"expiresAtBlock"`
+	Batch            string `json:"batch"`
 
 	keyStore ETHKeyStore
 	chainSet evm.ChainSet
@@ -73,6 +78,11 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		txMetaMap             MapParam
 		maybeMinConfirmations MaybeUint64Param
 		simulate              BoolParam
+		notBefore             MaybeTimeParam
+		notBeforeBlock        MaybeUint64Param
+		expiresAt             MaybeTimeParam
+		expiresAtBlock        MaybeUint64Param
+		batch                 BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -82,6 +92,11 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&simulate, From(VarExpr(t.Simulate, vars), NonemptyString(t.Simulate), false)), "simulate"),
+		errors.Wrap(ResolveParam(&notBefore, From(VarExpr(t.NotBefore, vars), t.NotBefore)), "notBefore"),
+		errors.Wrap(ResolveParam(&notBeforeBlock, From(VarExpr(t.NotBeforeBlock, vars), t.NotBeforeBlock)), "notBeforeBlock"),
+		errors.Wrap(ResolveParam(&expiresAt, From(VarExpr(t.ExpiresAt, vars), t.ExpiresAt)), "expiresAt"),
+		errors.Wrap(ResolveParam(&expiresAtBlock, From(VarExpr(t.ExpiresAtBlock, vars), t.ExpiresAtBlock)), "expiresAtBlock"),
+		errors.Wrap(ResolveParam(&batch, From(VarExpr(t.Batch, vars), NonemptyString(t.Batch), false)), "batch"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -131,6 +146,9 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 
 	// NOTE: This can be easily adjusted later to allow job specs to specify the details of which strategy they would like
 	strategy := bulletprooftxmanager.NewSendEveryStrategy(bool(simulate))
+	if batch {
+		strategy = bulletprooftxmanager.NewBatchStrategy(bool(simulate))
+	}
 
 	newTx := bulletprooftxmanager.NewTx{
 		FromAddress:    fromAddr,
@@ -139,14 +157,28 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		GasLimit:       uint64(gasLimit),
 		Meta:           &txMeta,
 		Strategy:       strategy,
+		// Store the task run ID so we can resume the pipeline when tx is
+		// confirmed, and so that the tx can be found from the task run
+		PipelineTaskRunID: &t.uuid,
 	}
 
 	if minConfirmations > 0 {
-		// Store the task run ID so we can resume the pipeline when tx is confirmed
-		newTx.PipelineTaskRunID = &t.uuid
 		newTx.MinConfirmations = null.Uint32From(uint32(minConfirmations))
 	}
 
+	if at, isSet := notBefore.Time(); isSet {
+		newTx.NotBefore = &at
+	}
+	if n, isSet := notBeforeBlock.Uint64(); isSet {
+		newTx.NotBeforeBlock = null.Int64From(int64(n))
+	}
+	if at, isSet := expiresAt.Time(); isSet {
+		newTx.ExpiresAt = &at
+	}
+	if n, isSet := expiresAtBlock.Uint64(); isSet {
+		newTx.ExpiresAtBlock = null.Int64From(int64(n))
+	}
+
 	_, err = txManager.CreateEthTransaction(newTx)
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
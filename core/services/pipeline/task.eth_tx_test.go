@@ -3,9 +3,11 @@ package pipeline_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -58,12 +60,13 @@ func TestETHTxTask(t *testing.T) {
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
 				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-					FromAddress:    from,
-					ToAddress:      to,
-					EncodedPayload: data,
-					GasLimit:       gasLimit,
-					Meta:           txMeta,
-					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+					FromAddress:       from,
+					ToAddress:         to,
+					EncodedPayload:    data,
+					GasLimit:          gasLimit,
+					Meta:              txMeta,
+					Strategy:          bulletprooftxmanager.SendEveryStrategy{},
+					PipelineTaskRunID: &uuid.UUID{},
 				}).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "", pipeline.RunInfo{},
@@ -95,12 +98,13 @@ func TestETHTxTask(t *testing.T) {
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
 				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-					FromAddress:    from,
-					ToAddress:      to,
-					EncodedPayload: data,
-					GasLimit:       gasLimit,
-					Meta:           txMeta,
-					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+					FromAddress:       from,
+					ToAddress:         to,
+					EncodedPayload:    data,
+					GasLimit:          gasLimit,
+					Meta:              txMeta,
+					Strategy:          bulletprooftxmanager.SendEveryStrategy{},
+					PipelineTaskRunID: &uuid.UUID{},
 				}).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "", pipeline.RunInfo{},
@@ -134,12 +138,13 @@ func TestETHTxTask(t *testing.T) {
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
 				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-					FromAddress:    from,
-					ToAddress:      to,
-					EncodedPayload: data,
-					GasLimit:       gasLimit,
-					Meta:           txMeta,
-					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+					FromAddress:       from,
+					ToAddress:         to,
+					EncodedPayload:    data,
+					GasLimit:          gasLimit,
+					Meta:              txMeta,
+					Strategy:          bulletprooftxmanager.SendEveryStrategy{},
+					PipelineTaskRunID: &uuid.UUID{},
 				}).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "", pipeline.RunInfo{},
@@ -173,12 +178,13 @@ func TestETHTxTask(t *testing.T) {
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress").Return(from, nil)
 				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-					FromAddress:    from,
-					ToAddress:      to,
-					EncodedPayload: data,
-					GasLimit:       gasLimit,
-					Meta:           txMeta,
-					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+					FromAddress:       from,
+					ToAddress:         to,
+					EncodedPayload:    data,
+					GasLimit:          gasLimit,
+					Meta:              txMeta,
+					Strategy:          bulletprooftxmanager.SendEveryStrategy{},
+					PipelineTaskRunID: &uuid.UUID{},
 				}).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "", pipeline.RunInfo{},
@@ -202,12 +208,13 @@ func TestETHTxTask(t *testing.T) {
 				txMeta := &bulletprooftxmanager.EthTxMeta{}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
 				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-					FromAddress:    from,
-					ToAddress:      to,
-					EncodedPayload: data,
-					GasLimit:       gasLimit,
-					Meta:           txMeta,
-					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+					FromAddress:       from,
+					ToAddress:         to,
+					EncodedPayload:    data,
+					GasLimit:          gasLimit,
+					Meta:              txMeta,
+					Strategy:          bulletprooftxmanager.SendEveryStrategy{},
+					PipelineTaskRunID: &uuid.UUID{},
 				}).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "", pipeline.RunInfo{},
@@ -231,12 +238,13 @@ func TestETHTxTask(t *testing.T) {
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
 				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-					FromAddress:    from,
-					ToAddress:      to,
-					EncodedPayload: data,
-					GasLimit:       gasLimit,
-					Meta:           txMeta,
-					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+					FromAddress:       from,
+					ToAddress:         to,
+					EncodedPayload:    data,
+					GasLimit:          gasLimit,
+					Meta:              txMeta,
+					Strategy:          bulletprooftxmanager.SendEveryStrategy{},
+					PipelineTaskRunID: &uuid.UUID{},
 				}).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "", pipeline.RunInfo{},
@@ -286,12 +294,13 @@ func TestETHTxTask(t *testing.T) {
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
 				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-					FromAddress:    from,
-					ToAddress:      to,
-					EncodedPayload: data,
-					GasLimit:       gasLimit,
-					Meta:           txMeta,
-					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+					FromAddress:       from,
+					ToAddress:         to,
+					EncodedPayload:    data,
+					GasLimit:          gasLimit,
+					Meta:              txMeta,
+					Strategy:          bulletprooftxmanager.SendEveryStrategy{},
+					PipelineTaskRunID: &uuid.UUID{},
 				}).Return(bulletprooftxmanager.EthTx{}, errors.New("uh oh"))
 			},
 			nil, pipeline.ErrTaskRunFailed, "while creating transaction", pipeline.RunInfo{IsRetryable: true},
@@ -423,3 +432,82 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_Scheduling(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.ETHTxTask{
+		BaseTask:       pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:           `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+		To:             "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+		Data:           "foobar",
+		GasLimit:       "12345",
+		NotBefore:      "2021-10-18T00:00:00Z",
+		NotBeforeBlock: "$(block)",
+		ExpiresAt:      "1634601600",
+		ExpiresAtBlock: "200",
+	}
+
+	keyStore := new(keystoremocks.Eth)
+	keyStore.Test(t)
+	txManager := new(bptxmmocks.TxManager)
+	txManager.Test(t)
+	db := pgtest.NewGormDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+	task.HelperSetDependencies(cc, keyStore)
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+	txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+		return tx.NotBefore != nil && tx.NotBefore.Equal(time.Date(2021, 10, 18, 0, 0, 0, 0, time.UTC)) &&
+			tx.NotBeforeBlock == clnull.Int64From(100) &&
+			tx.ExpiresAt != nil && tx.ExpiresAt.Equal(time.Unix(1634601600, 0)) &&
+			tx.ExpiresAtBlock == clnull.Int64From(200)
+	})).Return(bulletprooftxmanager.EthTx{}, nil)
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"block": uint64(100)})
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+	require.NoError(t, result.Error)
+
+	keyStore.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
+
+func TestETHTxTask_Batch(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.ETHTxTask{
+		BaseTask: pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:     `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+		To:       "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+		Data:     "foobar",
+		GasLimit: "12345",
+		Simulate: "true",
+		Batch:    "$(batch)",
+	}
+
+	keyStore := new(keystoremocks.Eth)
+	keyStore.Test(t)
+	txManager := new(bptxmmocks.TxManager)
+	txManager.Test(t)
+	db := pgtest.NewGormDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+	task.HelperSetDependencies(cc, keyStore)
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+	txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+		return tx.Strategy == bulletprooftxmanager.NewBatchStrategy(true)
+	})).Return(bulletprooftxmanager.EthTx{}, nil)
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"batch": true})
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+	require.NoError(t, result.Error)
+
+	keyStore.AssertExpectations(t)
+	txManager.AssertExpectations(t)
+}
@@ -0,0 +1,233 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// ForEachItemKey and ForEachIndexKey are the variables holding the current
+	// element, and its position in the array, within the body of a foreach
+	ForEachItemKey  = "item"
+	ForEachIndexKey = "index"
+
+	defaultForEachParallelism = 5
+	// maxForEachParallelism bounds the number of elements run at once
+	maxForEachParallelism = 100
+)
+
+// ForEachTask runs a nested pipeline, given as DOT in `dag`, once for every
+// element of the `input` array and returns an array of the results:
+//
+//	prices [type=foreach input="$(decode_ids)" parallelism=4 dag="
+//	    fetch [type=http method=GET url=<$(item)>]
+//	    parse [type=jsonparse path=price]
+//	    fetch -> parse
+//	"]
+//
+// Since the DAG is a quoted string, its own attributes are best quoted with
+// angle brackets. The nested pipeline can access all variables of the outer pipeline, as well
+// as $(item) and $(index). An element's result is the output of the nested
+// pipeline's final task, or an array if there are several. The nested task runs
+// are recorded on the run, named <foreach>[<index>].<task>. Async tasks are not
+// supported in the nested pipeline. At most maxForEachParallelism elements are
+// run at once.
+//
+// Return types:
+//
+//	[]interface{}
+type ForEachTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Input       string `json:"input"`
+	DAG         string `json:"dag"`
+	Parallelism string `json:"parallelism"`
+
+	runPipeline func(ctx context.Context, p *Pipeline, vars Vars, l logger.Logger) TaskRunResults
+}
+
+var _ Task = (*ForEachTask)(nil)
+
+func (t *ForEachTask) Type() TaskType {
+	return TaskTypeForEach
+}
+
+func (t *ForEachTask) validate() error {
+	p, err := t.parseDAG()
+	if err != nil {
+		return err
+	}
+	if len(p.Tasks) == 0 {
+		return errors.New("dag: must contain at least one task")
+	}
+	if p.RequiresPreInsert() {
+		return errors.New("dag: async tasks are not supported within foreach")
+	}
+	for _, task := range p.Tasks {
+		if task.DotID() == ForEachItemKey || task.DotID() == ForEachIndexKey {
+			return errors.Errorf("dag: '%v' is reserved within foreach and cannot be used as a task's name", task.DotID())
+		}
+	}
+	// parallelism taken from a variable can only be checked when run
+	if variableRegexp.MatchString(t.Parallelism) {
+		return nil
+	}
+	var maybeParallelism MaybeUint64Param
+	if err = ResolveParam(&maybeParallelism, From(t.Parallelism)); err != nil {
+		return errors.Wrap(err, "parallelism")
+	}
+	_, err = forEachParallelism(maybeParallelism)
+	return err
+}
+
+// forEachParallelism returns the parallelism param, or the default if it is
+// not set
+func forEachParallelism(maybeParallelism MaybeUint64Param) (uint64, error) {
+	n, isSet := maybeParallelism.Uint64()
+	if !isSet {
+		return defaultForEachParallelism, nil
+	}
+	if n == 0 || n > maxForEachParallelism {
+		return 0, errors.Wrapf(ErrBadInput, "parallelism must be between 1 and %d, got %d", maxForEachParallelism, n)
+	}
+	return n, nil
+}
+
+func (t *ForEachTask) parseDAG() (*Pipeline, error) {
+	// The DOT decoder leaves quoted strings spanning multiple lines as they are
+	dag := strings.TrimSpace(t.DAG)
+	if len(dag) >= 2 && strings.HasPrefix(dag, `"`) && strings.HasSuffix(dag, `"`) {
+		dag = strings.ReplaceAll(dag[1:len(dag)-1], `\"`, `"`)
+	}
+	p, err := Parse(dag)
+	return p, errors.Wrap(err, "dag")
+}
+
+func (t *ForEachTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		items            SliceParam
+		maybeParallelism MaybeUint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&items, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybeParallelism, From(VarExpr(t.Parallelism, vars), t.Parallelism)), "parallelism"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if t.runPipeline == nil {
+		return Result{Error: errors.New("foreach task is missing its pipeline runner")}, runInfo
+	}
+
+	parallelism, err := forEachParallelism(maybeParallelism)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if n := uint64(len(items)); n < parallelism {
+		parallelism = n
+	}
+
+	var (
+		values   = make([]interface{}, len(items))
+		errs     = make([]error, len(items))
+		taskRuns = make([][]TaskRun, len(items))
+		sem      = make(chan struct{}, parallelism)
+		wg       sync.WaitGroup
+	)
+	for i, item := range items {
+		// already validated, so this can't fail
+		p, err := t.parseDAG()
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+
+		itemVars := vars.Copy()
+		itemVars.Set(ForEachItemKey, item)
+		itemVars.Set(ForEachIndexKey, i)
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, p *Pipeline, itemVars Vars) {
+			defer func() {
+				if err := recover(); err != nil {
+					errs[i] = ErrRunPanicked{err}
+				}
+				<-sem
+				wg.Done()
+			}()
+
+			trrs := t.runPipeline(ctx, p, itemVars, lggr.With("foreachIndex", i))
+			taskRuns[i] = t.nestedTaskRuns(i, trrs)
+
+			fr := trrs.FinalResult()
+			for _, err := range fr.FatalErrors {
+				if err != nil {
+					errs[i] = err
+					return
+				}
+			}
+			if len(fr.Values) == 1 {
+				values[i] = fr.Values[0]
+			} else {
+				values[i] = fr.Values
+			}
+		}(i, p, itemVars)
+	}
+	wg.Wait()
+
+	for _, trs := range taskRuns {
+		runInfo.taskRuns = append(runInfo.taskRuns, trs...)
+	}
+	for i, err := range errs {
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "element %v", i)}, runInfo
+		}
+	}
+	return Result{Value: values}, runInfo
+}
+
+// nestedTaskRuns names the task runs of the i-th element after the foreach
+// task, so that they are unique within the run
+func (t *ForEachTask) nestedTaskRuns(i int, trrs TaskRunResults) []TaskRun {
+	var taskRuns []TaskRun
+	for _, trr := range trrs {
+		taskRuns = append(taskRuns, TaskRun{
+			ID:         trr.ID,
+			Type:       trr.Task.Type(),
+			Index:      trr.Task.OutputIndex(),
+			Output:     trr.Result.OutputDB(),
+			Error:      trr.Result.ErrorDB(),
+			DotID:      nestedDotID(t.DotID(), i, trr.Task.DotID()),
+			CreatedAt:  trr.CreatedAt,
+			FinishedAt: trr.FinishedAt,
+			Skipped:    trr.Skipped,
+		})
+		// task runs nested further down, e.g. by a foreach within the foreach
+		for _, tr := range trr.runInfo.taskRuns {
+			tr.DotID = nestedDotID(t.DotID(), i, tr.DotID)
+			taskRuns = append(taskRuns, tr)
+		}
+	}
+	return taskRuns
+}
+
+func nestedDotID(parent string, index int, dotID string) string {
+	return fmt.Sprintf("%v[%v].%v", parent, index, dotID)
+}
+
+// isNestedDotID returns true if the task run belongs to a pipeline nested
+// within a task, rather than to the run's own pipeline
+func isNestedDotID(dotID string) bool {
+	return strings.Contains(dotID, "].")
+}
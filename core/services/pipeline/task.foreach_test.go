@@ -0,0 +1,47 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestForEachTask_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		spec              string
+		wantErrorContains string
+	}{
+		{"valid", `a [type=foreach input="$(foo)" dag="b [type=multiply input=<$(item)> times=2]"]`, ""},
+		{"multiline", "a [type=foreach input=\"$(foo)\" dag=\"\n\tb [type=any]\n\tc [type=any]\n\tb -> c\n\"]", ""},
+		{"empty dag", `a [type=foreach input="$(foo)" dag=""]`, "must contain at least one task"},
+		{"invalid dag", `a [type=foreach input="$(foo)" dag="b [type=nope]"]`, "task a: dag"},
+		{"async task", `a [type=foreach input="$(foo)" dag="b [type=bridge name=foo async=true]"]`, "async tasks are not supported"},
+		{"reserved item", `a [type=foreach input="$(foo)" dag="item [type=any]"]`, "'item' is reserved"},
+		{"reserved index", `a [type=foreach input="$(foo)" dag="index [type=any]"]`, "'index' is reserved"},
+		{"max parallelism", `a [type=foreach input="$(foo)" parallelism=100 dag="b [type=any]"]`, ""},
+		{"parallelism from a variable", `a [type=foreach input="$(foo)" parallelism="$(n)" dag="b [type=any]"]`, ""},
+		{"zero parallelism", `a [type=foreach input="$(foo)" parallelism=0 dag="b [type=any]"]`, "parallelism must be between 1 and 100"},
+		{"parallelism too large", `a [type=foreach input="$(foo)" parallelism=101 dag="b [type=any]"]`, "parallelism must be between 1 and 100"},
+		{"parallelism overflows", `a [type=foreach input="$(foo)" parallelism=99999999999999999999999 dag="b [type=any]"]`, "parallelism"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			p, err := pipeline.Parse(test.spec)
+			if test.wantErrorContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.wantErrorContains)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, p.Tasks, 1)
+				require.Equal(t, pipeline.TaskTypeForEach, p.Tasks[0].Type())
+			}
+		})
+	}
+}
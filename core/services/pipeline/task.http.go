@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"sort"
 
 	"go.uber.org/multierr"
 
@@ -22,9 +23,11 @@ type HTTPTask struct {
 	Method                         string
 	URL                            string
 	RequestData                    string `json:"requestData"`
+	Headers                        string
 	AllowUnrestrictedNetworkAccess string
 
-	config Config
+	config  Config
+	secrets Secrets
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -70,27 +73,41 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		return Result{Error: err}, runInfo
 	}
 
+	reqHeaders, err := resolveHeaders(t.Headers, vars, t.secrets)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "headers")}, runInfo
+	}
+
 	requestDataJSON, err := json.Marshal(requestData)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	// Header values may contain secrets, so only their names are logged
+	headerNames := make([]string, 0, len(reqHeaders))
+	for name := range reqHeaders {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
 	lggr.Debugw("HTTP task: sending request",
-		"requestData", string(requestDataJSON),
+		"requestData", t.secrets.Redact(string(requestDataJSON)),
 		"url", url.String(),
 		"method", method,
+		"headers", headerNames,
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
 	)
 
-	responseBytes, statusCode, _, elapsed, err := makeHTTPRequest(ctx, method, url, requestData, allowUnrestrictedNetworkAccess, t.config)
+	responseBytes, statusCode, _, elapsed, err := makeHTTPRequest(ctx, method, url, requestData, reqHeaders, allowUnrestrictedNetworkAccess, t.config)
 	if err != nil {
 		if errors.Cause(err) == utils.ErrDisallowedIP {
 			err = errors.Wrap(err, "connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess=true in the pipeline task spec")
 		}
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		return Result{Error: t.secrets.RedactError(err)}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
+	// The response is passed on unchanged, only its logged copy is redacted
+	response := string(responseBytes)
 
 	lggr.Debugw("HTTP task got response",
-		"response", string(responseBytes),
+		"response", t.secrets.Redact(response),
 		"url", url.String(),
 		"dotID", t.DotID(),
 	)
@@ -102,5 +119,5 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
-	return Result{Value: string(responseBytes)}, runInfo
+	return Result{Value: response}, runInfo
 }
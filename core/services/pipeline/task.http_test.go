@@ -297,3 +297,57 @@ func TestHTTPTask_OnlyErrorMessage(t *testing.T) {
 	require.Contains(t, result.Error.Error(), "RequestId")
 	require.Nil(t, result.Value)
 }
+
+func TestHTTPTask_Headers(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	var received http.Header
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"echo": "` + r.Header.Get("Authorization") + `"}`))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	task := pipeline.HTTPTask{
+		Method:  "GET",
+		URL:     server.URL,
+		Headers: `{"Authorization": "Bearer $(secrets.token)", "X-Request-Id": "$(requestID)"}`,
+	}
+	task.HelperSetDependencies(config)
+	task.HelperSetSecrets(pipeline.Secrets{"token": "s3cr3t-token"})
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"requestID": "123"})
+	result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+	assert.False(t, runInfo.IsPending)
+	assert.False(t, runInfo.IsRetryable)
+	require.NoError(t, result.Error)
+	// The response is not redacted, as downstream tasks consume it
+	require.Equal(t, `{"echo": "Bearer s3cr3t-token"}`, result.Value)
+	assert.Equal(t, "Bearer s3cr3t-token", received.Get("Authorization"))
+	assert.Equal(t, "123", received.Get("X-Request-Id"))
+	assert.Equal(t, "application/json", received.Get("Content-Type"))
+
+	t.Run("missing secret", func(t *testing.T) {
+		task.Headers = `{"Authorization": "Bearer $(secrets.nope)"}`
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Error(t, result.Error)
+		require.Equal(t, pipeline.ErrSecretNotFound, errors.Cause(result.Error))
+	})
+
+	t.Run("headers from a variable cannot reference secrets", func(t *testing.T) {
+		task.Headers = `$(headers)`
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"headers": map[string]interface{}{"Authorization": "Bearer $(secrets.token)"},
+		})
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"echo": "Bearer $(secrets.token)"}`, result.Value)
+		assert.Equal(t, "Bearer $(secrets.token)", received.Get("Authorization"))
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -418,6 +419,59 @@ func (p MaybeInt32Param) Int32() (int32, bool) {
 	return p.n, p.isSet
 }
 
+// MaybeTimeParam is a point in time, given as an RFC3339 string or as Unix
+// seconds
+type MaybeTimeParam struct {
+	t     time.Time
+	isSet bool
+}
+
+func (p *MaybeTimeParam) UnmarshalPipelineParam(val interface{}) error {
+	var t time.Time
+	switch v := val.(type) {
+	case time.Time:
+		t = v
+	case int:
+		t = time.Unix(int64(v), 0)
+	case int64:
+		t = time.Unix(v, 0)
+	case uint64:
+		t = time.Unix(int64(v), 0)
+	case float64: // when decoding from db: JSON numbers are floats
+		t = time.Unix(int64(v), 0)
+	case *big.Int:
+		if !v.IsInt64() {
+			return errors.Wrap(ErrBadInput, "overflows int64")
+		}
+		t = time.Unix(v.Int64(), 0)
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			*p = MaybeTimeParam{time.Time{}, false}
+			return nil
+		}
+		if secs, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			t = time.Unix(secs, 0)
+			break
+		}
+		var err error
+		t, err = time.Parse(time.RFC3339, trimmed)
+		if err != nil {
+			return errors.Wrap(ErrBadInput, err.Error())
+		}
+
+	default:
+		return errors.Wrapf(ErrBadInput, "expected time, Unix seconds or nil, got %T", val)
+	}
+
+	*p = MaybeTimeParam{t, true}
+	return nil
+}
+
+func (p MaybeTimeParam) Time() (time.Time, bool) {
+	return p.t, p.isSet
+}
+
 type BoolParam bool
 
 func (b *BoolParam) UnmarshalPipelineParam(val interface{}) error {
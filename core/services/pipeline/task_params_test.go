@@ -1,8 +1,10 @@
 package pipeline_test
 
 import (
+	"math/big"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -191,6 +193,45 @@ func TestUint64Param_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestMaybeTimeParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	at := time.Unix(1634515200, 0)
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected time.Time
+		isSet    bool
+		err      error
+	}{
+		{"time", at, at, true, nil},
+		{"RFC3339 string", at.UTC().Format(time.RFC3339), at.UTC(), true, nil},
+		{"Unix seconds string", "1634515200", at, true, nil},
+		{"int", int(1634515200), at, true, nil},
+		{"int64", int64(1634515200), at, true, nil},
+		{"float64", float64(1634515200), at, true, nil},
+		{"*big.Int", big.NewInt(1634515200), at, true, nil},
+		{"empty string", "", time.Time{}, false, nil},
+		{"bad string", "tomorrow", time.Time{}, false, pipeline.ErrBadInput},
+		{"bool", true, time.Time{}, false, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var p pipeline.MaybeTimeParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			got, isSet := p.Time()
+			require.Equal(t, test.isSet, isSet)
+			require.True(t, test.expected.Equal(got))
+		})
+	}
+}
+
 func TestBoolParam_UnmarshalPipelineParam(t *testing.T) {
 	t.Parallel()
 
@@ -124,6 +124,7 @@ func (lsn *listenerV1) Start() error {
 			// We listen one block early so that the log can be stored in pendingRequests
 			// to avoid this.
 			NumConfirmations: uint64(spec.Confirmations - 1),
+			ConfirmationTag:  spec.ConfirmationTag,
 		})
 		// Subscribe to the head broadcaster for handling
 		// per request conf requirements.
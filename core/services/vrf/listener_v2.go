@@ -101,6 +101,7 @@ func (lsn *listenerV2) Start() error {
 				},
 			},
 			// Do not specify min confirmations, as it varies from request to request.
+			ConfirmationTag: spec.ConfirmationTag,
 		})
 
 		// Log listener gathers request logs
@@ -47,6 +47,9 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	if spec.Confirmations == 0 {
 		return jb, errors.Wrap(ErrKeyNotSet, "confirmations")
 	}
+	if err = job.ValidateConfirmationTag(spec.ConfirmationTag); err != nil {
+		return jb, err
+	}
 	if spec.CoordinatorAddress.String() == "" {
 		return jb, errors.Wrap(ErrKeyNotSet, "coordinatorAddress")
 	}
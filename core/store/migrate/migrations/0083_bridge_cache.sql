@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN cache_ttl bigint NOT NULL DEFAULT 0 CHECK (cache_ttl >= 0),
+    ADD COLUMN cache_hits bigint NOT NULL DEFAULT 0,
+    ADD COLUMN cache_last_hit_at timestamptz;
+
+CREATE TABLE bridge_last_values (
+    bridge_name text NOT NULL REFERENCES bridge_types (name) ON DELETE CASCADE,
+    request_hash bytea NOT NULL,
+    value bytea NOT NULL,
+    finished_at timestamptz NOT NULL,
+    PRIMARY KEY (bridge_name, request_hash)
+);
+
+-- +goose Down
+DROP TABLE bridge_last_values;
+ALTER TABLE bridge_types
+    DROP COLUMN cache_ttl,
+    DROP COLUMN cache_hits,
+    DROP COLUMN cache_last_hit_at;
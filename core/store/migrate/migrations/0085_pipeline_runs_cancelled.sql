@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Postgres v11 does not allow a new enum value to be used in the same
+-- transaction, so the type is recreated instead
+ALTER TABLE pipeline_runs DROP CONSTRAINT pipeline_runs_check;
+DROP INDEX pipeline_runs_suspended;
+ALTER TABLE pipeline_runs ALTER COLUMN state DROP DEFAULT;
+
+ALTER TYPE pipeline_runs_state RENAME TO pipeline_runs_state_old;
+CREATE TYPE pipeline_runs_state AS ENUM (
+	'running',
+	'suspended',
+	'errored',
+	'completed',
+	'cancelled'
+);
+ALTER TABLE pipeline_runs ALTER COLUMN state TYPE pipeline_runs_state USING state::text::pipeline_runs_state;
+DROP TYPE pipeline_runs_state_old;
+
+ALTER TABLE pipeline_runs ALTER COLUMN state SET DEFAULT 'completed';
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_check CHECK (
+	((state IN ('completed', 'errored', 'cancelled')) AND (finished_at IS NOT NULL) AND (num_nulls(outputs, fatal_errors) = 0))
+		OR
+	((state IN ('running', 'suspended')) AND num_nulls(finished_at, outputs, fatal_errors) = 3)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE pipeline_runs DROP CONSTRAINT pipeline_runs_check;
+DROP INDEX pipeline_runs_suspended;
+ALTER TABLE pipeline_runs ALTER COLUMN state DROP DEFAULT;
+
+-- Cancelled runs are kept as errored runs, they already have their errors set
+UPDATE pipeline_runs SET state = 'errored' WHERE state = 'cancelled';
+
+ALTER TYPE pipeline_runs_state RENAME TO pipeline_runs_state_old;
+CREATE TYPE pipeline_runs_state AS ENUM (
+	'running',
+	'suspended',
+	'errored',
+	'completed'
+);
+ALTER TABLE pipeline_runs ALTER COLUMN state TYPE pipeline_runs_state USING state::text::pipeline_runs_state;
+DROP TYPE pipeline_runs_state_old;
+
+ALTER TABLE pipeline_runs ALTER COLUMN state SET DEFAULT 'completed';
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_check CHECK (
+	((state IN ('completed', 'errored')) AND (finished_at IS NOT NULL) AND (num_nulls(outputs, fatal_errors) = 0))
+		OR
+	((state IN ('running', 'suspended')) AND num_nulls(finished_at, outputs, fatal_errors) = 3)
+);
+
+-- +goose StatementEnd
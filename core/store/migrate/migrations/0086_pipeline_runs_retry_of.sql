@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE pipeline_runs ADD COLUMN retry_of_run_id bigint REFERENCES pipeline_runs (id) ON DELETE SET NULL;
+CREATE INDEX idx_pipeline_runs_retry_of_run_id ON pipeline_runs (retry_of_run_id) WHERE retry_of_run_id IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE pipeline_runs DROP COLUMN retry_of_run_id;
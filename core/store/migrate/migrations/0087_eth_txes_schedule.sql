@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE eth_txes
+    ADD COLUMN not_before timestamptz,
+    ADD COLUMN not_before_block bigint,
+    ADD COLUMN expires_at timestamptz,
+    ADD COLUMN expires_at_block bigint;
+CREATE INDEX idx_eth_txes_expiring ON eth_txes (evm_chain_id, from_address, state) WHERE expires_at IS NOT NULL OR expires_at_block IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE eth_txes
+    DROP COLUMN not_before,
+    DROP COLUMN not_before_block,
+    DROP COLUMN expires_at,
+    DROP COLUMN expires_at_block;
@@ -0,0 +1,124 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE eth_txes
+    ADD COLUMN batchable boolean NOT NULL DEFAULT false,
+    ADD COLUMN batch_eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE RESTRICT,
+    ADD COLUMN batch_index integer,
+    ADD CONSTRAINT chk_eth_txes_batch_index CHECK ((batch_eth_tx_id IS NULL) = (batch_index IS NULL));
+CREATE INDEX idx_eth_txes_batch_eth_tx_id ON eth_txes (batch_eth_tx_id) WHERE batch_eth_tx_id IS NOT NULL;
+
+-- Postgres v11 does not allow a new enum value to be used in the same
+-- transaction, so the type is recreated instead
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+DROP INDEX idx_eth_txes_min_unconfirmed_nonce_for_key_evm_chain_id;
+DROP INDEX idx_only_one_in_progress_tx_per_account_id_per_evm_chain_id;
+DROP INDEX idx_eth_txes_state_from_address_evm_chain_id;
+DROP INDEX idx_eth_txes_unstarted_subject_id_evm_chain_id;
+ALTER TABLE eth_txes ALTER COLUMN state DROP DEFAULT;
+
+ALTER TYPE eth_txes_state RENAME TO eth_txes_state_old;
+CREATE TYPE eth_txes_state AS ENUM (
+    'unstarted',
+    'in_progress',
+    'fatal_error',
+    'unconfirmed',
+    'confirmed_missing_receipt',
+    'confirmed',
+    'batched'
+);
+ALTER TABLE eth_txes ALTER COLUMN state TYPE eth_txes_state USING state::text::eth_txes_state;
+DROP TYPE eth_txes_state_old;
+
+ALTER TABLE eth_txes ALTER COLUMN state SET DEFAULT 'unstarted';
+CREATE INDEX idx_eth_txes_min_unconfirmed_nonce_for_key_evm_chain_id ON eth_txes(evm_chain_id, from_address, nonce) WHERE state = 'unconfirmed'::eth_txes_state;
+CREATE UNIQUE INDEX idx_only_one_in_progress_tx_per_account_id_per_evm_chain_id ON eth_txes(evm_chain_id, from_address) WHERE state = 'in_progress'::eth_txes_state;
+CREATE INDEX idx_eth_txes_state_from_address_evm_chain_id ON eth_txes(evm_chain_id, from_address, state) WHERE state <> 'confirmed'::eth_txes_state;
+CREATE INDEX idx_eth_txes_unstarted_subject_id_evm_chain_id ON eth_txes(evm_chain_id, subject, id) WHERE subject IS NOT NULL AND state = 'unstarted'::eth_txes_state;
+
+-- A batched transaction is sent as call batch_index of the transaction
+-- batch_eth_tx_id, and is confirmed along with it, without a nonce of its own
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND batch_eth_tx_id IS NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND batch_eth_tx_id IS NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND batch_eth_tx_id IS NULL
+    OR
+    state = 'batched'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NOT NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Batched transactions may already have been sent on-chain as part of their
+-- batch, so they can neither be dropped nor sent again on their own
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM eth_txes WHERE state = 'batched') THEN
+        RAISE EXCEPTION 'cannot roll back while there are batched eth_txes, wait for their batches to be confirmed';
+    END IF;
+END $$;
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+DROP INDEX idx_eth_txes_min_unconfirmed_nonce_for_key_evm_chain_id;
+DROP INDEX idx_only_one_in_progress_tx_per_account_id_per_evm_chain_id;
+DROP INDEX idx_eth_txes_state_from_address_evm_chain_id;
+DROP INDEX idx_eth_txes_unstarted_subject_id_evm_chain_id;
+ALTER TABLE eth_txes ALTER COLUMN state DROP DEFAULT;
+
+-- Confirmed batched transactions have no nonce of their own, so they are kept
+-- as errored transactions which were never sent on their own
+UPDATE eth_txes SET state = 'fatal_error', error = 'sent as call ' || batch_index || ' of the batch eth_tx ' || batch_eth_tx_id
+WHERE state = 'confirmed' AND batch_eth_tx_id IS NOT NULL;
+
+ALTER TYPE eth_txes_state RENAME TO eth_txes_state_old;
+CREATE TYPE eth_txes_state AS ENUM (
+    'unstarted',
+    'in_progress',
+    'fatal_error',
+    'unconfirmed',
+    'confirmed_missing_receipt',
+    'confirmed'
+);
+ALTER TABLE eth_txes ALTER COLUMN state TYPE eth_txes_state USING state::text::eth_txes_state;
+DROP TYPE eth_txes_state_old;
+
+ALTER TABLE eth_txes ALTER COLUMN state SET DEFAULT 'unstarted';
+CREATE INDEX idx_eth_txes_min_unconfirmed_nonce_for_key_evm_chain_id ON eth_txes(evm_chain_id, from_address, nonce) WHERE state = 'unconfirmed'::eth_txes_state;
+CREATE UNIQUE INDEX idx_only_one_in_progress_tx_per_account_id_per_evm_chain_id ON eth_txes(evm_chain_id, from_address) WHERE state = 'in_progress'::eth_txes_state;
+CREATE INDEX idx_eth_txes_state_from_address_evm_chain_id ON eth_txes(evm_chain_id, from_address, state) WHERE state <> 'confirmed'::eth_txes_state;
+CREATE INDEX idx_eth_txes_unstarted_subject_id_evm_chain_id ON eth_txes(evm_chain_id, subject, id) WHERE subject IS NOT NULL AND state = 'unstarted'::eth_txes_state;
+
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
+
+DROP INDEX idx_eth_txes_batch_eth_tx_id;
+ALTER TABLE eth_txes
+    DROP CONSTRAINT chk_eth_txes_batch_index,
+    DROP COLUMN batchable,
+    DROP COLUMN batch_eth_tx_id,
+    DROP COLUMN batch_index;
+
+-- +goose StatementEnd
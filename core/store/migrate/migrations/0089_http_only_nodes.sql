@@ -0,0 +1,39 @@
+-- +goose Up
+ALTER TABLE nodes DROP CONSTRAINT primary_or_sendonly;
+ALTER TABLE nodes ADD CONSTRAINT primary_or_sendonly CHECK (
+    (send_only AND ws_url IS NULL AND http_url IS NOT NULL)
+    OR
+    (NOT send_only AND (ws_url IS NOT NULL OR http_url IS NOT NULL))
+);
+
+-- The block up to which logs have been polled for, on chains whose nodes do
+-- not support subscriptions
+CREATE TABLE log_broadcasts_poll_cursors (
+    evm_chain_id numeric(78,0) REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE PRIMARY KEY,
+    block_number int8 NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Nodes without a websocket URL are configured by the operator, so they are
+-- not deleted here
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM nodes WHERE NOT send_only AND ws_url IS NULL) THEN
+        RAISE EXCEPTION 'cannot roll back while there are primary nodes without a websocket URL, give them one or remove them first';
+    END IF;
+END $$;
+
+DROP TABLE log_broadcasts_poll_cursors;
+
+ALTER TABLE nodes DROP CONSTRAINT primary_or_sendonly;
+ALTER TABLE nodes ADD CONSTRAINT primary_or_sendonly CHECK (
+    (send_only AND ws_url IS NULL AND http_url IS NOT NULL)
+    OR
+    (NOT send_only AND ws_url IS NOT NULL)
+);
+
+-- +goose StatementEnd
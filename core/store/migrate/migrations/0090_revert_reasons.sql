@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE eth_receipts ADD COLUMN revert_reason text;
+ALTER TABLE eth_txes ADD COLUMN revert_reason text;
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN revert_reason;
+ALTER TABLE eth_receipts DROP COLUMN revert_reason;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_key_states ADD COLUMN budget_tripped_at timestamptz;
+
+-- +goose Down
+ALTER TABLE eth_key_states DROP COLUMN budget_tripped_at;
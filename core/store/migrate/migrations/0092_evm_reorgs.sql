@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE evm_reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE INITIALLY IMMEDIATE,
+    depth bigint NOT NULL,
+    orphaned_hashes bytea[] NOT NULL,
+    common_ancestor_hash bytea,
+    common_ancestor_number bigint,
+    previous_head_hash bytea NOT NULL,
+    previous_head_number bigint NOT NULL,
+    new_head_hash bytea NOT NULL,
+    new_head_number bigint NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_evm_reorgs_evm_chain_id_id ON evm_reorgs (evm_chain_id, id DESC);
+
+-- +goose Down
+DROP TABLE evm_reorgs;
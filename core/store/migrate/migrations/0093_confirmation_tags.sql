@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE direct_request_specs ADD COLUMN confirmation_tag text NOT NULL DEFAULT '' CHECK (confirmation_tag IN ('', 'finalized', 'safe'));
+ALTER TABLE flux_monitor_specs ADD COLUMN confirmation_tag text NOT NULL DEFAULT '' CHECK (confirmation_tag IN ('', 'finalized', 'safe'));
+ALTER TABLE vrf_specs ADD COLUMN confirmation_tag text NOT NULL DEFAULT '' CHECK (confirmation_tag IN ('', 'finalized', 'safe'));
+
+-- +goose Down
+ALTER TABLE direct_request_specs DROP COLUMN confirmation_tag;
+ALTER TABLE flux_monitor_specs DROP COLUMN confirmation_tag;
+ALTER TABLE vrf_specs DROP COLUMN confirmation_tag;
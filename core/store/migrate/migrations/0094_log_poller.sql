@@ -0,0 +1,48 @@
+-- +goose Up
+-- The contracts and events whose logs the log poller persists for each job,
+-- with the block up to which logs have been polled for
+CREATE TABLE log_poller_filters (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    job_id int4 NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    address bytea NOT NULL CHECK (octet_length(address) = 20),
+    event_sigs bytea[] NOT NULL,
+    start_block bigint,
+    block_number bigint,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_log_poller_filters_evm_chain_id_job_id_address ON log_poller_filters (evm_chain_id, job_id, address);
+
+CREATE TABLE log_poller_logs (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    block_hash bytea NOT NULL,
+    block_number bigint NOT NULL,
+    log_index bigint NOT NULL,
+    address bytea NOT NULL,
+    event_sig bytea NOT NULL,
+    topics bytea[] NOT NULL,
+    tx_hash bytea NOT NULL,
+    tx_index bigint NOT NULL,
+    data bytea NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, block_hash, log_index)
+);
+
+CREATE INDEX idx_log_poller_logs_evm_chain_id_address_event_sig_block_number ON log_poller_logs (evm_chain_id, address, event_sig, block_number);
+CREATE INDEX idx_log_poller_logs_evm_chain_id_block_number ON log_poller_logs (evm_chain_id, block_number);
+
+-- The recent blocks seen by the log poller, used to detect re-orgs
+CREATE TABLE log_poller_blocks (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    block_number bigint NOT NULL,
+    block_hash bytea NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, block_number)
+);
+
+-- +goose Down
+DROP TABLE log_poller_blocks;
+DROP TABLE log_poller_logs;
+DROP TABLE log_poller_filters;
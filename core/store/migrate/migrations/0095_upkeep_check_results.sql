@@ -0,0 +1,18 @@
+-- +goose Up
+-- The result of the last time the node checked, and tried to perform, each upkeep
+ALTER TABLE upkeep_registrations
+    ADD COLUMN last_check_block_number bigint,
+    ADD COLUMN last_check_outcome text CHECK (last_check_outcome IN ('performed', 'check_failed', 'perform_failed', 'gas_estimation_failed', 'run_failed')),
+    ADD COLUMN last_check_error text,
+    ADD COLUMN last_check_revert_data bytea,
+    ADD COLUMN last_check_at timestamptz,
+    ADD COLUMN last_perform_eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE SET NULL DEFERRABLE INITIALLY IMMEDIATE;
+
+-- +goose Down
+ALTER TABLE upkeep_registrations
+    DROP COLUMN last_check_block_number,
+    DROP COLUMN last_check_outcome,
+    DROP COLUMN last_check_error,
+    DROP COLUMN last_check_revert_data,
+    DROP COLUMN last_check_at,
+    DROP COLUMN last_perform_eth_tx_id;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- What an unconfirmed eth_tx was cancelled or replaced with. The eth_tx keeps
+-- its original fields, since its earlier attempts may still be mined.
+CREATE TABLE eth_tx_replacements (
+    eth_tx_id bigint PRIMARY KEY REFERENCES eth_txes (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    to_address bytea NOT NULL CHECK (octet_length(to_address) = 20),
+    encoded_payload bytea NOT NULL,
+    value numeric(78, 0) NOT NULL,
+    gas_limit bigint NOT NULL,
+    cancelled boolean NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE eth_tx_replacements;
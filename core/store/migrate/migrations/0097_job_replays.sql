@@ -0,0 +1,22 @@
+-- +goose Up
+-- The replays of the logs of a job, and their progress
+CREATE TABLE job_replays (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    job_id int4 NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    contract bytea CHECK (octet_length(contract) = 20),
+    from_block bigint NOT NULL,
+    to_block bigint NOT NULL,
+    ignore_consumed boolean NOT NULL,
+    block_number bigint,
+    logs_delivered bigint NOT NULL,
+    state text NOT NULL CHECK (state IN ('in_progress', 'completed', 'errored')),
+    error text,
+    created_at timestamptz NOT NULL,
+    finished_at timestamptz
+);
+
+CREATE INDEX idx_job_replays_evm_chain_id_job_id ON job_replays (evm_chain_id, job_id);
+
+-- +goose Down
+DROP TABLE job_replays;
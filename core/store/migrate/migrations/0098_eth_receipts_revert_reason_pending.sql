@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE eth_receipts ADD COLUMN revert_reason_pending boolean NOT NULL DEFAULT false;
+CREATE INDEX idx_eth_receipts_revert_reason_pending ON eth_receipts (tx_hash) WHERE revert_reason_pending;
+
+-- +goose Down
+DROP INDEX idx_eth_receipts_revert_reason_pending;
+ALTER TABLE eth_receipts DROP COLUMN revert_reason_pending;
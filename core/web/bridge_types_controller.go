@@ -49,6 +49,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest, orm bridges.ORM) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.CacheTTL != nil && bt.CacheTTL.Duration() < 0 {
+		fe.Add("CacheTTL must not be negative")
+	}
 	return fe.CoerceEmptyToNil()
 }
 
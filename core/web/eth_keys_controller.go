@@ -6,11 +6,14 @@ import (
 	"math/big"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -58,6 +61,7 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 			ekc.setEthBalance(c.Request.Context(), state),
 			ekc.setLinkBalance(state),
 			ekc.setKeyMaxGasPriceWei(state, key.Address.Address()),
+			ekc.setKeyBudget(state, key.Address.Address()),
 		)
 		if err != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err)
@@ -120,6 +124,7 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(state),
 		ekc.setKeyMaxGasPriceWei(state, key.Address.Address()),
+		ekc.setKeyBudget(state, key.Address.Address()),
 	)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -132,17 +137,53 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 // Update an ETH key's parameters
 // Example:
 // "PUT <application>/keys/eth/:keyID?maxGasPriceGWei=12345"
+// "PUT <application>/keys/eth/:keyID?budgetWindow=24h&budgetMaxSpendWei=1000000000000000000&budgetMaxTxs=1000"
 func (ekc *ETHKeysController) Update(c *gin.Context) {
 	ethKeyStore := ekc.App.GetKeyStore().Eth()
 
-	if c.Query("maxGasPriceGWei") == "" {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("no parameters passed to update"))
-		return
+	var maxGasPriceWei *big.Int
+	var budget evm.KeyBudgetUpdate
+	if c.Query("maxGasPriceGWei") != "" {
+		maxGasPriceGWei, err := strconv.ParseInt(c.Query("maxGasPriceGWei"), 10, 64)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		maxGasPriceWei = assets.GWei(maxGasPriceGWei)
+	}
+	if c.Query("budgetWindow") != "" {
+		window, err := time.ParseDuration(c.Query("budgetWindow"))
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		if window <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("budgetWindow must be positive"))
+			return
+		}
+		budget.Window = &window
 	}
+	if c.Query("budgetMaxSpendWei") != "" {
+		maxSpendWei, ok := new(big.Int).SetString(c.Query("budgetMaxSpendWei"), 10)
+		if !ok || maxSpendWei.Sign() < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid budgetMaxSpendWei: %s", c.Query("budgetMaxSpendWei")))
+			return
+		}
+		budget.MaxSpendWei = maxSpendWei
+	}
+	if c.Query("budgetMaxTxs") != "" {
+		maxTxs, err := strconv.ParseUint(c.Query("budgetMaxTxs"), 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		maxTxs32 := uint32(maxTxs)
+		budget.MaxTxs = &maxTxs32
+	}
+	updateBudget := budget.Window != nil || budget.MaxSpendWei != nil || budget.MaxTxs != nil
 
-	maxGasPriceGWei, err := strconv.ParseInt(c.Query("maxGasPriceGWei"), 10, 64)
-	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	if maxGasPriceWei == nil && !updateBudget {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("no parameters passed to update"))
 		return
 	}
 
@@ -159,17 +200,26 @@ func (ekc *ETHKeysController) Update(c *gin.Context) {
 		return
 	}
 
-	maxGasPriceWei := assets.GWei(maxGasPriceGWei)
-	updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address.Address(), maxGasPriceWei)
-	if err = ekc.App.GetChainSet().UpdateConfig((*big.Int)(&state.EVMChainID), updateMaxGasPrice); err != nil {
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+	if maxGasPriceWei != nil {
+		updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address.Address(), maxGasPriceWei)
+		if err = ekc.App.GetChainSet().UpdateConfig((*big.Int)(&state.EVMChainID), updateMaxGasPrice); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	if updateBudget {
+		updateKeyBudget := evm.UpdateKeySpecificBudget(key.Address.Address(), budget)
+		if err = ekc.App.GetChainSet().UpdateConfig((*big.Int)(&state.EVMChainID), updateKeyBudget); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
 	}
 
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
 		ekc.setLinkBalance(state),
 		ekc.setKeyMaxGasPriceWei(state, key.Address.Address()),
+		ekc.setKeyBudget(state, key.Address.Address()),
 	)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -365,3 +415,41 @@ func (ekc *ETHKeysController) setKeyMaxGasPriceWei(state ethkey.State, keyAddres
 		return nil
 	}
 }
+
+// setKeyBudget is a custom functional option for NewEthKeyResource which gets
+// the spending budget of the key and its usage from the transaction manager
+// and sets it on the resource, if the key has a budget. Nothing is set if
+// Ethereum is disabled, as there is no transaction manager.
+func (ekc *ETHKeysController) setKeyBudget(state ethkey.State, keyAddress common.Address) presenters.NewETHKeyOption {
+	var usage bulletprooftxmanager.KeyBudgetUsage
+	chain, err := ekc.App.GetChainSet().Get(state.EVMChainID.ToInt())
+	ethereumDisabled := err == nil && chain.Config().EthereumDisabled()
+	if err == nil && !ethereumDisabled {
+		usage, err = chain.TxManager().GetKeyBudgetUsage(keyAddress)
+	}
+
+	return func(r *presenters.ETHKeyResource) error {
+		if errors.Cause(err) == evm.ErrNoChains || ethereumDisabled {
+			return nil
+		}
+		if err != nil {
+			return errors.Errorf("error getting key budget: %v", err)
+		}
+		if !usage.Enabled() && usage.TrippedAt == nil {
+			return nil
+		}
+
+		r.Budget = &presenters.ETHKeyBudget{
+			Window:    models.MustMakeDuration(usage.Window),
+			MaxTxs:    usage.MaxTxs,
+			SpentWei:  *utils.NewBig(usage.SpentWei),
+			Txs:       usage.Txs,
+			TrippedAt: usage.TrippedAt,
+		}
+		if usage.MaxSpendWei != nil {
+			r.Budget.MaxSpendWei = utils.NewBig(usage.MaxSpendWei)
+		}
+
+		return nil
+	}
+}
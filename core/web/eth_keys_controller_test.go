@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -106,6 +107,27 @@ func TestETHKeysController_Index_NotDev(t *testing.T) {
 	assert.Equal(t, "256", only.LinkBalance.String())
 }
 
+func TestETHKeysController_Index_EthereumDisabled(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.EthereumDisabled = null.BoolFrom(true)
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg)
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/keys/eth")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var keys []webpresenters.ETHKeyResource
+	err := cltest.ParseJSONAPIResponse(t, resp, &keys)
+	require.NoError(t, err)
+
+	require.Len(t, keys, 1)
+	assert.Nil(t, keys[0].Budget)
+}
+
 func TestETHKeysController_Index_NoAccounts(t *testing.T) {
 	t.Parallel()
 
@@ -193,4 +215,21 @@ func TestETHKeysController_UpdateSuccess(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, assets.GWei(777), chain.Config().KeySpecificMaxGasPriceWei(key.Address.Address()))
+
+	resp, cleanup = client.Put("/v2/keys/eth/"+key.Address.Hex()+"?budgetWindow=24h&budgetMaxSpendWei=1000&budgetMaxTxs=10", nil)
+	defer cleanup()
+
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var r webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &r))
+	require.NotNil(t, r.Budget)
+	assert.Equal(t, 24*time.Hour, r.Budget.Window.Duration())
+	assert.Equal(t, "1000", r.Budget.MaxSpendWei.String())
+	assert.Equal(t, uint32(10), r.Budget.MaxTxs)
+	assert.Equal(t, uint32(0), r.Budget.Txs)
+
+	assert.Equal(t, big.NewInt(1000), chain.Config().KeySpecificBudgetMaxSpendWei(key.Address.Address()))
+	assert.Equal(t, uint32(10), chain.Config().KeySpecificBudgetMaxTxs(key.Address.Address()))
+	assert.Equal(t, assets.GWei(777), chain.Config().KeySpecificMaxGasPriceWei(key.Address.Address()))
 }
@@ -460,6 +460,60 @@ func runDirectRequestJobSpecAssertions(t *testing.T, ereJobSpecFromFile job.Job,
 	assert.Contains(t, ereJobSpecFromServer.DirectRequestSpec.UpdatedAt.String(), "20")
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	tomlStr := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    parse    [type=jsonparse path="price" data="$(jobRun.requestBody)"];
+    multiply [type=multiply input="$(parse)" times="10"];
+    submit   [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(multiply)"];
+
+    parse -> multiply -> submit;
+"""
+`
+	body, err := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlStr,
+		Vars: map[string]interface{}{
+			"jobRun": map[string]interface{}{"requestBody": `{"price": 3}`},
+		},
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobSimulationResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	require.Len(t, resource.TaskRuns, 3)
+	assert.Equal(t, "multiply", resource.TaskRuns[1].DotID)
+	require.NotNil(t, resource.TaskRuns[1].Output)
+	assert.Equal(t, `"30"`, *resource.TaskRuns[1].Output)
+	assert.False(t, resource.TaskRuns[1].Stubbed)
+	assert.Equal(t, "submit", resource.TaskRuns[2].DotID)
+	assert.True(t, resource.TaskRuns[2].Stubbed)
+	assert.Nil(t, resource.TaskRuns[2].Error)
+	assert.Equal(t, []*string{nil}, resource.FatalErrors)
+
+	// neither the job nor the run are saved
+	var count int
+	require.NoError(t, app.GetSqlxDB().Get(&count, `SELECT count(*) FROM pipeline_runs`))
+	assert.Zero(t, count)
+	require.NoError(t, app.GetSqlxDB().Get(&count, `SELECT count(*) FROM jobs`))
+	assert.Zero(t, count)
+
+	body, err = json.Marshal(web.SimulateJobRequest{TOML: "not toml"})
+	require.NoError(t, err)
+	response, cleanup = client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
 func setupBridges(t *testing.T, db *sqlx.DB) (b1, b2 string) {
 	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{})
 	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{})
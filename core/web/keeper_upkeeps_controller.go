@@ -0,0 +1,78 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeeperUpkeepsController diagnoses the upkeeps of keeper jobs
+type KeeperUpkeepsController struct {
+	App chainlink.Application
+}
+
+// Eligibility explains which keeper is responsible for the upkeep at the
+// block, or at the latest block if blockNumber is not given, and whether the
+// node would perform it
+// Example:
+//  "GET <application>/jobs/:ID/upkeeps/:upkeepID/eligibility?blockNumber=:blockNumber"
+func (kuc *KeeperUpkeepsController) Eligibility(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	upkeepID, err := strconv.ParseInt(c.Param("upkeepID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid upkeep ID"))
+		return
+	}
+	var blockNumber *int64
+	if c.Query("blockNumber") != "" {
+		n, err := strconv.ParseInt(c.Query("blockNumber"), 10, 64)
+		if err != nil || n < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid block number: %s", c.Query("blockNumber")))
+			return
+		}
+		blockNumber = &n
+	}
+
+	jb, err = kuc.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+	if errors.Cause(err) == sql.ErrNoRows {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if jb.KeeperSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a keeper job", jb.ID))
+		return
+	}
+	chain, err := kuc.App.GetChainSet().Get(jb.KeeperSpec.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := keeper.NewORM(kuc.App.GetDB(), nil, chain.Config(), nil)
+	eligibility, err := keeper.ExplainUpkeepEligibility(c.Request.Context(), orm, chain.Client(), chain.Config(), jb.ID, upkeepID, blockNumber)
+	if errors.Cause(err) == gorm.ErrRecordNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("upkeep not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepEligibilityResource(eligibility), "upkeepEligibility")
+}
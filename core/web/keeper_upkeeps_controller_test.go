@@ -0,0 +1,37 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+)
+
+func TestKeeperUpkeepsController_Eligibility(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"invalid job ID", "/v2/jobs/foo/upkeeps/1/eligibility", http.StatusUnprocessableEntity},
+		{"invalid upkeep ID", "/v2/jobs/1/upkeeps/foo/eligibility", http.StatusUnprocessableEntity},
+		{"invalid block number", "/v2/jobs/1/upkeeps/1/eligibility?blockNumber=-1", http.StatusUnprocessableEntity},
+		{"unknown job", "/v2/jobs/999999/upkeeps/1/eligibility", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, cleanup := client.Get(tt.path)
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, tt.status)
+		})
+	}
+}
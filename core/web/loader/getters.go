@@ -43,3 +43,21 @@ func GetNodesByChainID(ctx context.Context, id string) ([]types.Node, error) {
 
 	return nodes, nil
 }
+
+// GetNodeStatesByChainID fetches the state of each node of a chain by name.
+func GetNodeStatesByChainID(ctx context.Context, id string) (map[string]string, error) {
+	ldr := For(ctx)
+
+	thunk := ldr.NodeStatesByChainIDLoader.Load(ctx, dataloader.StringKey(id))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	states, ok := result.(map[string]string)
+	if !ok {
+		return nil, errors.New("invalid type")
+	}
+
+	return states, nil
+}
@@ -14,8 +14,9 @@ type loadersKey struct{}
 type Dataloader struct {
 	app chainlink.Application
 
-	NodesByChainIDLoader *dataloader.Loader
-	ChainsByIDLoader     *dataloader.Loader
+	NodesByChainIDLoader      *dataloader.Loader
+	NodeStatesByChainIDLoader *dataloader.Loader
+	ChainsByIDLoader          *dataloader.Loader
 }
 
 func New(app chainlink.Application) *Dataloader {
@@ -25,8 +26,9 @@ func New(app chainlink.Application) *Dataloader {
 	return &Dataloader{
 		app: app,
 
-		NodesByChainIDLoader: dataloader.NewBatchedLoader(nodes.loadByChainIDs),
-		ChainsByIDLoader:     dataloader.NewBatchedLoader(chains.loadByIDs),
+		NodesByChainIDLoader:      dataloader.NewBatchedLoader(nodes.loadByChainIDs),
+		NodeStatesByChainIDLoader: dataloader.NewBatchedLoader(nodes.loadStatesByChainIDs),
+		ChainsByIDLoader:          dataloader.NewBatchedLoader(chains.loadByIDs),
 	}
 }
 
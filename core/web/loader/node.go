@@ -57,3 +57,23 @@ func (b *nodeBatcher) loadByChainIDs(ctx context.Context, keys dataloader.Keys)
 
 	return results
 }
+
+// loadStatesByChainIDs loads the state of each node of the chains by name.
+// Chains which are not running have no node states.
+func (b *nodeBatcher) loadStatesByChainIDs(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	results := make([]*dataloader.Result, len(keys))
+	for ix, key := range keys {
+		states := map[string]string{}
+		id := utils.Big{}
+		if err := id.UnmarshalText([]byte(key.String())); err == nil {
+			if chain, err := b.app.GetChainSet().Get(id.ToInt()); err == nil {
+				for name, state := range chain.Client().NodeStates() {
+					states[name] = state
+				}
+			}
+		}
+		results[ix] = &dataloader.Result{Data: states, Error: nil}
+	}
+
+	return results
+}
@@ -39,7 +39,7 @@ func (nc *NodesController) Index(c *gin.Context, size, page, offset int) {
 
 	var resources []presenters.NodeResource
 	for _, node := range nodes {
-		resources = append(resources, presenters.NewNodeResource(node))
+		resources = append(resources, presenters.NewNodeResource(node, nc.nodeState(node)))
 	}
 
 	paginatedResponse(c, "node", size, page, resources, count, err)
@@ -60,7 +60,7 @@ func (nc *NodesController) Create(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewNodeResource(node), "node")
+	jsonAPIResponse(c, presenters.NewNodeResource(node, nc.nodeState(node)), "node")
 }
 
 func (nc *NodesController) Delete(c *gin.Context) {
@@ -79,3 +79,13 @@ func (nc *NodesController) Delete(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, nil, "node", http.StatusNoContent)
 }
+
+// nodeState returns the state of the node in its chain's pool, which is empty
+// if the chain is not running
+func (nc *NodesController) nodeState(node types.Node) string {
+	chain, err := nc.App.GetChainSet().Get(node.EVMChainID.ToInt())
+	if err != nil {
+		return ""
+	}
+	return chain.Client().NodeStates()[node.Name]
+}
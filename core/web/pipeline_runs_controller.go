@@ -2,12 +2,16 @@ package web
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
@@ -152,6 +156,259 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// UpdatePipelineRunRequest is the body of a PATCH to a pipeline run. The only
+// supported change is setting the state to "cancelled".
+type UpdatePipelineRunRequest struct {
+	State pipeline.RunStatus `json:"state"`
+}
+
+// Update changes the state of a pipeline run, which can only be cancelled.
+// Example:
+// "PATCH <application>/jobs/:ID/runs/:runID"
+func (prc *PipelineRunsController) Update(c *gin.Context) {
+	var request UpdatePipelineRunRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.State != pipeline.RunStatusCancelled {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("state can only be changed to %q", pipeline.RunStatusCancelled))
+		return
+	}
+	prc.Cancel(c)
+}
+
+// Cancel cancels a running or suspended pipeline run.
+// Example:
+// "DELETE <application>/jobs/:ID/runs/:runID"
+func (prc *PipelineRunsController) Cancel(c *gin.Context) {
+	pipelineRun, found := prc.findJobRun(c)
+	if !found {
+		return
+	}
+
+	err := prc.App.CancelJobRunV2(c.Request.Context(), pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if errors.Is(err, pipeline.ErrRunNotCancellable) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(pipelineRun.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
+// findJobRun returns the pipeline run of the path, if it is a run of the job of
+// the path. Otherwise it responds with an error and found is false.
+func (prc *PipelineRunsController) findJobRun(c *gin.Context) (pipelineRun pipeline.Run, found bool) {
+	jobSpec := job.Job{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return pipelineRun, false
+	}
+	if err := pipelineRun.SetID(c.Param("runID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return pipelineRun, false
+	}
+
+	jobSpec, err := prc.App.JobORM().FindJob(c.Request.Context(), jobSpec.ID)
+	if errors.Cause(err) == sql.ErrNoRows {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return pipelineRun, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return pipelineRun, false
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && pipelineRun.PipelineSpecID != jobSpec.PipelineSpecID) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return pipelineRun, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return pipelineRun, false
+	}
+	return pipelineRun, true
+}
+
+// CancelSuspended cancels all suspended pipeline runs of a job, which must be
+// requested with the state query parameter.
+// Example:
+// "DELETE <application>/jobs/:ID/runs?state=suspended"
+func (prc *PipelineRunsController) CancelSuspended(c *gin.Context) {
+	if c.Query("state") != string(pipeline.RunStatusSuspended) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("only %q runs can be cancelled in bulk, set the state query parameter", pipeline.RunStatusSuspended))
+		return
+	}
+
+	jobSpec := job.Job{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	runIDs, err := prc.App.CancelSuspendedJobRunsV2(c.Request.Context(), jobSpec.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pipelineRuns := []pipeline.Run{}
+	for _, id := range runIDs {
+		pipelineRun, err := prc.App.PipelineORM().FindRun(id)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		pipelineRuns = append(pipelineRuns, pipelineRun)
+	}
+
+	res := presenters.NewPipelineRunResources(pipelineRuns, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
+// RetryPipelineRunRequest is the body of a request to retry an errored
+// pipeline run. DotID is the task to retry from, if it is empty the run is
+// retried from the tasks which errored.
+type RetryPipelineRunRequest struct {
+	DotID string `json:"dotId"`
+}
+
+// Retry starts a new run which retries an errored pipeline run, keeping the
+// results of the tasks which are not retried.
+// Example:
+// "POST <application>/jobs/:ID/runs/:runID/retry"
+func (prc *PipelineRunsController) Retry(c *gin.Context) {
+	pipelineRun, found := prc.findJobRun(c)
+	if !found {
+		return
+	}
+
+	// the body is optional
+	var request RetryPipelineRunRequest
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	retryRunID, err := prc.App.RetryJobRunV2(c.Request.Context(), pipelineRun.ID, request.DotID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if errors.Is(err, pipeline.ErrRunNotRetryable) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if errors.Is(err, pipeline.ErrRetryTaskNotFound) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(retryRunID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
+const (
+	// runEventsWriteWait is the time allowed to write an event to the peer
+	runEventsWriteWait = 10 * time.Second
+	// runEventsPingPeriod is how often the peer is pinged to keep the
+	// connection alive while there are no events
+	runEventsPingPeriod = 30 * time.Second
+)
+
+var runEventsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// origins are already checked by the CORS middleware
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// Events streams the lifecycle events of the pipeline runs of a job, or of all
+// jobs, over a WebSocket as JSON messages. The stream is live only: runs which
+// happened before subscribing are not replayed.
+// Example:
+// "GET <application>/jobs/:ID/runs/events"
+func (prc *PipelineRunsController) Events(c *gin.Context) {
+	var jobID *int32
+	if id := c.Param("ID"); id != "" {
+		jobSpec := job.Job{}
+		if err := jobSpec.SetID(id); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jobID = &jobSpec.ID
+	}
+
+	conn, err := runEventsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		prc.App.GetLogger().Debugw("Failed to upgrade pipeline run events connection", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	sub := prc.App.SubscribeToJobRunEventsV2(jobID)
+	defer sub.Close()
+
+	// the peer is not expected to send anything, reading only processes
+	// control messages and notices when the connection is closed
+	chClosed := make(chan struct{})
+	go func() {
+		defer close(chClosed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(runEventsPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-chClosed:
+			return
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if err := conn.SetWriteDeadline(time.Now().Add(runEventsWriteWait)); err != nil {
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(runEventsWriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/pelletier/go-toml"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +21,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/web"
@@ -246,6 +249,245 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Cancel(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+	runPath := fmt.Sprintf("/v2/jobs/%v/runs/%v", jobID, runIDs[0])
+
+	t.Run("finished runs cannot be cancelled", func(t *testing.T) {
+		response, cleanup := client.Delete(runPath)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusConflict)
+	})
+
+	t.Run("the state can only be changed to cancelled", func(t *testing.T) {
+		response, cleanup := client.Patch(runPath, strings.NewReader(`{"state": "completed"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+		response, cleanup = client.Patch(runPath, strings.NewReader(`{"state": "cancelled"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusConflict)
+	})
+
+	t.Run("run not found", func(t *testing.T) {
+		response, cleanup := client.Delete(fmt.Sprintf("/v2/jobs/%v/runs/%v", jobID, runIDs[1]+1000))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("invalid run ID", func(t *testing.T) {
+		response, cleanup := client.Delete(fmt.Sprintf("/v2/jobs/%v/runs/invalid-run-ID", jobID))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("run of another job", func(t *testing.T) {
+		otherJobID := createWebhookJob(t, client)
+
+		response, cleanup := client.Delete(fmt.Sprintf("/v2/jobs/%v/runs/%v", otherJobID, runIDs[0]))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+		response, cleanup = client.Patch(fmt.Sprintf("/v2/jobs/%v/runs/%v", otherJobID, runIDs[0]), strings.NewReader(`{"state": "cancelled"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
+// createWebhookJob creates a webhook job which always succeeds and returns its ID
+func createWebhookJob(t *testing.T, client cltest.HTTPClientCleaner) int32 {
+	t.Helper()
+
+	body, err := json.Marshal(web.CreateJobRequest{TOML: `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    memo [type=memo value="1"];
+"""
+`})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var jobResource presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &jobResource))
+
+	jobID, err := strconv.Atoi(jobResource.ID)
+	require.NoError(t, err)
+	return int32(jobID)
+}
+
+func TestPipelineRunsController_CancelSuspended(t *testing.T) {
+	client, jobID, _ := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Delete(fmt.Sprintf("/v2/jobs/%v/runs", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Delete(fmt.Sprintf("/v2/jobs/%v/runs?state=suspended", jobID+1000))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Delete(fmt.Sprintf("/v2/jobs/%v/runs?state=suspended", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse []presenters.PipelineRunResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+	require.NoError(t, err)
+	assert.Empty(t, parsedResponse)
+}
+
+func TestPipelineRunsController_Retry(t *testing.T) {
+	t.Parallel()
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body, err := json.Marshal(web.CreateJobRequest{TOML: `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    memo [type=memo value="1"];
+    fail [type=fail msg="uh oh"];
+    memo -> fail;
+"""
+`})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var jobResource presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &jobResource))
+
+	jobID, err := strconv.Atoi(jobResource.ID)
+	require.NoError(t, err)
+	runID, err := app.RunJobV2(context.Background(), int32(jobID), nil)
+	require.NoError(t, err)
+	retryPath := fmt.Sprintf("/v2/jobs/%v/runs/%v/retry", jobID, runID)
+
+	t.Run("retry from a task", func(t *testing.T) {
+		response, cleanup := client.Post(retryPath, strings.NewReader(`{"dotId": "fail"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var run presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &run))
+		assert.NotEqual(t, strconv.FormatInt(runID, 10), run.ID)
+		assert.Equal(t, null.IntFrom(runID), run.RetryOfRunID)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		require.Len(t, run.TaskRuns, 2)
+	})
+
+	t.Run("retry from the errored tasks", func(t *testing.T) {
+		response, cleanup := client.Post(retryPath, nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		response, cleanup := client.Post(retryPath, strings.NewReader(`{"dotId": "nope"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("run not found", func(t *testing.T) {
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/retry", jobID, runID+1000), nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("run of another job", func(t *testing.T) {
+		otherJobID := createWebhookJob(t, client)
+
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/retry", otherJobID, runID), nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
+func TestPipelineRunsController_Retry_NotErrored(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/retry", jobID, runIDs[0]), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+}
+
+func TestPipelineRunsController_Events(t *testing.T) {
+	t.Parallel()
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body, err := json.Marshal(web.CreateJobRequest{TOML: `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    memo [type=memo value="1"];
+"""
+`})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var jobResource presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &jobResource))
+	jobID, err := strconv.Atoi(jobResource.ID)
+	require.NoError(t, err)
+
+	header := http.Header{}
+	header.Add("Cookie", cltest.MustGenerateSessionCookie(t, app.MustSeedNewSession()).String())
+	wsURL := "ws" + strings.TrimPrefix(app.Server.URL, "http")
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL+"/v2/pipeline/runs/events", nil)
+		require.Error(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("invalid job ID", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL+"/v2/jobs/nope/runs/events", header)
+		require.Error(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("events of a job", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s/v2/jobs/%d/runs/events", wsURL, jobID), header)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		runID, err := app.RunJobV2(context.Background(), int32(jobID), nil)
+		require.NoError(t, err)
+
+		var types []pipeline.RunEventType
+		for {
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(cltest.DBWaitTimeout)))
+			var event pipeline.RunEvent
+			require.NoError(t, conn.ReadJSON(&event))
+			assert.Equal(t, int32(jobID), event.JobID)
+			types = append(types, event.Type)
+			if event.Type == pipeline.RunEventRunCreated {
+				assert.Equal(t, runID, event.RunID)
+			}
+			if event.Type == pipeline.RunEventRunFinished {
+				assert.Equal(t, runID, event.RunID)
+				assert.Equal(t, pipeline.RunStatusCompleted, event.State)
+				break
+			}
+		}
+		// the run is only created once it is saved, after its tasks ran
+		assert.Equal(t, []pipeline.RunEventType{
+			pipeline.RunEventTaskStarted,
+			pipeline.RunEventTaskFinished,
+			pipeline.RunEventRunCreated,
+			pipeline.RunEventRunFinished,
+		}, types)
+	})
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
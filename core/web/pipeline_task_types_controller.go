@@ -0,0 +1,23 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// PipelineTaskTypesController lists the task types usable in pipelines
+type PipelineTaskTypesController struct{}
+
+// Index lists the task types usable in pipelines, the built-in ones as well as
+// those registered by the packages the node is built with
+// Example:
+// "GET <application>/pipeline/task_types"
+func (ptc *PipelineTaskTypesController) Index(c *gin.Context) {
+	resources := []presenters.PipelineTaskTypeResource{}
+	for _, taskType := range pipeline.RegisteredTaskTypes() {
+		resources = append(resources, *presenters.NewPipelineTaskTypeResource(taskType, pipeline.IsCustomTaskType(taskType)))
+	}
+
+	jsonAPIResponse(c, resources, "taskTypes")
+}
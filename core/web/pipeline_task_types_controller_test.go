@@ -0,0 +1,39 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PipelineTaskTypesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/pipeline/task_types")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resources := []presenters.PipelineTaskTypeResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources)
+	require.NoError(t, err)
+	require.Len(t, resources, len(pipeline.RegisteredTaskTypes()))
+
+	var found bool
+	for _, resource := range resources {
+		if resource.ID == string(pipeline.TaskTypeHTTP) {
+			found = true
+			assert.False(t, resource.Custom)
+		}
+	}
+	assert.True(t, found)
+}
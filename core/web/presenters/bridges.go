@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -14,10 +15,13 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken          string          `json:"incomingToken,omitempty"`
+	OutgoingToken          string          `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link    `json:"minimumContractPayment"`
+	CacheTTL               models.Interval `json:"cacheTTL"`
+	CacheHits              int64           `json:"cacheHits"`
+	CacheLastHitAt         *time.Time      `json:"cacheLastHitAt"`
+	CreatedAt              time.Time       `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,6 +39,9 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		CacheTTL:               b.CacheTTL,
+		CacheHits:              b.CacheHits,
+		CacheLastHitAt:         b.CacheLastHitAt.Ptr(),
 		CreatedAt:              b.CreatedAt,
 	}
 }
@@ -26,6 +26,8 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
+		CacheTTL:               models.Interval(time.Minute),
+		CacheHits:              2,
 		CreatedAt:              timestamp,
 	}
 
@@ -45,6 +47,9 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheTTL":"1m0s",
+			"cacheHits":2,
+			"cacheLastHitAt":null,
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -70,6 +75,9 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheTTL":"1m0s",
+			"cacheHits":2,
+			"cacheLastHitAt":null,
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -3,7 +3,10 @@ package presenters
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gopkg.in/guregu/null.v4"
 )
@@ -37,6 +40,7 @@ type NodeResource struct {
 	EVMChainID utils.Big   `json:"evmChainID"`
 	WSURL      null.String `json:"wsURL"`
 	HTTPURL    null.String `json:"httpURL"`
+	State      string      `json:"state"`
 	CreatedAt  time.Time   `json:"createdAt"`
 	UpdatedAt  time.Time   `json:"updatedAt"`
 }
@@ -46,14 +50,53 @@ func (r NodeResource) GetName() string {
 	return "node"
 }
 
-func NewNodeResource(node types.Node) NodeResource {
+// NewNodeResource returns a NodeResource with the node's state, which is empty
+// if the node's chain is not running
+func NewNodeResource(node types.Node, state string) NodeResource {
 	return NodeResource{
 		JAID:       NewJAIDInt32(node.ID),
 		Name:       node.Name,
 		EVMChainID: node.EVMChainID,
 		WSURL:      node.WSURL,
 		HTTPURL:    node.HTTPURL,
+		State:      state,
 		CreatedAt:  node.CreatedAt,
 		UpdatedAt:  node.UpdatedAt,
 	}
 }
+
+// ReorgResource is a re-org of an EVM chain
+type ReorgResource struct {
+	JAID
+	EVMChainID           utils.Big     `json:"evmChainID"`
+	Depth                int64         `json:"depth"`
+	OrphanedHashes       []common.Hash `json:"orphanedHashes"`
+	CommonAncestorHash   *common.Hash  `json:"commonAncestorHash"`
+	CommonAncestorNumber clnull.Int64  `json:"commonAncestorNumber"`
+	PreviousHeadHash     common.Hash   `json:"previousHeadHash"`
+	PreviousHeadNumber   int64         `json:"previousHeadNumber"`
+	NewHeadHash          common.Hash   `json:"newHeadHash"`
+	NewHeadNumber        int64         `json:"newHeadNumber"`
+	CreatedAt            time.Time     `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReorgResource) GetName() string {
+	return "reorg"
+}
+
+func NewReorgResource(reorg httypes.Reorg) ReorgResource {
+	return ReorgResource{
+		JAID:                 NewJAIDInt64(reorg.ID),
+		EVMChainID:           reorg.EVMChainID,
+		Depth:                reorg.Depth,
+		OrphanedHashes:       reorg.OrphanedHashes,
+		CommonAncestorHash:   reorg.CommonAncestorHash,
+		CommonAncestorNumber: reorg.CommonAncestorNumber,
+		PreviousHeadHash:     reorg.PreviousHeadHash,
+		PreviousHeadNumber:   reorg.PreviousHeadNumber,
+		NewHeadHash:          reorg.NewHeadHash,
+		NewHeadNumber:        reorg.NewHeadNumber,
+		CreatedAt:            reorg.CreatedAt,
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -12,14 +13,26 @@ import (
 // representation of the address plus its ETH & LINK balances
 type ETHKeyResource struct {
 	JAID
-	EVMChainID     utils.Big    `json:"evmChainID"`
-	Address        string       `json:"address"`
-	EthBalance     *assets.Eth  `json:"ethBalance"`
-	LinkBalance    *assets.Link `json:"linkBalance"`
-	IsFunding      bool         `json:"isFunding"`
-	CreatedAt      time.Time    `json:"createdAt"`
-	UpdatedAt      time.Time    `json:"updatedAt"`
-	MaxGasPriceWei utils.Big    `json:"maxGasPriceWei"`
+	EVMChainID     utils.Big     `json:"evmChainID"`
+	Address        string        `json:"address"`
+	EthBalance     *assets.Eth   `json:"ethBalance"`
+	LinkBalance    *assets.Link  `json:"linkBalance"`
+	IsFunding      bool          `json:"isFunding"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	UpdatedAt      time.Time     `json:"updatedAt"`
+	MaxGasPriceWei utils.Big     `json:"maxGasPriceWei"`
+	Budget         *ETHKeyBudget `json:"budget,omitempty"`
+}
+
+// ETHKeyBudget is the spending budget of a key, and its usage over the
+// current window
+type ETHKeyBudget struct {
+	Window      models.Duration `json:"window"`
+	MaxSpendWei *utils.Big      `json:"maxSpendWei"`
+	MaxTxs      uint32          `json:"maxTxs"`
+	SpentWei    utils.Big       `json:"spentWei"`
+	Txs         uint32          `json:"txs"`
+	TrippedAt   *time.Time      `json:"trippedAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -83,3 +96,11 @@ func SetETHKeyMaxGasPriceWei(maxGasPriceWei utils.Big) NewETHKeyOption {
 		return nil
 	}
 }
+
+func SetETHKeyBudget(budget *ETHKeyBudget) NewETHKeyOption {
+	return func(r *ETHKeyResource) error {
+		r.Budget = budget
+
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -24,6 +25,13 @@ type EthTxResource struct {
 	To         *common.Address `json:"to"`
 	Value      string          `json:"value"`
 	EVMChainID utils.Big       `json:"evmChainID"`
+
+	NotBefore      *time.Time `json:"notBefore,omitempty"`
+	NotBeforeBlock string     `json:"notBeforeBlock,omitempty"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+	ExpiresAtBlock string     `json:"expiresAtBlock,omitempty"`
+
+	RevertReason string `json:"revertReason,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -37,7 +45,7 @@ func (EthTxResource) GetName() string {
 // EthTx as the id being used was the EthTxAttempt Hash.
 // This should really use it's proper id
 func NewEthTxResource(tx bulletprooftxmanager.EthTx) EthTxResource {
-	return EthTxResource{
+	r := EthTxResource{
 		Data:       hexutil.Bytes(tx.EncodedPayload),
 		From:       &tx.FromAddress,
 		GasLimit:   strconv.FormatUint(tx.GasLimit, 10),
@@ -45,7 +53,19 @@ func NewEthTxResource(tx bulletprooftxmanager.EthTx) EthTxResource {
 		To:         &tx.ToAddress,
 		Value:      tx.Value.String(),
 		EVMChainID: tx.EVMChainID,
+		NotBefore:  tx.NotBefore,
+		ExpiresAt:  tx.ExpiresAt,
+
+		RevertReason: tx.RevertReason.ValueOrZero(),
+	}
+
+	if tx.NotBeforeBlock.Valid {
+		r.NotBeforeBlock = strconv.FormatInt(tx.NotBeforeBlock.Int64, 10)
+	}
+	if tx.ExpiresAtBlock.Valid {
+		r.ExpiresAtBlock = strconv.FormatInt(tx.ExpiresAtBlock.Int64, 10)
 	}
+	return r
 }
 
 func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxResource {
@@ -66,3 +86,21 @@ func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxRes
 	}
 	return r
 }
+
+// NewEthTxResourceFromEthTx returns the resource of the latest attempt of the
+// transaction, or of the transaction itself, identified by its ID, if it has
+// no attempts yet
+func NewEthTxResourceFromEthTx(tx bulletprooftxmanager.EthTx) EthTxResource {
+	if len(tx.EthTxAttempts) > 0 {
+		txa := tx.EthTxAttempts[0]
+		txa.EthTx = tx
+		return NewEthTxResourceFromAttempt(txa)
+	}
+
+	r := NewEthTxResource(tx)
+	r.JAID = NewJAIDInt64(tx.ID)
+	if tx.Nonce != nil {
+		r.Nonce = strconv.FormatUint(uint64(*tx.Nonce), 10)
+	}
+	return r
+}
@@ -2,11 +2,13 @@ package presenters
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
@@ -103,4 +105,78 @@ func TestEthTxResource(t *testing.T) {
 	`
 
 	assert.JSONEq(t, expected, string(b))
+
+	notBefore := time.Date(2021, 10, 18, 0, 0, 0, 0, time.UTC)
+	tx.Nonce = nil
+	tx.State = bulletprooftxmanager.EthTxUnstarted
+	tx.NotBefore = &notBefore
+	tx.ExpiresAtBlock = null.Int64From(200)
+
+	r = NewEthTxResource(tx)
+
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+	{
+		"data": {
+		  "type": "transactions",
+		  "id": "",
+		  "attributes": {
+			"state": "unstarted",
+			"data": "0x7b2264617461223a202269732077696c64696e67206f7574227d",
+			"from": "0x0000000000000000000000000000000000000001",
+			"gasLimit": "5000",
+			"gasPrice": "",
+			"hash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+			"rawHex": "",
+			"nonce": "",
+			"sentAt": "",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0.000000000000000001",
+			"evmChainID": "0",
+			"notBefore": "2021-10-18T00:00:00Z",
+			"expiresAtBlock": "200"
+		  }
+		}
+	  }
+	`
+
+	assert.JSONEq(t, expected, string(b))
+
+	tx.State = bulletprooftxmanager.EthTxConfirmed
+	tx.NotBefore = nil
+	tx.ExpiresAtBlock = null.Int64{}
+	tx.RevertReason.SetValid("panic: arithmetic underflow or overflow (0x11)")
+
+	r = NewEthTxResource(tx)
+
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+	{
+		"data": {
+		  "type": "transactions",
+		  "id": "",
+		  "attributes": {
+			"state": "confirmed",
+			"data": "0x7b2264617461223a202269732077696c64696e67206f7574227d",
+			"from": "0x0000000000000000000000000000000000000001",
+			"gasLimit": "5000",
+			"gasPrice": "",
+			"hash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+			"rawHex": "",
+			"nonce": "",
+			"sentAt": "",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0.000000000000000001",
+			"evmChainID": "0",
+			"revertReason": "panic: arithmetic underflow or overflow (0x11)"
+		  }
+		}
+	  }
+	`
+
+	assert.JSONEq(t, expected, string(b))
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
@@ -39,6 +40,7 @@ type DirectRequestSpec struct {
 	ContractAddress             ethkey.EIP55Address      `json:"contractAddress"`
 	MinIncomingConfirmations    clnull.Uint32            `json:"minIncomingConfirmations"`
 	MinIncomingConfirmationsEnv bool                     `json:"minIncomingConfirmationsEnv,omitempty"`
+	ConfirmationTag             eth.BlockTag             `json:"confirmationTag,omitempty"`
 	MinContractPayment          *assets.Link             `json:"minContractPaymentLinkJuels"`
 	Requesters                  models.AddressCollection `json:"requesters"`
 	Initiator                   string                   `json:"initiator"`
@@ -54,6 +56,7 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 		ContractAddress:             spec.ContractAddress,
 		MinIncomingConfirmations:    spec.MinIncomingConfirmations,
 		MinIncomingConfirmationsEnv: spec.MinIncomingConfirmationsEnv,
+		ConfirmationTag:             spec.ConfirmationTag,
 		MinContractPayment:          spec.MinContractPayment,
 		Requesters:                  spec.Requesters,
 		// This is hardcoded to runlog. When we support other intiators, we need
@@ -78,6 +81,7 @@ type FluxMonitorSpec struct {
 	DrumbeatSchedule    *string             `json:"drumbeatSchedule"`
 	DrumbeatRandomDelay *string             `json:"drumbeatRandomDelay"`
 	MinPayment          *assets.Link        `json:"minPayment"`
+	ConfirmationTag     eth.BlockTag        `json:"confirmationTag,omitempty"`
 	CreatedAt           time.Time           `json:"createdAt"`
 	UpdatedAt           time.Time           `json:"updatedAt"`
 	EVMChainID          *utils.Big          `json:"evmChainID"`
@@ -107,6 +111,7 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		DrumbeatSchedule:    drumbeatSchedulePtr,
 		DrumbeatRandomDelay: drumbeatRandomDelayPtr,
 		MinPayment:          spec.MinPayment,
+		ConfirmationTag:     spec.ConfirmationTag,
 		CreatedAt:           spec.CreatedAt,
 		UpdatedAt:           spec.UpdatedAt,
 		EVMChainID:          spec.EVMChainID,
@@ -236,6 +241,7 @@ type VRFSpec struct {
 	FromAddress        *ethkey.EIP55Address `json:"fromAddress"`
 	PollPeriod         models.Duration      `json:"pollPeriod"`
 	Confirmations      uint32               `json:"confirmations"`
+	ConfirmationTag    eth.BlockTag         `json:"confirmationTag,omitempty"`
 	CreatedAt          time.Time            `json:"createdAt"`
 	UpdatedAt          time.Time            `json:"updatedAt"`
 	EVMChainID         *utils.Big           `json:"evmChainID"`
@@ -248,6 +254,7 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 		FromAddress:        spec.FromAddress,
 		PollPeriod:         models.MustMakeDuration(spec.PollPeriod),
 		Confirmations:      spec.Confirmations,
+		ConfirmationTag:    spec.ConfirmationTag,
 		CreatedAt:          spec.CreatedAt,
 		UpdatedAt:          spec.UpdatedAt,
 		EVMChainID:         spec.EVMChainID,
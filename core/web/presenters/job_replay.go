@@ -0,0 +1,61 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// JobReplayResource is a replay of the logs of a job, and its progress
+type JobReplayResource struct {
+	JAID
+	JobID          int32              `json:"jobID"`
+	EVMChainID     utils.Big          `json:"evmChainID"`
+	Contract       *common.Address    `json:"contract"`
+	FromBlock      int64              `json:"fromBlock"`
+	ToBlock        int64              `json:"toBlock"`
+	BlockNumber    clnull.Int64       `json:"blockNumber"`
+	LogsDelivered  int64              `json:"logsDelivered"`
+	IgnoreConsumed bool               `json:"ignoreConsumed"`
+	State          log.JobReplayState `json:"state"`
+	Error          string             `json:"error,omitempty"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	FinishedAt     *time.Time         `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobReplayResource) GetName() string {
+	return "jobReplays"
+}
+
+// NewJobReplayResource constructs a new JobReplayResource
+func NewJobReplayResource(replay log.JobReplay) *JobReplayResource {
+	return &JobReplayResource{
+		JAID:           NewJAIDInt64(replay.ID),
+		JobID:          replay.JobID,
+		EVMChainID:     replay.EVMChainID,
+		Contract:       replay.Contract,
+		FromBlock:      replay.FromBlock,
+		ToBlock:        replay.ToBlock,
+		BlockNumber:    replay.BlockNumber,
+		LogsDelivered:  replay.LogsDelivered,
+		IgnoreConsumed: replay.IgnoreConsumed,
+		State:          replay.State,
+		Error:          replay.Error,
+		CreatedAt:      replay.CreatedAt,
+		FinishedAt:     replay.FinishedAt,
+	}
+}
+
+// NewJobReplayResources constructs a slice of JobReplayResources
+func NewJobReplayResources(replays []log.JobReplay) []JobReplayResource {
+	rs := []JobReplayResource{}
+	for _, replay := range replays {
+		rs = append(rs, *NewJobReplayResource(replay))
+	}
+	return rs
+}
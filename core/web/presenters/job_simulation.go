@@ -0,0 +1,69 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// JobSimulationResource represents the results of simulating the pipeline of
+// a job. Simulations are not saved, so the resource always has the same ID.
+type JobSimulationResource struct {
+	JAID
+	Outputs     []*string                      `json:"outputs"`
+	AllErrors   []*string                      `json:"allErrors"`
+	FatalErrors []*string                      `json:"fatalErrors"`
+	TaskRuns    []JobSimulationTaskRunResource `json:"taskRuns"`
+	CreatedAt   time.Time                      `json:"createdAt"`
+	FinishedAt  time.Time                      `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSimulationResource) GetName() string {
+	return "simulations"
+}
+
+// JobSimulationTaskRunResource represents a task run of a simulation
+type JobSimulationTaskRunResource struct {
+	PipelineTaskRunResource
+	// Duration is the time the task took to execute
+	Duration models.Interval `json:"duration"`
+	// Stubbed is true when the task has side effects and was not executed
+	Stubbed bool `json:"stubbed"`
+}
+
+// NewJobSimulationResource constructs a new JobSimulationResource
+func NewJobSimulationResource(run pipeline.Run, trrs pipeline.TaskRunResults, lggr logger.Logger) *JobSimulationResource {
+	stubbed := make(map[string]bool)
+	for _, trr := range trrs {
+		if trr.Task != nil && pipeline.HasSideEffects(trr.Task) {
+			stubbed[trr.Task.DotID()] = true
+		}
+	}
+
+	pr := NewPipelineRunResource(run, lggr)
+	var trs []JobSimulationTaskRunResource
+	for _, tr := range run.PipelineTaskRuns {
+		var duration time.Duration
+		if tr.FinishedAt.Valid {
+			duration = tr.FinishedAt.Time.Sub(tr.CreatedAt)
+		}
+		trs = append(trs, JobSimulationTaskRunResource{
+			PipelineTaskRunResource: NewPipelineTaskRunResource(tr),
+			Duration:                models.Interval(duration),
+			Stubbed:                 stubbed[tr.GetDotID()],
+		})
+	}
+
+	return &JobSimulationResource{
+		JAID:        NewJAID("simulation"),
+		Outputs:     pr.Outputs,
+		AllErrors:   pr.AllErrors,
+		FatalErrors: pr.FatalErrors,
+		TaskRuns:    trs,
+		CreatedAt:   pr.CreatedAt,
+		FinishedAt:  pr.FinishedAt,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -25,6 +26,8 @@ type PipelineRunResource struct {
 	TaskRuns     []PipelineTaskRunResource `json:"taskRuns"`
 	CreatedAt    time.Time                 `json:"createdAt"`
 	FinishedAt   time.Time                 `json:"finishedAt"`
+	State        pipeline.RunStatus        `json:"state"`
+	RetryOfRunID null.Int                  `json:"retryOfRunId"`
 	PipelineSpec PipelineSpec              `json:"pipelineSpec"`
 }
 
@@ -102,6 +105,8 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 		TaskRuns:     trs,
 		CreatedAt:    pr.CreatedAt,
 		FinishedAt:   pr.FinishedAt.ValueOrZero(),
+		State:        pr.State,
+		RetryOfRunID: pr.RetryOfRunID,
 		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
 	}
 }
@@ -114,6 +119,7 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Skipped    bool              `json:"skipped"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -139,6 +145,7 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      error,
 		DotID:      tr.GetDotID(),
+		Skipped:    tr.Skipped,
 	}
 }
 
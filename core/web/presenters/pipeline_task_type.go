@@ -0,0 +1,24 @@
+package presenters
+
+import "github.com/smartcontractkit/chainlink/core/services/pipeline"
+
+// PipelineTaskTypeResource represents a pipeline task type JSONAPI resource.
+type PipelineTaskTypeResource struct {
+	JAID
+	// Custom is true for the task types registered by the packages the node
+	// is built with, rather than built into it
+	Custom bool `json:"custom"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineTaskTypeResource) GetName() string {
+	return "taskTypes"
+}
+
+// NewPipelineTaskTypeResource constructs a new PipelineTaskTypeResource.
+func NewPipelineTaskTypeResource(taskType pipeline.TaskType, custom bool) *PipelineTaskTypeResource {
+	return &PipelineTaskTypeResource{
+		JAID:   NewJAID(string(taskType)),
+		Custom: custom,
+	}
+}
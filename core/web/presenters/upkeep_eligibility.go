@@ -0,0 +1,75 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// UpkeepEligibilityResource explains which keeper is responsible for an
+// upkeep at a block, and what the node would do with it
+type UpkeepEligibilityResource struct {
+	JAID
+	JobID                  int32               `json:"jobID"`
+	UpkeepID               int64               `json:"upkeepID"`
+	RegistryAddress        ethkey.EIP55Address `json:"registryAddress"`
+	BlockNumber            int64               `json:"blockNumber"`
+	BlockCountPerTurn      int32               `json:"blockCountPerTurn"`
+	NumKeepers             int32               `json:"numKeepers"`
+	TurnStartBlock         int64               `json:"turnStartBlock"`
+	ResponsibleKeeperIndex int32               `json:"responsibleKeeperIndex"`
+	KeeperIndex            int32               `json:"keeperIndex"`
+	IsOurTurn              bool                `json:"isOurTurn"`
+	LastRunBlockHeight     int64               `json:"lastRunBlockHeight"`
+	GracePeriod            int64               `json:"gracePeriod"`
+	Eligible               bool                `json:"eligible"`
+	Reason                 string              `json:"reason"`
+	CheckSimulated         bool                `json:"checkSimulated"`
+	CheckSucceeded         bool                `json:"checkSucceeded"`
+	CheckError             string              `json:"checkError,omitempty"`
+	Action                 keeper.UpkeepAction `json:"action"`
+	LastCheckBlockNumber   null.Int            `json:"lastCheckBlockNumber"`
+	LastCheckOutcome       null.String         `json:"lastCheckOutcome"`
+	LastCheckError         null.String         `json:"lastCheckError"`
+	LastCheckRevertData    hexutil.Bytes       `json:"lastCheckRevertData,omitempty"`
+	LastCheckAt            null.Time           `json:"lastCheckAt"`
+	LastPerformEthTxID     null.Int            `json:"lastPerformEthTxID"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepEligibilityResource) GetName() string {
+	return "upkeepEligibility"
+}
+
+// NewUpkeepEligibilityResource constructs a new UpkeepEligibilityResource
+func NewUpkeepEligibilityResource(e keeper.UpkeepEligibility) *UpkeepEligibilityResource {
+	return &UpkeepEligibilityResource{
+		JAID:                   NewJAIDInt64(e.UpkeepID),
+		JobID:                  e.JobID,
+		UpkeepID:               e.UpkeepID,
+		RegistryAddress:        e.RegistryAddress,
+		BlockNumber:            e.BlockNumber,
+		BlockCountPerTurn:      e.BlockCountPerTurn,
+		NumKeepers:             e.NumKeepers,
+		TurnStartBlock:         e.TurnStartBlock,
+		ResponsibleKeeperIndex: e.ResponsibleKeeperIndex,
+		KeeperIndex:            e.KeeperIndex,
+		IsOurTurn:              e.IsOurTurn,
+		LastRunBlockHeight:     e.LastRunBlockHeight,
+		GracePeriod:            e.GracePeriod,
+		Eligible:               e.Eligible,
+		Reason:                 e.Reason,
+		CheckSimulated:         e.CheckSimulated,
+		CheckSucceeded:         e.CheckSucceeded,
+		CheckError:             e.CheckError,
+		Action:                 e.Action,
+		LastCheckBlockNumber:   e.LastCheck.LastCheckBlockNumber,
+		LastCheckOutcome:       e.LastCheck.LastCheckOutcome,
+		LastCheckError:         e.LastCheck.LastCheckError,
+		LastCheckRevertData:    e.LastCheck.LastCheckRevertData,
+		LastCheckAt:            e.LastCheck.LastCheckAt,
+		LastPerformEthTxID:     e.LastCheck.LastPerformEthTxID,
+	}
+}
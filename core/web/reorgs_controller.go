@@ -0,0 +1,38 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ReorgsController lists the re-orgs detected on a chain
+type ReorgsController struct {
+	App chainlink.Application
+}
+
+// Index lists the re-orgs of the chain, latest first
+// Example:
+//  "GET <application>/chains/evm/:ID/reorgs"
+func (rc *ReorgsController) Index(c *gin.Context, size, page, offset int) {
+	chainID := utils.Big{}
+	if err := chainID.UnmarshalText([]byte(c.Param("ID"))); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	orm := headtracker.NewORM(rc.App.GetDB(), *chainID.ToInt())
+	reorgs, count, err := orm.Reorgs(c.Request.Context(), offset, size)
+
+	var resources []presenters.ReorgResource
+	for _, reorg := range reorgs {
+		resources = append(resources, presenters.NewReorgResource(reorg))
+	}
+
+	paginatedResponse(c, "reorg", size, page, resources, count, err)
+}
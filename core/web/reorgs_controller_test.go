@@ -0,0 +1,71 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestReorgsController_Index_Success(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	orm := headtracker.NewORM(app.GetDB(), cltest.FixtureChainID)
+
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, orm.InsertReorg(context.TODO(), &httypes.Reorg{
+			Depth:              1,
+			OrphanedHashes:     []common.Hash{utils.NewHash()},
+			PreviousHeadHash:   utils.NewHash(),
+			PreviousHeadNumber: i * 10,
+			NewHeadHash:        utils.NewHash(),
+			NewHeadNumber:      i * 10,
+			CreatedAt:          time.Now(),
+		}, 10))
+	}
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/chains/evm/%s/reorgs?size=2", cltest.FixtureChainID.String()))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var reorgs []presenters.ReorgResource
+	body := cltest.ParseResponseBody(t, resp)
+
+	require.NoError(t, web.ParsePaginatedResponse(body, &reorgs, &links))
+	assert.NotEmpty(t, links["next"].Href)
+	assert.Empty(t, links["prev"].Href)
+	require.Len(t, reorgs, 2)
+	assert.Equal(t, int64(30), reorgs[0].NewHeadNumber, "expected re-orgs latest first")
+	assert.Equal(t, int64(20), reorgs[1].NewHeadNumber, "expected re-orgs latest first")
+	assert.Len(t, reorgs[0].OrphanedHashes, 1)
+}
+
+func TestReorgsController_Index_InvalidChainID(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/chains/evm/TrainingDay/reorgs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
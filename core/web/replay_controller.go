@@ -1,13 +1,17 @@
 package web
 
 import (
+	"math/big"
 	"net/http"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 type ReplayController struct {
@@ -17,6 +21,14 @@ type ReplayController struct {
 // ReplayFromBlock causes the node to process blocks again from the given block number
 // Example:
 //  "<application>/v2/replay_from_block/:number"
+//
+// With the jobID query parameter, the logs are replayed to the listeners of
+// that job only, optionally only to those of the contract query parameter.
+// With ignoreConsumed=true, the job processes the logs again even if it
+// consumed them already. The response is then the job replay, whose progress
+// is listed by Index.
+// Example:
+//  "<application>/v2/replay_from_block/:number?jobID=1&contract=0x...&ignoreConsumed=true"
 func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	if c.Param("number") == "" {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'number' parameter"))
@@ -46,6 +58,11 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	}
 	chainID := chain.ID()
 
+	if c.Query("jobID") != "" {
+		bdc.replayJobFromBlock(c, chainID, blockNumber)
+		return
+	}
+
 	if err := bdc.App.ReplayFromBlock(chainID, uint64(blockNumber)); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -58,6 +75,80 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	jsonAPIResponse(c, &response, "response")
 }
 
+func (bdc *ReplayController) replayJobFromBlock(c *gin.Context, chainID *big.Int, blockNumber int64) {
+	jobID, err := strconv.ParseInt(c.Query("jobID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'jobID' parameter"))
+		return
+	}
+	opts := log.JobReplayOpts{
+		JobID:     int32(jobID),
+		FromBlock: blockNumber,
+	}
+	if contract := c.Query("contract"); contract != "" {
+		if !common.IsHexAddress(contract) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid 'contract' parameter: %s", contract))
+			return
+		}
+		address := common.HexToAddress(contract)
+		opts.Contract = &address
+	}
+	if ignoreConsumed := c.Query("ignoreConsumed"); ignoreConsumed != "" {
+		opts.IgnoreConsumed, err = strconv.ParseBool(ignoreConsumed)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'ignoreConsumed' parameter"))
+			return
+		}
+	}
+
+	replay, err := bdc.App.ReplayJobFromBlock(chainID, opts)
+	if errors.Cause(err) == log.ErrNoJobListeners {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobReplayResource(replay), "jobReplays")
+}
+
+// Index lists the latest job replays on the chain with their progress,
+// latest first, only those of a job with the jobID query parameter
+// Example:
+//  "<application>/v2/replays?jobID=1"
+func (bdc *ReplayController) Index(c *gin.Context) {
+	chain, err := getChain(bdc.App.GetChainSet(), c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var jobID *int32
+	if c.Query("jobID") != "" {
+		id, err := strconv.ParseInt(c.Query("jobID"), 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'jobID' parameter"))
+			return
+		}
+		jobID = new(int32)
+		*jobID = int32(id)
+	}
+
+	replays, err := bdc.App.JobReplays(chain.ID(), jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewJobReplayResources(replays), "jobReplays")
+}
+
 type ReplayResponse struct {
 	Message    string     `json:"message"`
 	EVMChainID *utils.Big `json:"evmChainID"`
@@ -0,0 +1,63 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestReplayController_ReplayFromBlock_Job(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"invalid job ID", "?jobID=foo"},
+		{"invalid contract", "?jobID=1&contract=0xbeef"},
+		{"invalid ignoreConsumed", "?jobID=1&ignoreConsumed=maybe"},
+		{"job without log listeners", "?jobID=1"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/replay_from_block/10"+tt.query, bytes.NewBufferString("{}"))
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+		})
+	}
+}
+
+func TestReplayController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/replays")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var replays []presenters.JobReplayResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &replays))
+	assert.Empty(t, replays)
+
+	resp, cleanup = client.Get("/v2/replays?jobID=1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/replays?jobID=abc")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -49,6 +49,24 @@ func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
 }
 
+// CacheTTL resolves the bridge's cache TTL.
+func (r *BridgeResolver) CacheTTL() string {
+	return r.bridge.CacheTTL.Duration().String()
+}
+
+// CacheHits resolves the number of cached responses returned by the bridge.
+func (r *BridgeResolver) CacheHits() int32 {
+	return int32(r.bridge.CacheHits)
+}
+
+// CacheLastHitAt resolves the last time a cached response was returned.
+func (r *BridgeResolver) CacheLastHitAt() *graphql.Time {
+	if !r.bridge.CacheLastHitAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.bridge.CacheLastHitAt.Time}
+}
+
 // CreatedAt resolves the bridge's created at field.
 func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
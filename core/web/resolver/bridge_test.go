@@ -4,9 +4,11 @@ import (
 	"database/sql"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -90,6 +92,9 @@ func Test_Bridge(t *testing.T) {
 						confirmations
 						outgoingToken
 						minimumContractPayment
+						cacheTTL
+						cacheHits
+						cacheLastHitAt
 						createdAt
 					}
 					... on NotFoundError {
@@ -117,6 +122,9 @@ func Test_Bridge(t *testing.T) {
 					Confirmations:          uint32(1),
 					OutgoingToken:          "outgoingToken",
 					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					CacheTTL:               models.Interval(time.Minute),
+					CacheHits:              3,
+					CacheLastHitAt:         null.TimeFrom(f.Timestamp()),
 					CreatedAt:              f.Timestamp(),
 				}, nil)
 			},
@@ -128,6 +136,9 @@ func Test_Bridge(t *testing.T) {
 					"confirmations": 1,
 					"outgoingToken": "outgoingToken",
 					"minimumContractPayment": "1",
+					"cacheTTL": "1m0s",
+					"cacheHits": 3,
+					"cacheLastHitAt": "2021-01-01T00:00:00Z",
 					"createdAt": "2021-01-01T00:00:00Z"
 				}
 			}`,
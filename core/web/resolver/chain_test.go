@@ -3,7 +3,11 @@ package resolver
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -120,3 +124,67 @@ func Test_Chain(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func Test_ChainNodeStates(t *testing.T) {
+	var (
+		chainID = *utils.NewBigI(1)
+		query   = `
+			query GetChain {
+				chain(id: "1") {
+					nodes {
+						name
+						state
+					}
+				}
+			}
+		`
+	)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				chainSet := new(evmmocks.ChainSet)
+				chain := new(evmmocks.Chain)
+				client := new(ethmocks.Client)
+				f.t.Cleanup(func() {
+					mock.AssertExpectationsForObjects(f.t, chainSet, chain, client)
+				})
+
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
+				f.App.On("GetChainSet").Return(chainSet)
+				chainSet.On("Get", chainID.ToInt()).Return(chain, nil)
+				chain.On("Client").Return(client)
+				client.On("NodeStates").Return(map[string]string{
+					"primary-a": "Alive",
+					"primary-b": "OutOfSync",
+				})
+				f.Mocks.evmORM.On("Chain", chainID).Return(types.Chain{
+					ID:        chainID,
+					Enabled:   true,
+					CreatedAt: f.Timestamp(),
+				}, nil)
+				f.Mocks.evmORM.On("GetNodesByChainIDs", []utils.Big{chainID}).
+					Return([]types.Node{
+						{ID: 200, Name: "primary-a", EVMChainID: chainID},
+						{ID: 201, Name: "primary-b", EVMChainID: chainID},
+						{ID: 202, Name: "removed", EVMChainID: chainID},
+					}, nil)
+			},
+			query: query,
+			result: `
+				{
+					"chain": {
+						"nodes": [
+							{"name": "primary-a", "state": "Alive"},
+							{"name": "primary-b", "state": "OutOfSync"},
+							{"name": "removed", "state": ""}
+						]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
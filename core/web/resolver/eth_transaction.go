@@ -0,0 +1,221 @@
+package resolver
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+// EthTransactionResolver resolves the EthTransaction type.
+type EthTransactionResolver struct {
+	tx bulletprooftxmanager.EthTx
+}
+
+func NewEthTransaction(tx bulletprooftxmanager.EthTx) *EthTransactionResolver {
+	return &EthTransactionResolver{tx: tx}
+}
+
+// ID resolves the transaction's id.
+func (r *EthTransactionResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(r.tx.ID, 10))
+}
+
+// State resolves the transaction's state.
+func (r *EthTransactionResolver) State() string {
+	return string(r.tx.State)
+}
+
+// From resolves the transaction's from address.
+func (r *EthTransactionResolver) From() string {
+	return r.tx.FromAddress.Hex()
+}
+
+// To resolves the transaction's to address.
+func (r *EthTransactionResolver) To() string {
+	return r.tx.ToAddress.Hex()
+}
+
+// Data resolves the transaction's payload.
+func (r *EthTransactionResolver) Data() string {
+	return hexutil.Encode(r.tx.EncodedPayload)
+}
+
+// Value resolves the transaction's value.
+func (r *EthTransactionResolver) Value() string {
+	return r.tx.Value.String()
+}
+
+// GasLimit resolves the transaction's gas limit.
+func (r *EthTransactionResolver) GasLimit() string {
+	return strconv.FormatUint(r.tx.GasLimit, 10)
+}
+
+// Nonce resolves the transaction's nonce, which is only set once it is sent.
+func (r *EthTransactionResolver) Nonce() *string {
+	if r.tx.Nonce == nil {
+		return nil
+	}
+	nonce := strconv.FormatInt(*r.tx.Nonce, 10)
+	return &nonce
+}
+
+// Hash resolves the hash of the transaction's latest attempt.
+func (r *EthTransactionResolver) Hash() *string {
+	if len(r.tx.EthTxAttempts) == 0 {
+		return nil
+	}
+	hash := r.tx.EthTxAttempts[0].Hash.Hex()
+	return &hash
+}
+
+// EVMChainID resolves the transaction's chain id.
+func (r *EthTransactionResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.tx.EVMChainID.String())
+}
+
+// -- CancelEthTransaction Mutation --
+
+// CancelEthTransactionPayloadResolver resolves the response to cancelling a
+// transaction
+type CancelEthTransactionPayloadResolver struct {
+	tx  *bulletprooftxmanager.EthTx
+	err error
+}
+
+func NewCancelEthTransactionPayload(tx *bulletprooftxmanager.EthTx, err error) *CancelEthTransactionPayloadResolver {
+	return &CancelEthTransactionPayloadResolver{
+		tx:  tx,
+		err: err,
+	}
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToCancelEthTransactionSuccess() (*CancelEthTransactionSuccessResolver, bool) {
+	if r.tx != nil {
+		return NewCancelEthTransactionSuccess(*r.tx), true
+	}
+
+	return nil, false
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToCancelEthTransactionError() (*CancelEthTransactionErrorResolver, bool) {
+	if r.err != nil && !errors.Is(r.err, sql.ErrNoRows) {
+		return NewCancelEthTransactionError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToNotFoundError() (*NotFoundErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, sql.ErrNoRows) {
+		return NewNotFoundError("transaction not found"), true
+	}
+
+	return nil, false
+}
+
+type CancelEthTransactionSuccessResolver struct {
+	tx bulletprooftxmanager.EthTx
+}
+
+func NewCancelEthTransactionSuccess(tx bulletprooftxmanager.EthTx) *CancelEthTransactionSuccessResolver {
+	return &CancelEthTransactionSuccessResolver{tx: tx}
+}
+
+// Transaction resolves the cancelled transaction.
+func (r *CancelEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
+
+type CancelEthTransactionErrorResolver struct {
+	message string
+}
+
+func NewCancelEthTransactionError(message string) *CancelEthTransactionErrorResolver {
+	return &CancelEthTransactionErrorResolver{
+		message: message,
+	}
+}
+
+func (r *CancelEthTransactionErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *CancelEthTransactionErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
+
+// -- ReplaceEthTransaction Mutation --
+
+// ReplaceEthTransactionPayloadResolver resolves the response to replacing a
+// transaction
+type ReplaceEthTransactionPayloadResolver struct {
+	tx  *bulletprooftxmanager.EthTx
+	err error
+}
+
+func NewReplaceEthTransactionPayload(tx *bulletprooftxmanager.EthTx, err error) *ReplaceEthTransactionPayloadResolver {
+	return &ReplaceEthTransactionPayloadResolver{
+		tx:  tx,
+		err: err,
+	}
+}
+
+func (r *ReplaceEthTransactionPayloadResolver) ToReplaceEthTransactionSuccess() (*ReplaceEthTransactionSuccessResolver, bool) {
+	if r.tx != nil {
+		return NewReplaceEthTransactionSuccess(*r.tx), true
+	}
+
+	return nil, false
+}
+
+func (r *ReplaceEthTransactionPayloadResolver) ToReplaceEthTransactionError() (*ReplaceEthTransactionErrorResolver, bool) {
+	if r.err != nil && !errors.Is(r.err, sql.ErrNoRows) {
+		return NewReplaceEthTransactionError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+func (r *ReplaceEthTransactionPayloadResolver) ToNotFoundError() (*NotFoundErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, sql.ErrNoRows) {
+		return NewNotFoundError("transaction not found"), true
+	}
+
+	return nil, false
+}
+
+type ReplaceEthTransactionSuccessResolver struct {
+	tx bulletprooftxmanager.EthTx
+}
+
+func NewReplaceEthTransactionSuccess(tx bulletprooftxmanager.EthTx) *ReplaceEthTransactionSuccessResolver {
+	return &ReplaceEthTransactionSuccessResolver{tx: tx}
+}
+
+// Transaction resolves the replaced transaction.
+func (r *ReplaceEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
+
+type ReplaceEthTransactionErrorResolver struct {
+	message string
+}
+
+func NewReplaceEthTransactionError(message string) *ReplaceEthTransactionErrorResolver {
+	return &ReplaceEthTransactionErrorResolver{
+		message: message,
+	}
+}
+
+func (r *ReplaceEthTransactionErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *ReplaceEthTransactionErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
@@ -0,0 +1,214 @@
+package resolver
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func Test_CancelEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutation = `
+			mutation CancelEthTransaction($id: ID!) {
+				cancelEthTransaction(id: $id) {
+					... on CancelEthTransactionSuccess {
+						transaction {
+							id
+							state
+							from
+							to
+							data
+							value
+							gasLimit
+							nonce
+							hash
+							evmChainID
+						}
+					}
+					... on CancelEthTransactionError {
+						message
+						code
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]interface{}{
+			"id": "1",
+		}
+		from  = common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+		nonce = int64(7)
+		hash  = common.HexToHash("0x5431F5F973781809D18643b87B44921b11355d81")
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "cancelEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CancelEthTransaction", mock.Anything, int64(1)).Return(bulletprooftxmanager.EthTx{
+					ID:             1,
+					Nonce:          &nonce,
+					FromAddress:    from,
+					ToAddress:      from,
+					EncodedPayload: []byte{},
+					Value:          assets.NewEthValue(0),
+					GasLimit:       21000,
+					State:          bulletprooftxmanager.EthTxUnconfirmed,
+					EVMChainID:     *utils.NewBig(big.NewInt(42)),
+					EthTxAttempts:  []bulletprooftxmanager.EthTxAttempt{{Hash: hash}},
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"cancelEthTransaction": {
+					"transaction": {
+						"id": "1",
+						"state": "unconfirmed",
+						"from": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
+						"to": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
+						"data": "0x",
+						"value": "0.000000000000000000",
+						"gasLimit": "21000",
+						"nonce": "7",
+						"hash": "0x0000000000000000000000005431f5f973781809d18643b87b44921b11355d81",
+						"evmChainID": "42"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not replaceable",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CancelEthTransaction", mock.Anything, int64(1)).Return(bulletprooftxmanager.EthTx{}, errors.Wrap(bulletprooftxmanager.ErrEthTxNotReplaceable, "eth_tx 1 is confirmed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"cancelEthTransaction": {
+					"message": "eth_tx 1 is confirmed: only unstarted and unconfirmed transactions can be cancelled or replaced",
+					"code": "UNPROCESSABLE"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CancelEthTransaction", mock.Anything, int64(1)).Return(bulletprooftxmanager.EthTx{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"cancelEthTransaction": {
+					"message": "transaction not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_ReplaceEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutation = `
+			mutation ReplaceEthTransaction($id: ID!, $input: ReplaceEthTransactionInput!) {
+				replaceEthTransaction(id: $id, input: $input) {
+					... on ReplaceEthTransactionSuccess {
+						transaction {
+							id
+							state
+							data
+							gasLimit
+							nonce
+							hash
+						}
+					}
+					... on ReplaceEthTransactionError {
+						message
+						code
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]interface{}{
+			"id":    "1",
+			"input": map[string]interface{}{"data": "0x040506", "gasLimit": "42000"},
+		}
+		replacement = bulletprooftxmanager.EthTxReplacement{EncodedPayload: []byte{4, 5, 6}, GasLimit: 42000}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "replaceEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ReplaceEthTransaction", mock.Anything, int64(1), replacement).Return(bulletprooftxmanager.EthTx{
+					ID:             1,
+					EncodedPayload: []byte{4, 5, 6},
+					GasLimit:       42000,
+					State:          bulletprooftxmanager.EthTxUnstarted,
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"replaceEthTransaction": {
+					"transaction": {
+						"id": "1",
+						"state": "unstarted",
+						"data": "0x040506",
+						"gasLimit": "42000",
+						"nonce": null,
+						"hash": null
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ReplaceEthTransaction", mock.Anything, int64(1), replacement).Return(bulletprooftxmanager.EthTx{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"replaceEthTransaction": {
+					"message": "transaction not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -81,6 +81,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest, orm bridges.ORM) error {
 
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if bt.CacheTTL != nil && bt.CacheTTL.Duration() < 0 {
+		return errors.New("CacheTTL must not be negative")
+	}
 
 	return nil
 }
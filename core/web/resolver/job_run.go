@@ -0,0 +1,159 @@
+package resolver
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+type JobRunStatus string
+
+const (
+	JobRunStatusRunning   JobRunStatus = "RUNNING"
+	JobRunStatusSuspended JobRunStatus = "SUSPENDED"
+	JobRunStatusErrored   JobRunStatus = "ERRORED"
+	JobRunStatusCompleted JobRunStatus = "COMPLETED"
+	JobRunStatusCancelled JobRunStatus = "CANCELLED"
+)
+
+// ToJobRunStatus converts the status of a pipeline run into the enum value
+func ToJobRunStatus(status pipeline.RunStatus) JobRunStatus {
+	return JobRunStatus(strings.ToUpper(string(status)))
+}
+
+// JobRunResolver resolves the JobRun type.
+type JobRunResolver struct {
+	run pipeline.Run
+}
+
+func NewJobRun(run pipeline.Run) *JobRunResolver {
+	return &JobRunResolver{run: run}
+}
+
+// ID resolves the job run's id.
+func (r *JobRunResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(r.run.ID, 10))
+}
+
+// Status resolves the job run's status.
+func (r *JobRunResolver) Status() JobRunStatus {
+	return ToJobRunStatus(r.run.State)
+}
+
+// AllErrors resolves the job run's errors, including those of tasks whose
+// errors did not fail the run.
+func (r *JobRunResolver) AllErrors() []string {
+	return runErrorStrings(r.run.AllErrors)
+}
+
+// FatalErrors resolves the job run's fatal errors.
+func (r *JobRunResolver) FatalErrors() []string {
+	return runErrorStrings(r.run.FatalErrors)
+}
+
+// CreatedAt resolves the job run's created at field.
+func (r *JobRunResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.run.CreatedAt}
+}
+
+// FinishedAt resolves the job run's finished at field.
+func (r *JobRunResolver) FinishedAt() *graphql.Time {
+	if !r.run.FinishedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.run.FinishedAt.Time}
+}
+
+// RetryOfRunID resolves the id of the errored run which this run retries.
+func (r *JobRunResolver) RetryOfRunID() *graphql.ID {
+	if !r.run.RetryOfRunID.Valid {
+		return nil
+	}
+	id := graphql.ID(strconv.FormatInt(r.run.RetryOfRunID.Int64, 10))
+	return &id
+}
+
+func runErrorStrings(errs pipeline.RunErrors) []string {
+	strs := []string{}
+	for _, err := range errs {
+		if err.Valid {
+			strs = append(strs, err.String)
+		}
+	}
+	return strs
+}
+
+// -- RetryJobRun Mutation --
+
+// RetryJobRunPayloadResolver resolves the response to retrying a job run
+type RetryJobRunPayloadResolver struct {
+	run *pipeline.Run
+	err error
+}
+
+func NewRetryJobRunPayload(run *pipeline.Run, err error) *RetryJobRunPayloadResolver {
+	return &RetryJobRunPayloadResolver{
+		run: run,
+		err: err,
+	}
+}
+
+func (r *RetryJobRunPayloadResolver) ToRetryJobRunSuccess() (*RetryJobRunSuccessResolver, bool) {
+	if r.run != nil {
+		return NewRetryJobRunSuccess(*r.run), true
+	}
+
+	return nil, false
+}
+
+func (r *RetryJobRunPayloadResolver) ToRetryJobRunError() (*RetryJobRunErrorResolver, bool) {
+	if r.err != nil && !errors.Is(r.err, sql.ErrNoRows) {
+		return NewRetryJobRunError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+func (r *RetryJobRunPayloadResolver) ToNotFoundError() (*NotFoundErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, sql.ErrNoRows) {
+		return NewNotFoundError("job run not found"), true
+	}
+
+	return nil, false
+}
+
+type RetryJobRunSuccessResolver struct {
+	run pipeline.Run
+}
+
+func NewRetryJobRunSuccess(run pipeline.Run) *RetryJobRunSuccessResolver {
+	return &RetryJobRunSuccessResolver{run: run}
+}
+
+// JobRun resolves the new run which retries the errored run.
+func (r *RetryJobRunSuccessResolver) JobRun() *JobRunResolver {
+	return NewJobRun(r.run)
+}
+
+type RetryJobRunErrorResolver struct {
+	message string
+}
+
+func NewRetryJobRunError(message string) *RetryJobRunErrorResolver {
+	return &RetryJobRunErrorResolver{
+		message: message,
+	}
+}
+
+func (r *RetryJobRunErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *RetryJobRunErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
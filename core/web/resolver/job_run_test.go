@@ -0,0 +1,117 @@
+package resolver
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func Test_RetryJobRun(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutation = `
+			mutation RetryJobRun($id: ID!, $input: RetryJobRunInput) {
+				retryJobRun(id: $id, input: $input) {
+					... on RetryJobRunSuccess {
+						jobRun {
+							id
+							status
+							allErrors
+							fatalErrors
+							createdAt
+							finishedAt
+							retryOfRunID
+						}
+					}
+					... on RetryJobRunError {
+						message
+						code
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]interface{}{
+			"id":    "1",
+			"input": map[string]interface{}{"taskDotID": "submit"},
+		}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "retryJobRun"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RetryJobRunV2", mock.Anything, int64(1), "submit").Return(int64(2), nil)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.Mocks.pipelineORM.On("FindRun", int64(2)).Return(pipeline.Run{
+					ID:           2,
+					State:        pipeline.RunStatusCompleted,
+					AllErrors:    pipeline.RunErrors{null.String{}},
+					FatalErrors:  pipeline.RunErrors{null.String{}},
+					CreatedAt:    f.Timestamp(),
+					FinishedAt:   null.TimeFrom(f.Timestamp()),
+					RetryOfRunID: null.IntFrom(1),
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"retryJobRun": {
+					"jobRun": {
+						"id": "2",
+						"status": "COMPLETED",
+						"allErrors": [],
+						"fatalErrors": [],
+						"createdAt": "2021-01-01T00:00:00Z",
+						"finishedAt": "2021-01-01T00:00:00Z",
+						"retryOfRunID": "1"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not retryable",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RetryJobRunV2", mock.Anything, int64(1), "submit").Return(int64(0), pipeline.ErrRunNotRetryable)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"retryJobRun": {
+					"message": "only errored runs can be retried",
+					"code": "UNPROCESSABLE"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RetryJobRunV2", mock.Anything, int64(1), "submit").Return(int64(0), sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"retryJobRun": {
+					"message": "job run not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
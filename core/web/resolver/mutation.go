@@ -7,14 +7,17 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/lib/pq"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 )
@@ -28,6 +31,7 @@ type createBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	CacheTTL               *string
 }
 
 // Bridge retrieves a bridges by name.
@@ -48,12 +52,20 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	var cacheTTL *models.Interval
+	if args.Input.CacheTTL != nil {
+		cacheTTL = new(models.Interval)
+		if err := cacheTTL.UnmarshalText([]byte(*args.Input.CacheTTL)); err != nil {
+			return nil, err
+		}
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.TaskType(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		CacheTTL:               cacheTTL,
 	}
 
 	bta, bt, err := bridges.NewBridgeType(btr)
@@ -140,6 +152,7 @@ type updateBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	CacheTTL               *string
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
@@ -162,12 +175,20 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	var cacheTTL *models.Interval
+	if args.Input.CacheTTL != nil {
+		cacheTTL = new(models.Interval)
+		if err := cacheTTL.UnmarshalText([]byte(*args.Input.CacheTTL)); err != nil {
+			return nil, err
+		}
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.TaskType(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		CacheTTL:               cacheTTL,
 	}
 
 	taskType, err := bridges.NewTaskType(args.Name)
@@ -260,3 +281,114 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 
 	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
 }
+
+type retryJobRunInput struct {
+	TaskDotID *string
+}
+
+// RetryJobRun retries an errored job run in a new run.
+func (r *Resolver) RetryJobRun(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *retryJobRunInput
+}) (*RetryJobRunPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseInt(string(args.ID), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var dotID string
+	if args.Input != nil && args.Input.TaskDotID != nil {
+		dotID = *args.Input.TaskDotID
+	}
+
+	retryRunID, err := r.App.RetryJobRunV2(ctx, id, dotID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) ||
+			errors.Is(err, pipeline.ErrRunNotRetryable) ||
+			errors.Is(err, pipeline.ErrRetryTaskNotFound) {
+			return NewRetryJobRunPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	run, err := r.App.PipelineORM().FindRun(retryRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRetryJobRunPayload(&run, nil), nil
+}
+
+// CancelEthTransaction cancels an unstarted or unconfirmed transaction.
+func (r *Resolver) CancelEthTransaction(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*CancelEthTransactionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseInt(string(args.ID), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.App.CancelEthTransaction(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) ||
+			errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable) {
+			return NewCancelEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewCancelEthTransactionPayload(&tx, nil), nil
+}
+
+type replaceEthTransactionInput struct {
+	Data     string
+	GasLimit *string
+}
+
+// ReplaceEthTransaction replaces the payload of an unstarted or unconfirmed
+// transaction.
+func (r *Resolver) ReplaceEthTransaction(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input replaceEthTransactionInput
+}) (*ReplaceEthTransactionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseInt(string(args.ID), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement := bulletprooftxmanager.EthTxReplacement{}
+	if replacement.EncodedPayload, err = hexutil.Decode(args.Input.Data); err != nil {
+		return nil, err
+	}
+	if args.Input.GasLimit != nil {
+		if replacement.GasLimit, err = strconv.ParseUint(*args.Input.GasLimit, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := r.App.ReplaceEthTransaction(ctx, id, replacement)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) ||
+			errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable) {
+			return NewReplaceEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewReplaceEthTransactionPayload(&tx, nil), nil
+}
@@ -57,6 +57,17 @@ func (r *NodeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
 	return NewChain(*chain), nil
 }
 
+// State resolves the node's state field, which is empty if the node's chain
+// is not running.
+func (r *NodeResolver) State(ctx context.Context) (string, error) {
+	states, err := loader.GetNodeStatesByChainID(ctx, r.node.EVMChainID.String())
+	if err != nil {
+		return "", err
+	}
+
+	return states[r.node.Name], nil
+}
+
 // CreatedAt resolves the node's created at field.
 func (r *NodeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.node.CreatedAt}
@@ -16,6 +16,7 @@ import (
 	coremocks "github.com/smartcontractkit/chainlink/core/internal/mocks"
 	feedsMocks "github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+	pipelineMocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
@@ -23,12 +24,13 @@ import (
 )
 
 type mocks struct {
-	bridgeORM *bridgeORMMocks.ORM
-	evmORM    *evmORMMocks.ORM
-	feedsSvc  *feedsMocks.Service
-	cfg       *configMocks.GeneralConfig
-	ocr       *keystoreMocks.OCR
-	keystore  *keystoreMocks.Master
+	bridgeORM   *bridgeORMMocks.ORM
+	evmORM      *evmORMMocks.ORM
+	pipelineORM *pipelineMocks.ORM
+	feedsSvc    *feedsMocks.Service
+	cfg         *configMocks.GeneralConfig
+	ocr         *keystoreMocks.OCR
+	keystore    *keystoreMocks.Master
 }
 
 // gqlTestFramework is a framework wrapper containing the objects needed to run
@@ -64,12 +66,13 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 	// Setup mocks
 	// Note - If you add a new mock make sure you assert it's expectation below.
 	m := &mocks{
-		bridgeORM: &bridgeORMMocks.ORM{},
-		evmORM:    &evmORMMocks.ORM{},
-		feedsSvc:  &feedsMocks.Service{},
-		cfg:       &configMocks.GeneralConfig{},
-		ocr:       &keystoreMocks.OCR{},
-		keystore:  &keystoreMocks.Master{},
+		bridgeORM:   &bridgeORMMocks.ORM{},
+		evmORM:      &evmORMMocks.ORM{},
+		pipelineORM: &pipelineMocks.ORM{},
+		feedsSvc:    &feedsMocks.Service{},
+		cfg:         &configMocks.GeneralConfig{},
+		ocr:         &keystoreMocks.OCR{},
+		keystore:    &keystoreMocks.Master{},
 	}
 
 	// Assert expectations for any mocks that we set up
@@ -78,6 +81,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 			app,
 			m.bridgeORM,
 			m.evmORM,
+			m.pipelineORM,
 			m.feedsSvc,
 			m.cfg,
 			m.ocr,
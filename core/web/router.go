@@ -256,9 +256,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.POST("/transactions/:TxHash/cancel", txs.Cancel)
+		authv2.POST("/transactions/:TxHash/replace", txs.Replace)
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
+		authv2.GET("/replays", rc.Index)
 
 		ekc := ETHKeysController{app}
 		authv2.GET("/keys/eth", ekc.Index)
@@ -299,8 +302,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", jc.Create)
+		authv2.POST("/jobs/simulate", jc.Simulate)
 		authv2.DELETE("/jobs/:ID", jc.Delete)
 
+		kuc := KeeperUpkeepsController{app}
+		authv2.GET("/jobs/:ID/upkeeps/:upkeepID/eligibility", kuc.Eligibility)
+
 		jpc := JobProposalsController{app}
 		authv2.GET("/job_proposals", jpc.Index)
 		authv2.GET("/job_proposals/:id", jpc.Show)
@@ -311,8 +318,14 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/events", prc.Events)
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
+		authv2.GET("/jobs/:ID/runs/events", prc.Events)
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.PATCH("/jobs/:ID/runs/:runID", prc.Update)
+		authv2.DELETE("/jobs/:ID/runs/:runID", prc.Cancel)
+		authv2.DELETE("/jobs/:ID/runs", prc.CancelSuspended)
+		authv2.POST("/jobs/:ID/runs/:runID/retry", prc.Retry)
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -321,6 +334,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
 
+		// PipelineTaskTypesController
+		ptc := PipelineTaskTypesController{}
+		authv2.GET("/pipeline/task_types", ptc.Index)
+
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", lgc.Patch)
@@ -337,6 +354,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/chains/evm/:ID/nodes", paginatedRequest(nc.Index))
 		authv2.POST("/nodes", nc.Create)
 		authv2.DELETE("/nodes/:ID", nc.Delete)
+
+		rgc := ReorgsController{app}
+		authv2.GET("/chains/evm/:ID/reorgs", paginatedRequest(rgc.Index))
 	}
 
 	ping := PingController{app}
@@ -3,11 +3,14 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 )
@@ -46,3 +49,87 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// ReplaceTransactionRequest is the body of a request to replace the payload
+// of a transaction. A GasLimit of zero keeps the gas limit of the transaction.
+type ReplaceTransactionRequest struct {
+	Data     hexutil.Bytes `json:"data"`
+	GasLimit uint64        `json:"gasLimit"`
+}
+
+// Cancel cancels an unstarted or unconfirmed transaction, identified by the
+// hash of one of its attempts or by its ID. An unconfirmed transaction is
+// replaced by a 0-value transfer to its sender.
+// Example:
+//  "<application>/transactions/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	etxID, err := tc.findEthTxID(c.Param("TxHash"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	etx, err := tc.App.CancelEthTransaction(c.Request.Context(), etxID)
+	tc.replacementResponse(c, etx, err)
+}
+
+// Replace replaces the payload of an unstarted or unconfirmed transaction,
+// identified by the hash of one of its attempts or by its ID. An unconfirmed
+// transaction is sent again at the same nonce.
+// Example:
+//  "<application>/transactions/:TxHash/replace"
+func (tc *TransactionsController) Replace(c *gin.Context) {
+	var request ReplaceTransactionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	etxID, err := tc.findEthTxID(c.Param("TxHash"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	etx, err := tc.App.ReplaceEthTransaction(c.Request.Context(), etxID, bulletprooftxmanager.EthTxReplacement{
+		EncodedPayload: request.Data,
+		GasLimit:       request.GasLimit,
+	})
+	tc.replacementResponse(c, etx, err)
+}
+
+// findEthTxID returns the ID of the transaction with an attempt of the given
+// hash, or the given transaction ID
+func (tc *TransactionsController) findEthTxID(hashOrID string) (int64, error) {
+	if id, err := strconv.ParseInt(hashOrID, 10, 64); err == nil {
+		return id, nil
+	}
+	attempt, err := tc.App.BPTXMORM().FindEthTxAttempt(common.HexToHash(hashOrID))
+	if err != nil {
+		return 0, err
+	}
+	return attempt.EthTxID, nil
+}
+
+// replacementResponse renders the transaction after it was cancelled or
+// replaced, from its latest attempt
+func (tc *TransactionsController) replacementResponse(c *gin.Context, etx bulletprooftxmanager.EthTx, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromEthTx(etx), "transaction")
+}
@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -124,3 +125,80 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	db := app.GetDB()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	t.Run("cancels unstarted transaction by ID", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, db, from)
+
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/%d/cancel", etx.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		ptx := presenters.EthTxResource{}
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+		assert.Equal(t, fmt.Sprintf("%d", etx.ID), ptx.ID)
+		assert.Equal(t, string(bulletprooftxmanager.EthTxFatalError), ptx.State)
+	})
+
+	t.Run("cancels unconfirmed transaction by attempt hash", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, from)
+		attempt := etx.EthTxAttempts[0]
+
+		resp, cleanup := client.Post("/v2/transactions/"+attempt.Hash.Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		ptx := presenters.EthTxResource{}
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+		assert.NotEqual(t, attempt.Hash, ptx.Hash)
+		assert.Equal(t, &from, ptx.To)
+		assert.Equal(t, "0", ptx.Nonce)
+	})
+
+	t.Run("does not cancel confirmed transaction", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 1, 1, from)
+
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/%d/cancel", etx.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+	})
+
+	t.Run("missing transaction", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/transactions/"+utils.NewHash().Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
+
+func TestTransactionsController_Replace(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	db := app.GetDB()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	etx := cltest.MustInsertUnstartedEthTx(t, db, from)
+
+	body := bytes.NewBufferString(`{"data": "0x040506", "gasLimit": 42000}`)
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/%d/replace", etx.ID), body)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTxResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, "0x040506", ptx.Data.String())
+	assert.Equal(t, "42000", ptx.GasLimit)
+	assert.Equal(t, string(bulletprooftxmanager.EthTxUnstarted), ptx.State)
+}
@@ -431,6 +431,26 @@ declare module 'core/store/models' {
     updatedAt: time.Time
   }
 
+  export type JobReplayState = 'in_progress' | 'completed' | 'errored'
+
+  /**
+   * JobReplay is a replay of the logs of a job, and its progress
+   */
+  export type JobReplay = {
+    jobID: number
+    evmChainID: string
+    contract: string | null
+    fromBlock: number
+    toBlock: number
+    blockNumber: number | null
+    logsDelivered: number
+    ignoreConsumed: boolean
+    state: JobReplayState
+    error?: string
+    createdAt: time.Time
+    finishedAt: nullable.Time
+  }
+
   export interface JobRunV2 {
     state: string
     outputs: PipelineTaskOutput[]
@@ -557,6 +577,14 @@ declare module 'core/store/models' {
   export interface FeatureFlag {
     enabled: boolean
   }
+
+  /**
+   * PipelineTaskType is a task type usable in pipelines. Custom task types
+   * are registered by the packages the node is built with.
+   */
+  export interface PipelineTaskType {
+    custom: boolean
+  }
 }
 
 export interface PipelineTaskRun {
@@ -7,9 +7,11 @@ import { Config } from './config'
 import { Features } from './features'
 import { FeedsManagers } from './feedsManagers'
 import { Jobs } from './jobs'
+import { JobReplays } from './jobReplays'
 import { JobProposals } from './jobProposals'
 import { OcrKeys } from './ocrKeys'
 import { P2PKeys } from './p2pKeys'
+import { PipelineTaskTypes } from './pipelineTaskTypes'
 import { Runs } from './runs'
 import { Transactions } from './transactions'
 import { User } from './user'
@@ -30,9 +32,11 @@ export class V2 {
   public logConfig = new LogConfig(this.api)
   public nodes = new Nodes(this.api)
   public jobs = new Jobs(this.api)
+  public jobReplays = new JobReplays(this.api)
   public jobProposals = new JobProposals(this.api)
   public ocrKeys = new OcrKeys(this.api)
   public p2pKeys = new P2PKeys(this.api)
+  public pipelineTaskTypes = new PipelineTaskTypes(this.api)
   public runs = new Runs(this.api)
   public transactions = new Transactions(this.api)
   public user = new User(this.api)
@@ -0,0 +1,30 @@
+import * as jsonapi from 'utils/json-api-client'
+import { boundMethod } from 'autobind-decorator'
+import * as models from 'core/store/models'
+
+/**
+ * Index returns the latest replays of the logs of jobs, latest first
+ *
+ * @example "<application>/v2/replays?evmChainID=1&jobID=1"
+ */
+interface IndexParams {
+  evmChainID: string
+  jobID: string
+}
+const ENDPOINT = '/v2/replays'
+
+export class JobReplays {
+  constructor(private api: jsonapi.Api) {}
+
+  @boundMethod
+  public getJobReplays(
+    evmChainID: string,
+    jobID: string,
+  ): Promise<jsonapi.ApiResponse<models.JobReplay[]>> {
+    return this.index({ evmChainID, jobID })
+  }
+
+  private index = this.api.fetchResource<IndexParams, models.JobReplay[]>(
+    ENDPOINT,
+  )
+}
@@ -0,0 +1,23 @@
+import * as jsonapi from 'utils/json-api-client'
+import { boundMethod } from 'autobind-decorator'
+import * as models from 'core/store/models'
+
+export const INDEX_ENDPOINT = '/v2/pipeline/task_types'
+
+export class PipelineTaskTypes {
+  constructor(private api: jsonapi.Api) {}
+
+  /**
+   * Get the task types usable in pipelines, custom ones included
+   */
+  @boundMethod
+  public getTaskTypes(): Promise<
+    jsonapi.ApiResponse<models.PipelineTaskType[]>
+  > {
+    return this.index()
+  }
+
+  private index = this.api.fetchResource<{}, models.PipelineTaskType[], {}>(
+    INDEX_ENDPOINT,
+  )
+}
@@ -3,6 +3,7 @@ import { syncFetch } from 'test-helpers/syncFetch'
 import globPath from 'test-helpers/globPath'
 import { mountWithProviders } from 'test-helpers/mountWithTheme'
 import { ENDPOINT as TOML_CREATE_ENDPOINT } from 'api/v2/jobs'
+import { INDEX_ENDPOINT as TASK_TYPES_ENDPOINT } from 'api/v2/pipelineTaskTypes'
 import { Route } from 'react-router-dom'
 import * as storage from 'utils/local-storage'
 import New, { validate, SELECTED_FORMAT, PERSIST_SPEC } from './New'
@@ -16,9 +17,17 @@ const fillTextarea = (component: ReactWrapper, jobSpec: string) => {
 }
 
 describe('pages/Jobs/New', () => {
+  const taskTypes = {
+    data: [
+      { id: 'http', type: 'taskTypes', attributes: { custom: false } },
+      { id: 'greet', type: 'taskTypes', attributes: { custom: true } },
+    ],
+  }
+
   beforeEach(() => {
     storage.remove(`${PERSIST_SPEC}`)
     storage.remove(SELECTED_FORMAT)
+    global.fetch.get(globPath(TASK_TYPES_ENDPOINT), taskTypes)
   })
 
   it('submits TOML job spec form', async () => {
@@ -125,6 +134,30 @@ describe('pages/Jobs/New', () => {
     ])
   })
 
+  it('checks the task types of the preview, custom ones included', async () => {
+    const tomlSpec =
+      'observationSource = """ ds [type=http]; ds_greet [type=greet]; ds_nope [type=nope]; ds -> ds_greet -> ds_nope """'
+
+    const wrapper = mountWithProviders(
+      <Route path="/jobs/new" component={New} />,
+      {
+        initialEntries: [`/jobs/new`],
+      },
+    )
+    await syncFetch(wrapper)
+
+    jest.useFakeTimers()
+    fillTextarea(wrapper, tomlSpec)
+    act(() => {
+      jest.runAllTimers()
+    })
+    wrapper.update()
+
+    expect(wrapper.text()).toContain('Unknown task types: nope')
+    expect(wrapper.text()).toContain('Custom task types')
+    expect(wrapper.text()).toContain('greet')
+  })
+
   it('shows "Tasks not found" on job spec errors', () => {
     const tomlSpec =
       'observationSource = "" ds [type=ds]; ds_parse [type=ds_parse];  """'
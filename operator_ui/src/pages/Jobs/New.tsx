@@ -1,10 +1,10 @@
 import React, { useEffect, useState } from 'react'
-import { isToml, getTaskList } from './utils'
+import { isToml, getTaskList, getUnknownTaskTypes } from './utils'
 import { ApiResponse, BadRequestError } from 'utils/json-api-client'
 import Button from 'components/Button'
 import * as api from 'api'
 import { useDispatch } from 'react-redux'
-import { CreateJobRequest, Job } from 'core/store/models'
+import { CreateJobRequest, Job, PipelineTaskType } from 'core/store/models'
 import BaseLink from 'components/BaseLink'
 import ErrorMessage from 'components/Notifications/DefaultError'
 import { notifySuccess, notifyError } from 'actionCreators'
@@ -41,6 +41,13 @@ const styles = (theme: Theme) =>
     emptyTasks: {
       padding: theme.spacing.unit * 3,
     },
+    unknownTaskTypes: {
+      padding: theme.spacing.unit * 3,
+      paddingTop: 0,
+    },
+    customTaskTypes: {
+      marginTop: theme.spacing.unit * 5,
+    },
   })
 
 const SuccessNotification = ({ id }: { id: string }) => (
@@ -105,6 +112,30 @@ export const New = ({
   const [tasks, setTasks] = useState(() =>
     getTaskList({ value: initialValues.jobSpec }),
   )
+  const [taskTypes, setTaskTypes] =
+    useState<ApiResponse<PipelineTaskType[]>['data']>()
+
+  // Fetch the task types usable in pipelines, custom ones included, to check
+  // those of the tasks in the preview
+  useEffect(() => {
+    api.v2.pipelineTaskTypes
+      .getTaskTypes()
+      .then(({ data }) => setTaskTypes(data))
+      .catch(() => {
+        // The preview is shown without checking the task types
+      })
+  }, [])
+
+  const unknownTaskTypes =
+    tasks.list && taskTypes
+      ? getUnknownTaskTypes({
+          list: tasks.list as Stratify[],
+          taskTypes: taskTypes.map(({ id }) => id),
+        })
+      : []
+  const customTaskTypes = (taskTypes || [])
+    .filter(({ attributes }) => attributes.custom)
+    .map(({ id }) => id)
 
   // Extract the tasks from the job spec to display in the preview
   useEffect(() => {
@@ -228,6 +259,15 @@ export const New = ({
           <Card style={{ overflow: 'visible' }}>
             <CardHeader title="Task list preview" />
             {tasks.list && <TaskListDag stratify={tasks.list as Stratify[]} />}
+            {unknownTaskTypes.length > 0 && (
+              <Typography
+                className={classes.unknownTaskTypes}
+                variant="body1"
+                color="error"
+              >
+                Unknown task types: {unknownTaskTypes.join(', ')}
+              </Typography>
+            )}
             {!tasks.list && (
               <Typography
                 className={classes.emptyTasks}
@@ -238,6 +278,17 @@ export const New = ({
               </Typography>
             )}
           </Card>
+
+          {customTaskTypes.length > 0 && (
+            <Card className={classes.customTaskTypes}>
+              <CardHeader title="Custom task types" />
+              <CardContent>
+                <Typography variant="body1" color="textSecondary">
+                  {customTaskTypes.join(', ')}
+                </Typography>
+              </CardContent>
+            </Card>
+          )}
         </Grid>
       </Grid>
     </Content>
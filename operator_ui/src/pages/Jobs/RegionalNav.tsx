@@ -155,8 +155,12 @@ const RegionalNavComponent = ({
   const navErrorsActive = location.pathname.endsWith('/errors')
   const navDefinitionActive = location.pathname.endsWith('/definition')
   const navRunsActive = location.pathname.endsWith('/runs')
+  const navReplaysActive = location.pathname.endsWith('/replays')
   const navOverviewActive =
-    !navDefinitionActive && !navErrorsActive && !navRunsActive
+    !navDefinitionActive &&
+    !navErrorsActive &&
+    !navRunsActive &&
+    !navReplaysActive
   const [modalOpen, setModalOpen] = useState(false)
   const [deleted, setDeleted] = useState(false)
   const [runJobModalOpen, setRunJobModalOpen] = useState(false)
@@ -410,6 +414,17 @@ const RegionalNavComponent = ({
                   </Badge>
                 </Link>
               </ListItem>
+              <ListItem className={classes.horizontalNavItem}>
+                <Link
+                  href={`/jobs/${jobId}/replays`}
+                  className={classNames(
+                    classes.horizontalNavLink,
+                    navReplaysActive && classes.activeNavLink,
+                  )}
+                >
+                  Replays
+                </Link>
+              </ListItem>
             </List>
           </Grid>
         </Grid>
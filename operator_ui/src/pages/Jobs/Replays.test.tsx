@@ -0,0 +1,76 @@
+import React from 'react'
+import { JobsShow } from 'pages/Jobs/Show'
+import { Route } from 'react-router-dom'
+import { mountWithProviders } from 'test-helpers/mountWithTheme'
+import { syncFetch } from 'test-helpers/syncFetch'
+import globPath from 'test-helpers/globPath'
+import {
+  jsonApiJob,
+  fluxMonitorJobResource,
+} from 'support/factories/jsonApiJobs'
+
+const JOB_ID = '200'
+const EVM_CHAIN_ID = '42'
+
+const replays = [
+  {
+    id: '2',
+    type: 'jobReplays',
+    attributes: {
+      jobID: 200,
+      evmChainID: EVM_CHAIN_ID,
+      contract: null,
+      fromBlock: 10,
+      toBlock: 20,
+      blockNumber: null,
+      logsDelivered: 0,
+      ignoreConsumed: false,
+      state: 'errored',
+      error: 'replay interrupted, the node was restarted',
+      createdAt: '2020-10-16T13:18:46.519087+01:00',
+      finishedAt: '2020-10-16T13:19:46.519087+01:00',
+    },
+  },
+  {
+    id: '1',
+    type: 'jobReplays',
+    attributes: {
+      jobID: 200,
+      evmChainID: EVM_CHAIN_ID,
+      contract: null,
+      fromBlock: 5,
+      toBlock: 20,
+      blockNumber: 14,
+      logsDelivered: 3,
+      ignoreConsumed: true,
+      state: 'in_progress',
+      createdAt: '2020-10-16T13:18:46.519087+01:00',
+      finishedAt: null,
+    },
+  },
+]
+
+describe('pages/Jobs/Replays', () => {
+  it('renders the job replays', async () => {
+    const job = fluxMonitorJobResource({ id: JOB_ID })
+    job.attributes.fluxMonitorSpec.evmChainID = EVM_CHAIN_ID
+    global.fetch.getOnce(globPath(`/v2/jobs/${JOB_ID}`), jsonApiJob(job))
+    global.fetch.getOnce(globPath('/v2/replays'), { data: replays })
+
+    const wrapper = mountWithProviders(
+      <Route path="/jobs/:jobId" component={JobsShow} />,
+      {
+        initialEntries: [`/jobs/${JOB_ID}/replays`],
+      },
+    )
+
+    await syncFetch(wrapper)
+    await syncFetch(wrapper)
+
+    expect(wrapper.text()).toContain(
+      'replay interrupted, the node was restarted',
+    )
+    expect(wrapper.text()).toContain('In progress')
+    expect(wrapper.find('tbody').children().length).toEqual(2)
+  })
+})
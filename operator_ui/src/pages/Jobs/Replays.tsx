@@ -0,0 +1,143 @@
+import React from 'react'
+import {
+  Card,
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableRow,
+  Typography,
+} from '@material-ui/core'
+import { v2 } from 'api'
+import { JobReplay } from 'core/store/models'
+import Content from 'components/Content'
+import { TimeAgo } from 'components/TimeAgo'
+import { ApiResponse } from 'utils/json-api-client'
+import { JobData } from './sharedTypes'
+
+const REPLAY_STATES = {
+  in_progress: 'In progress',
+  completed: 'Completed',
+  errored: 'Errored',
+}
+
+export const JobsReplays: React.FC<{
+  error: unknown
+  ErrorComponent: React.FC
+  LoadingPlaceholder: React.FC
+  setError: React.Dispatch<unknown>
+  job?: JobData['job']
+}> = ({ error, ErrorComponent, LoadingPlaceholder, setError, job }) => {
+  const [replays, setReplays] = React.useState<
+    ApiResponse<JobReplay[]>['data']
+  >()
+
+  React.useEffect(() => {
+    document.title = job?.name ? `${job?.name} | Job replays` : 'Job replays'
+  }, [job])
+
+  React.useEffect(() => {
+    if (!job) {
+      return
+    }
+    // Only the logs of jobs on an EVM chain can be replayed
+    if (!job.evmChainID) {
+      setReplays([])
+      return
+    }
+    v2.jobReplays
+      .getJobReplays(job.evmChainID, job.id)
+      .then((response) => setReplays(response.data))
+      .catch(setError)
+  }, [job, setError])
+
+  const tableHeaders = [
+    'Blocks',
+    'Replayed To',
+    'Logs Delivered',
+    'State',
+    'Created',
+    'Finished',
+    'Error',
+  ]
+
+  return (
+    <Content>
+      <ErrorComponent />
+      <LoadingPlaceholder />
+
+      {!error && replays && (
+        <Card>
+          <Table>
+            <TableHead>
+              <TableRow>
+                {tableHeaders.map((header) => (
+                  <TableCell key={header}>
+                    <Typography variant="body1" color="textSecondary">
+                      {header}
+                    </Typography>
+                  </TableCell>
+                ))}
+              </TableRow>
+            </TableHead>
+            <TableBody>
+              {replays.length === 0 ? (
+                <TableRow>
+                  <TableCell component="th" scope="row" colSpan={7}>
+                    No replays
+                  </TableCell>
+                </TableRow>
+              ) : (
+                replays.map(({ id, attributes: replay }) => (
+                  <TableRow key={id}>
+                    <TableCell>
+                      <Typography variant="body1">
+                        {replay.fromBlock} - {replay.toBlock}
+                      </Typography>
+                    </TableCell>
+                    <TableCell>
+                      <Typography variant="body1">
+                        {replay.blockNumber === null ? '-' : replay.blockNumber}
+                      </Typography>
+                    </TableCell>
+                    <TableCell>
+                      <Typography variant="body1">
+                        {replay.logsDelivered}
+                      </Typography>
+                    </TableCell>
+                    <TableCell>
+                      <Typography variant="body1">
+                        {REPLAY_STATES[replay.state]}
+                      </Typography>
+                    </TableCell>
+                    <TableCell>
+                      <Typography variant="body1">
+                        <TimeAgo tooltip>{replay.createdAt}</TimeAgo>
+                      </Typography>
+                    </TableCell>
+                    <TableCell>
+                      <Typography variant="body1">
+                        {replay.finishedAt ? (
+                          <TimeAgo tooltip>{replay.finishedAt}</TimeAgo>
+                        ) : (
+                          '-'
+                        )}
+                      </Typography>
+                    </TableCell>
+                    <TableCell>
+                      <Typography variant="body1">
+                        {replay.error || '-'}
+                      </Typography>
+                    </TableCell>
+                  </TableRow>
+                ))
+              )}
+            </TableBody>
+          </Table>
+        </Card>
+      )}
+    </Content>
+  )
+}
+
+export default JobsReplays
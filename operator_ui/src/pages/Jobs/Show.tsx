@@ -9,6 +9,7 @@ import { JobDefinition } from './JobDefinition'
 import { JobsErrors } from './Errors'
 import { RecentRuns } from './RecentRuns'
 import { RegionalNav } from './RegionalNav'
+import { JobsReplays } from './Replays'
 import { Runs as JobRuns } from './Runs'
 import { transformPipelineJobRun } from './transformJobRuns'
 
@@ -155,6 +156,17 @@ export const JobsShow = () => {
             }}
           />
         </Route>
+        <Route exact path={`${path}/replays`}>
+          <JobsReplays
+            {...{
+              job,
+              ErrorComponent,
+              LoadingPlaceholder,
+              error,
+              setError,
+            }}
+          />
+        </Route>
         <Route exact path={`${path}/runs`}>
           <JobRuns
             {...{
@@ -1,4 +1,5 @@
-import { stringifyJobSpec, getTaskList } from './utils'
+import { stringifyJobSpec, getTaskList, getUnknownTaskTypes } from './utils'
+import { Stratify } from './parseDot'
 
 describe('pages/jobs/utils', () => {
   describe('stringifyJobSpec', () => {
@@ -74,4 +75,20 @@ describe('pages/jobs/utils', () => {
       })
     })
   })
+
+  describe('getUnknownTaskTypes', () => {
+    it('returns the task types which are not registered', () => {
+      const { list } = getTaskList({
+        value:
+          'observationSource = """ ds [type=http]; ds_parse [type=MyTask]; ds_custom [type=greet]; ds_custom2 [type=mytask]; ds -> ds_parse -> ds_custom -> ds_custom2 """',
+      })
+
+      expect(
+        getUnknownTaskTypes({
+          list: list as Stratify[],
+          taskTypes: ['http', 'greet'],
+        }),
+      ).toEqual(['MyTask'])
+    })
+  })
 })
@@ -2,7 +2,7 @@ import TOML from '@iarna/toml'
 import { PipelineTaskError, RunStatus } from 'core/store/models'
 import { TaskSpec } from 'core/store/models'
 import { parseDot, Stratify } from './parseDot'
-import { countBy as _countBy } from 'lodash'
+import { countBy as _countBy, uniqBy as _uniqBy } from 'lodash'
 
 export function isToml({ value }: { value: string }): boolean {
   try {
@@ -90,6 +90,28 @@ export function getTaskList({ value }: { value: string }): {
   }
 }
 
+/**
+ * getUnknownTaskTypes returns the types of the tasks which are neither built
+ * into the node nor registered as custom task types. Task types are
+ * case-insensitive, as they are on the node.
+ */
+export function getUnknownTaskTypes({
+  list,
+  taskTypes,
+}: {
+  list: Stratify[]
+  taskTypes: string[]
+}): string[] {
+  const unknown = list
+    .map((task) => task.attributes?.type)
+    .filter(
+      (type): type is string =>
+        !!type && !taskTypes.includes(type.toLowerCase()),
+    )
+
+  return _uniqBy(unknown, (type) => type.toLowerCase())
+}
+
 function errorsExist(errors: PipelineTaskError[]): boolean {
   return errors !== null && errors.length > 0 && errors[0] !== null
 }